@@ -0,0 +1,6 @@
+package interservicelinks
+
+import "embed"
+
+//go:embed descriptions/*
+var descriptions embed.FS
@@ -0,0 +1,147 @@
+package interservicelinks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdalinks "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/links"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// destinationTarget describes a type of resource that a lambda function
+// can send records of asynchronous invocations to.
+type destinationTarget struct {
+	resourceType string
+	// label is used in the label and description of the destination annotation.
+	label string
+	// targetPlaceholder is the placeholder used for the name of the target
+	// resource in annotation names.
+	targetPlaceholder string
+	// action is the IAM action the execution role of the linked from function
+	// must be allowed to perform on the target resource.
+	action string
+	// policyNameAction is the verb used in the name of the inline policy
+	// added to the execution role of the linked from function.
+	policyNameAction string
+	// policyLinkDataKey is the top-level link data field that holds
+	// the details of the inline policy.
+	policyLinkDataKey string
+	descriptionFile   string
+	summary           string
+	// nameFromARN extracts the name of the target resource from its ARN.
+	nameFromARN func(string) string
+}
+
+// functionDestinationLink creates a link implementation for a link from
+// a lambda function to a resource that can be used as a destination
+// for asynchronous invocations.
+//
+// The IAM service is used to manage an inline policy in the execution role
+// of the lambda function that grants permission to send to the target resource
+// when the target resource is configured as a destination,
+// the execution role is treated as an intermediary resource.
+func functionDestinationLink(
+	target *destinationTarget,
+	linkServiceDeps pluginutils.LinkServiceDeps[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	],
+	iamService pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
+) provider.Link {
+	description, _ := descriptions.ReadFile(target.descriptionFile)
+
+	actions := &lambdaFunctionDestinationLinkActions{
+		target:               target,
+		lambdaServiceFactory: linkServiceDeps.ResourceAService.ServiceFactory,
+		awsConfigStore:       linkServiceDeps.ResourceAService.ConfigStore,
+		iamServiceFactory:    iamService.ServiceFactory,
+		iamConfigStore:       iamService.ConfigStore,
+		destinationRetry:     lambdalinks.DefaultDestinationPermissionRetry(),
+	}
+
+	return &providerv1.LinkDefinition{
+		ResourceTypeA: "aws/lambda/function",
+		ResourceTypeB: target.resourceType,
+		// It doesn't matter which resource is created first,
+		// the function will be configured to be able to send to
+		// the target resource once both have been created.
+		Kind:                            provider.LinkKindSoft,
+		PriorityResource:                provider.LinkPriorityResourceNone,
+		PlainTextSummary:                target.summary,
+		FormattedDescription:            string(description),
+		AnnotationDefinitions:           lambdaFunctionDestinationLinkAnnotations(target),
+		StageChangesFunc:                actions.StageChanges,
+		UpdateResourceAFunc:             actions.UpdateResourceA,
+		UpdateResourceBFunc:             actions.UpdateResourceB,
+		UpdateIntermediaryResourcesFunc: actions.UpdateIntermediaryResources,
+	}
+}
+
+type lambdaFunctionDestinationLinkActions struct {
+	target               *destinationTarget
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	iamServiceFactory    pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	iamConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	destinationRetry     *lambdalinks.DestinationPermissionRetry
+}
+
+func (l *lambdaFunctionDestinationLinkActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (lambdaservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaFunctionDestinationLinkActions) getIAMService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (iamservice.Service, error) {
+	awsConfig, err := l.iamConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.iamServiceFactory(awsConfig, providerContext), nil
+}
+
+// Extracts the name from an SQS queue or SNS topic ARN of the form
+// arn:aws:{service}:{region}:{account}:{name}.
+func lastARNSegment(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// Extracts the event bus name from an EventBridge event bus ARN of the form
+// arn:aws:events:{region}:{account}:event-bus/{eventBusName}.
+func eventBusNameFromARN(eventBusARN string) string {
+	return eventBusARN[strings.LastIndex(eventBusARN, "/")+1:]
+}
+
+func (t *destinationTarget) policyDocument(targetARN string) string {
+	return fmt.Sprintf(
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":[%q],"Resource":[%q]}]}`,
+		t.action,
+		targetARN,
+	)
+}
@@ -0,0 +1,19 @@
+package interservicelinks
+
+import (
+	"fmt"
+
+	lambdalinks "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func lambdaFunctionDestinationLinkAnnotations(
+	target *destinationTarget,
+) map[string]*provider.LinkAnnotationDefinition {
+	return map[string]*provider.LinkAnnotationDefinition{
+		fmt.Sprintf(
+			"aws/lambda/function::aws.lambda.function.%s.destination",
+			target.targetPlaceholder,
+		): lambdalinks.DestinationAnnotationDefinition(target.targetPlaceholder, target.label),
+	}
+}
@@ -0,0 +1,105 @@
+package interservicelinks
+
+import (
+	"context"
+
+	lambdalinks "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/linkhelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *lambdaFunctionDestinationLinkActions) StageChanges(
+	ctx context.Context,
+	input *provider.LinkStageChangesInput,
+) (*provider.LinkStageChangesOutput, error) {
+	changes := &provider.LinkChanges{}
+
+	functionResourceName := linkhelpers.GetResourceNameFromChanges(input.ResourceAChanges)
+	targetResourceName := linkhelpers.GetResourceNameFromChanges(input.ResourceBChanges)
+
+	currentLinkData := linkhelpers.GetLinkDataFromState(input.CurrentLinkState)
+
+	destinationType := lambdalinks.DestinationTypeFromChanges(
+		input.ResourceAChanges,
+		targetResourceName,
+	)
+	err := lambdalinks.CollectDestinationChanges(
+		functionResourceName,
+		destinationType,
+		currentLinkData,
+		input.ResourceBChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if destinationType == "" {
+		// Permission to send to the target is only granted to the execution role
+		// when the target is a destination for the function.
+		err = l.collectPolicyRemovalChanges(currentLinkData, changes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &provider.LinkStageChangesOutput{
+			Changes: changes,
+		}, nil
+	}
+
+	err = linkhelpers.CollectChanges(
+		"$.spec.role",
+		"$."+l.target.policyLinkDataKey+".roleArn",
+		currentLinkData,
+		input.ResourceAChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = linkhelpers.CollectChanges(
+		"$.spec.arn",
+		"$."+l.target.policyLinkDataKey+".targetArn",
+		currentLinkData,
+		input.ResourceBChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkStageChangesOutput{
+		Changes: changes,
+	}, nil
+}
+
+func (l *lambdaFunctionDestinationLinkActions) collectPolicyRemovalChanges(
+	currentLinkData *core.MappingNode,
+	changes *provider.LinkChanges,
+) error {
+	for _, field := range []string{"roleArn", "targetArn"} {
+		linkFieldPath := "$." + l.target.policyLinkDataKey + "." + field
+		currentValue, _ := core.GetPathValue(
+			linkFieldPath,
+			currentLinkData,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if core.IsNilMappingNode(currentValue) {
+			continue
+		}
+
+		err := linkhelpers.CollectLinkDataChanges(
+			linkFieldPath,
+			currentLinkData,
+			changes,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package interservicelinks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type FunctionDestinationLinkStageChangesSuite struct {
+	suite.Suite
+}
+
+func (s *FunctionDestinationLinkStageChangesSuite) Test_stage_changes() {
+	loader := &testutils.MockAWSConfigLoader{}
+
+	testCases := []plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		createFunctionDestinationLinkChangesTestCase(),
+		createFunctionDestinationLinkChangesSwitchTypeTestCase(),
+		createFunctionDestinationLinkChangesRemoveDestinationTestCase(),
+	}
+
+	plugintestutils.RunLinkChangeStagingTestCases(
+		testCases,
+		createTestFunctionDestinationLink(
+			LambdaFunctionSQSQueueLink,
+			iammock.CreateIamServiceMockFactory(),
+			loader,
+		),
+		&s.Suite,
+	)
+}
+
+func createFunctionDestinationLinkChangesTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for a new link with the queue as a failure destination",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: createTestFunctionChangesWithDestination("onFailure"),
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "failedOrdersQueue",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{},
+						},
+					},
+				},
+				FieldChangesKnownOnDeploy: []string{
+					"spec.arn",
+				},
+			},
+			CurrentLinkState: &state.LinkState{
+				LinkID: "test-link",
+				Data:   map[string]*core.MappingNode{},
+			},
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				NewFields: []*provider.FieldChange{
+					{
+						FieldPath: "sendMessagePolicy.roleArn",
+						NewValue:  core.MappingNodeFromString(testFunctionRoleARN),
+					},
+				},
+				FieldChangesKnownOnDeploy: []string{
+					"[\"ordersFunction\"].destinationConfig.onFailure",
+					"sendMessagePolicy.targetArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionDestinationLinkChangesSwitchTypeTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for moving the queue from the success to the failure destination",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: createTestFunctionChangesWithDestination("onFailure"),
+			ResourceBChanges: createTestQueueChanges(),
+			CurrentLinkState: createTestQueueLinkState("onSuccess"),
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				NewFields: []*provider.FieldChange{
+					{
+						FieldPath: "[\"ordersFunction\"].destinationConfig.onFailure",
+						NewValue:  core.MappingNodeFromString(testQueueARN),
+					},
+				},
+				RemovedFields: []string{
+					"[\"ordersFunction\"].destinationConfig.onSuccess",
+				},
+				UnchangedFields: []string{
+					"sendMessagePolicy.roleArn",
+					"sendMessagePolicy.targetArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionDestinationLinkChangesRemoveDestinationTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for removing the queue as a destination when the annotation is removed",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: createTestFunctionChangesWithDestination(""),
+			ResourceBChanges: createTestQueueChanges(),
+			CurrentLinkState: createTestQueueLinkState("onFailure"),
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				// The permission to send to the queue is removed along with the destination.
+				RemovedFields: []string{
+					"[\"ordersFunction\"].destinationConfig.onFailure",
+					"sendMessagePolicy.roleArn",
+					"sendMessagePolicy.targetArn",
+				},
+			},
+		},
+	}
+}
+
+func createTestFunctionChangesWithDestination(destinationType string) *provider.Changes {
+	annotations := map[string]*core.MappingNode{}
+	if destinationType != "" {
+		annotations["aws.lambda.function.failedOrdersQueue.destination"] = core.MappingNodeFromString(
+			destinationType,
+		)
+	}
+
+	return &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceName: "ordersFunction",
+			ResourceWithResolvedSubs: &provider.ResolvedResource{
+				Metadata: &provider.ResolvedResourceMetadata{
+					Annotations: &core.MappingNode{
+						Fields: annotations,
+					},
+				},
+				Spec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"role": core.MappingNodeFromString(testFunctionRoleARN),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestQueueChanges() *provider.Changes {
+	return &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceName: "failedOrdersQueue",
+			ResourceWithResolvedSubs: &provider.ResolvedResource{
+				Spec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(testQueueARN),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestQueueLinkState(destinationType string) *state.LinkState {
+	return &state.LinkState{
+		LinkID: "test-link",
+		Data: map[string]*core.MappingNode{
+			"ordersFunction": {
+				Fields: map[string]*core.MappingNode{
+					"destinationConfig": {
+						Fields: map[string]*core.MappingNode{
+							destinationType: core.MappingNodeFromString(testQueueARN),
+						},
+					},
+				},
+			},
+			"sendMessagePolicy": {
+				Fields: map[string]*core.MappingNode{
+					"roleArn":   core.MappingNodeFromString(testFunctionRoleARN),
+					"targetArn": core.MappingNodeFromString(testQueueARN),
+				},
+			},
+		},
+	}
+}
+
+func TestFunctionDestinationLinkStageChangesSuite(t *testing.T) {
+	suite.Run(t, new(FunctionDestinationLinkStageChangesSuite))
+}
@@ -0,0 +1,255 @@
+package interservicelinks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	lambdalinks "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (l *lambdaFunctionDestinationLinkActions) UpdateResourceA(
+	ctx context.Context,
+	input *provider.LinkUpdateResourceInput,
+) (*provider.LinkUpdateResourceOutput, error) {
+	lambdaService, err := l.getLambdaService(
+		ctx,
+		provider.NewProviderContextFromLinkContext(
+			input.LinkContext,
+			"aws",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionARN, hasFunctionARN := getSpecStringValue(input.ResourceInfo, "$.arn")
+	if !hasFunctionARN {
+		return nil, fmt.Errorf(
+			"function ARN could not be retrieved from the linked from %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceInfo),
+		)
+	}
+
+	targetARN, hasTargetARN := getSpecStringValue(input.OtherResourceInfo, "$.arn")
+	if !hasTargetARN {
+		return nil, fmt.Errorf(
+			"ARN could not be retrieved from the linked to %q %s resource",
+			getResourceNameFromResourceInfo(input.OtherResourceInfo),
+			l.target.resourceType,
+		)
+	}
+
+	destinationType := ""
+	if input.LinkUpdateType != provider.LinkUpdateTypeDestroy {
+		destinationType = lambdalinks.DestinationTypeFromResourceInfo(
+			input.ResourceInfo,
+			getResourceNameFromResourceInfo(input.OtherResourceInfo),
+		)
+	}
+
+	linkData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{},
+	}
+	if destinationType != "" {
+		// The target is configured as a destination once the execution role
+		// has been granted permission to send to the target when the intermediary
+		// resources are updated, Lambda rejects a destination that the execution
+		// role is not allowed to send to.
+		lambdalinks.AddDestinationConfigLinkData(
+			linkData,
+			getResourceNameFromResourceInfo(input.ResourceInfo),
+			lambdalinks.DestinationConfigLinkData(destinationType, targetARN),
+		)
+		return &provider.LinkUpdateResourceOutput{
+			LinkData: linkData,
+		}, nil
+	}
+
+	// The target is removed from the destinations of the function before
+	// the permission to send to the target is removed from the execution role,
+	// only a destination recorded in the link data is removed so that a destination
+	// for the target configured by other means is left in place.
+	err = lambdalinks.RemoveFunctionDestination(
+		ctx,
+		functionARN,
+		targetARN,
+		lambdalinks.RecordedDestinationTypes(input),
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: linkData,
+	}, nil
+}
+
+func (l *lambdaFunctionDestinationLinkActions) UpdateResourceB(
+	ctx context.Context,
+	input *provider.LinkUpdateResourceInput,
+) (*provider.LinkUpdateResourceOutput, error) {
+	// The target resource is not updated as a part of the link update,
+	// the link only requires updates to the linked from function
+	// and its execution role.
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}, nil
+}
+
+func (l *lambdaFunctionDestinationLinkActions) UpdateIntermediaryResources(
+	ctx context.Context,
+	input *provider.LinkUpdateIntermediaryResourcesInput,
+) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
+	iamService, err := l.getIAMService(
+		ctx,
+		provider.NewProviderContextFromLinkContext(
+			input.LinkContext,
+			"aws",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roleARN, hasRoleARN := getSpecStringValue(input.ResourceAInfo, "$.role")
+	if !hasRoleARN {
+		return nil, fmt.Errorf(
+			"execution role ARN could not be retrieved from the linked from %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceAInfo),
+		)
+	}
+
+	functionARN, hasFunctionARN := getSpecStringValue(input.ResourceAInfo, "$.arn")
+	if !hasFunctionARN {
+		return nil, fmt.Errorf(
+			"function ARN could not be retrieved from the linked from %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceAInfo),
+		)
+	}
+
+	targetARN, hasTargetARN := getSpecStringValue(input.ResourceBInfo, "$.arn")
+	if !hasTargetARN {
+		return nil, fmt.Errorf(
+			"ARN could not be retrieved from the linked to %q %s resource",
+			getResourceNameFromResourceInfo(input.ResourceBInfo),
+			l.target.resourceType,
+		)
+	}
+
+	roleName := lambdalinks.RoleNameFromARN(roleARN)
+	policyName := lambdalinks.ExecutionRolePolicyName(
+		functionARN,
+		l.target.policyNameAction,
+		l.target.nameFromARN(targetARN),
+	)
+
+	destinationType := ""
+	if input.LinkUpdateType != provider.LinkUpdateTypeDestroy {
+		destinationType = lambdalinks.DestinationTypeFromResourceInfo(
+			input.ResourceAInfo,
+			getResourceNameFromResourceInfo(input.ResourceBInfo),
+		)
+	}
+
+	// Permission to send to the target is only granted when the target
+	// is configured as a destination for the function, a policy granted
+	// by a previous version of the link is removed when the target
+	// is no longer a destination.
+	if destinationType == "" {
+		_, err := iamService.DeleteRolePolicy(
+			ctx,
+			&iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(policyName),
+			},
+		)
+		if err != nil && !isNoSuchEntityError(err) {
+			return nil, err
+		}
+
+		return &provider.LinkUpdateIntermediaryResourcesOutput{
+			IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		}, nil
+	}
+
+	_, err = iamService.PutRolePolicy(
+		ctx,
+		&iam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String(policyName),
+			PolicyDocument: aws.String(l.target.policyDocument(targetARN)),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The policy is removed from the role recorded in the link data when the
+	// execution role of the function has changed, otherwise the previous role
+	// would keep the permission to send to the target.
+	previousRoleARN, hasPreviousRoleARN := getPreviousLinkDataValue(
+		input.Changes,
+		l.target.policyLinkDataKey+".roleArn",
+	)
+	if hasPreviousRoleARN && previousRoleARN != roleARN {
+		_, err := iamService.DeleteRolePolicy(
+			ctx,
+			&iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(lambdalinks.RoleNameFromARN(previousRoleARN)),
+				PolicyName: aws.String(policyName),
+			},
+		)
+		if err != nil && !isNoSuchEntityError(err) {
+			return nil, err
+		}
+	}
+
+	lambdaService, err := l.getLambdaService(
+		ctx,
+		provider.NewProviderContextFromLinkContext(
+			input.LinkContext,
+			"aws",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = lambdalinks.ConfigureFunctionDestination(
+		ctx,
+		functionARN,
+		targetARN,
+		destinationType,
+		lambdaService,
+		l.destinationRetry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkUpdateIntermediaryResourcesOutput{
+		IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				l.target.policyLinkDataKey: {
+					Fields: map[string]*core.MappingNode{
+						"roleArn":    core.MappingNodeFromString(roleARN),
+						"targetArn":  core.MappingNodeFromString(targetARN),
+						"policyName": core.MappingNodeFromString(policyName),
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,1020 @@
+package interservicelinks
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testFunctionARN      = "arn:aws:lambda:us-west-2:123456789012:function:orders"
+	testFunctionRoleARN  = "arn:aws:iam::123456789012:role/service-role/orders-function-role"
+	testQueueARN         = "arn:aws:sqs:us-west-2:123456789012:failed-orders"
+	testTopicARN         = "arn:aws:sns:us-west-2:123456789012:order-processed"
+	testEventBusARN      = "arn:aws:events:us-west-2:123456789012:event-bus/orders"
+	testOtherDestination = "arn:aws:sqs:us-west-2:123456789012:audit"
+)
+
+type FunctionDestinationLinkUpdateSuite struct {
+	suite.Suite
+}
+
+// Each intermediary resources test case needs its own IAM service mock
+// and link constructor as the IAM service is not a part of the link service
+// dependencies provided by the test case runner.
+// The lambda service mock calls are checked separately as the test case
+// runner only checks the calls made to the intermediaries service mock.
+type functionDestinationIntermediariesTestCase struct {
+	iamService             iamservice.Service
+	createLink             functionDestinationLinkConstructor
+	lambdaServiceMockCalls *plugintestutils.MockCalls
+	lambdaActionsCalled    map[string]any
+	testCase               plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]
+}
+
+type functionDestinationLinkConstructor func(
+	pluginutils.LinkServiceDeps[*aws.Config, lambdaservice.Service, *aws.Config, lambdaservice.Service],
+	pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
+) provider.Link
+
+func (s *FunctionDestinationLinkUpdateSuite) Test_link_update_resources() {
+	loader := &testutils.MockAWSConfigLoader{}
+	linkCtx := createTestLinkContext()
+
+	testCases := []plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		s.createUpdateLinkDeferDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkNoDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkRemoveDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkDeselectDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkUnrecordedDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkErrorGetEventInvokeConfigTestCase(linkCtx, loader),
+		s.createUpdateLinkErrorTargetMissingARNTestCase(linkCtx, loader),
+	}
+
+	plugintestutils.RunLinkUpdateResourceTestCases(
+		testCases,
+		createTestFunctionDestinationLink(
+			LambdaFunctionSQSQueueLink,
+			iammock.CreateIamServiceMockFactory(),
+			loader,
+		),
+		&s.Suite,
+	)
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) Test_link_update_intermediary_resources() {
+	loader := &testutils.MockAWSConfigLoader{}
+	linkCtx := createTestLinkContext()
+
+	testCases := []functionDestinationIntermediariesTestCase{
+		s.createUpdateIntermediariesQueuePolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesRoleChangedTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesTopicPolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesEventBusPolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesDeletePolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesNoDestinationTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesMissingRoleTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesConfigureDestinationErrorTestCase(linkCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		iamService := tc.iamService
+		plugintestutils.RunLinkUpdateIntermediaryResourcesTestCases(
+			[]plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+				*aws.Config,
+				lambdaservice.Service,
+				*aws.Config,
+				lambdaservice.Service,
+			]{tc.testCase},
+			createTestFunctionDestinationLink(
+				tc.createLink,
+				func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+					return iamService
+				},
+				loader,
+			),
+			&s.Suite,
+		)
+
+		for methodName, expectedInput := range tc.lambdaActionsCalled {
+			tc.lambdaServiceMockCalls.AssertCalledWith(
+				&s.Suite,
+				methodName,
+				0,
+				plugintestutils.Any,
+				expectedInput,
+			)
+		}
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkDeferDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Defers configuring the queue as a destination until permission has been granted",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeCreate,
+			ResourceInfo: createTestFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.failedOrdersQueue.destination": core.MappingNodeFromString(
+						"onFailure",
+					),
+				},
+			),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: createTestDestinationConfigLinkData("onFailure", testQueueARN),
+		},
+		UpdateActionsNotCalled: []string{
+			"GetFunctionEventInvokeConfig",
+			"PutFunctionEventInvokeConfig",
+			"UpdateFunctionEventInvokeConfig",
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkNoDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigError(
+			&types.ResourceNotFoundException{
+				Message: aws.String("The function doesn't have an EventInvokeConfig"),
+			},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Does not create an event invoke config when the queue is not a destination",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeCreate,
+			ResourceInfo:      createTestFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"PutFunctionEventInvokeConfig",
+			"UpdateFunctionEventInvokeConfig",
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkRemoveDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigOutput(
+			createTestEventInvokeConfigOutput(testOtherDestination, testQueueARN),
+		),
+		lambdamock.WithUpdateFunctionEventInvokeConfigOutput(
+			&lambda.UpdateFunctionEventInvokeConfigOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Removes only the queue destination when the link is destroyed",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeDestroy,
+			ResourceInfo: createTestFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.failedOrdersQueue.destination": core.MappingNodeFromString(
+						"onFailure",
+					),
+				},
+			),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsCalled: map[string]any{
+			"UpdateFunctionEventInvokeConfig": &lambda.UpdateFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(testFunctionARN),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: aws.String(testOtherDestination),
+					},
+					OnFailure: &types.OnFailure{},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkDeselectDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigOutput(
+			createTestEventInvokeConfigOutput(testOtherDestination, testQueueARN),
+		),
+		lambdamock.WithUpdateFunctionEventInvokeConfigOutput(
+			&lambda.UpdateFunctionEventInvokeConfigOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Removes the queue destination when the queue is no longer selected as a destination",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeUpdate,
+			ResourceInfo:      createTestFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+			Changes: &provider.LinkChanges{
+				RemovedFields: []string{
+					"[\"ordersFunction\"].destinationConfig.onFailure",
+				},
+			},
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsCalled: map[string]any{
+			"UpdateFunctionEventInvokeConfig": &lambda.UpdateFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(testFunctionARN),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: aws.String(testOtherDestination),
+					},
+					OnFailure: &types.OnFailure{},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkUnrecordedDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	// The queue is a destination for the function that was configured by other means,
+	// such as an event invoke config resource, and not by the link.
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigOutput(
+			createTestEventInvokeConfigOutput(testOtherDestination, testQueueARN),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Leaves a queue destination that was not configured by the link in place",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeUpdate,
+			ResourceInfo:      createTestFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+			Changes:           &provider.LinkChanges{},
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"GetFunctionEventInvokeConfig",
+			"UpdateFunctionEventInvokeConfig",
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkErrorGetEventInvokeConfigTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigError(
+			errors.New("throttled"),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Fails when the current event invoke config can not be retrieved",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeDestroy,
+			ResourceInfo: createTestFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.failedOrdersQueue.destination": core.MappingNodeFromString(
+						"onFailure",
+					),
+				},
+			),
+			OtherResourceInfo: createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+			LinkContext:       linkCtx,
+		},
+		ExpectError: true,
+		ExpectedErrorMessage: "failed to get event invoke config for function " +
+			testFunctionARN + ": throttled",
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateLinkErrorTargetMissingARNTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Fails when the ARN of the queue is missing",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeCreate,
+			ResourceInfo:   createTestFunctionResourceInfo(nil),
+			OtherResourceInfo: &provider.ResourceInfo{
+				ResourceName: "failedOrdersQueue",
+			},
+			LinkContext: linkCtx,
+		},
+		ExpectError: true,
+		ExpectedErrorMessage: "ARN could not be retrieved from the linked to " +
+			"\"failedOrdersQueue\" aws/sqs/queue resource",
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesQueuePolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	return createPutDestinationPolicyTestCase(
+		"Adds send message policy to the function execution role",
+		LambdaFunctionSQSQueueLink,
+		createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+		"sendMessagePolicy",
+		"bluelink-link-orders-send-failed-orders",
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],`+
+			`"Resource":["arn:aws:sqs:us-west-2:123456789012:failed-orders"]}]}`,
+		linkCtx,
+		loader,
+	)
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesRoleChangedTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	tc := createPutDestinationPolicyTestCase(
+		"Moves send message policy from the previous execution role to the new execution role",
+		LambdaFunctionSQSQueueLink,
+		createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+		"sendMessagePolicy",
+		"bluelink-link-orders-send-failed-orders",
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],`+
+			`"Resource":["arn:aws:sqs:us-west-2:123456789012:failed-orders"]}]}`,
+		linkCtx,
+		loader,
+	)
+	tc.testCase.Input.Changes = &provider.LinkChanges{
+		ModifiedFields: []*provider.FieldChange{
+			{
+				FieldPath: "sendMessagePolicy.roleArn",
+				PrevValue: core.MappingNodeFromString(
+					"arn:aws:iam::123456789012:role/service-role/legacy-orders-role",
+				),
+				NewValue: core.MappingNodeFromString(testFunctionRoleARN),
+			},
+		},
+	}
+	tc.testCase.UpdateActionsCalled["DeleteRolePolicy"] = &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String("legacy-orders-role"),
+		PolicyName: aws.String("bluelink-link-orders-send-failed-orders"),
+	}
+
+	return tc
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesTopicPolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	return createPutDestinationPolicyTestCase(
+		"Adds publish policy to the function execution role",
+		LambdaFunctionSNSTopicLink,
+		createTestDestinationResourceInfo("orderProcessedTopic", testTopicARN),
+		"publishPolicy",
+		"bluelink-link-orders-publish-order-processed",
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sns:Publish"],`+
+			`"Resource":["arn:aws:sns:us-west-2:123456789012:order-processed"]}]}`,
+		linkCtx,
+		loader,
+	)
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesEventBusPolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	return createPutDestinationPolicyTestCase(
+		"Adds put events policy to the function execution role",
+		LambdaFunctionEventBusLink,
+		createTestDestinationResourceInfo("ordersEventBus", testEventBusARN),
+		"putEventsPolicy",
+		"bluelink-link-orders-put-events-orders",
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["events:PutEvents"],`+
+			`"Resource":["arn:aws:events:us-west-2:123456789012:event-bus/orders"]}]}`,
+		linkCtx,
+		loader,
+	)
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesDeletePolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithDeleteRolePolicyOutput(&iam.DeleteRolePolicyOutput{}),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionDestinationIntermediariesTestCase{
+		iamService: iamService,
+		createLink: LambdaFunctionSQSQueueLink,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Removes send message policy from the function execution role",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+				LinkUpdateType: provider.LinkUpdateTypeDestroy,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"DeleteRolePolicy": &iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("orders-function-role"),
+					PolicyName: aws.String("bluelink-link-orders-send-failed-orders"),
+				},
+			},
+			UpdateActionsNotCalled: []string{
+				"PutRolePolicy",
+			},
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesNoDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithDeleteRolePolicyOutput(&iam.DeleteRolePolicyOutput{}),
+	)
+	lambdaService := lambdamock.CreateLambdaServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(lambdaService, loader)
+
+	return functionDestinationIntermediariesTestCase{
+		iamService: iamService,
+		createLink: LambdaFunctionSQSQueueLink,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Does not grant permission to send to the queue when the queue is not a destination",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"DeleteRolePolicy": &iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("orders-function-role"),
+					PolicyName: aws.String("bluelink-link-orders-send-failed-orders"),
+				},
+			},
+			UpdateActionsNotCalled: []string{
+				"PutRolePolicy",
+			},
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesMissingRoleTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionDestinationIntermediariesTestCase{
+		iamService: iamService,
+		createLink: LambdaFunctionSNSTopicLink,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Fails when the function execution role is missing",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo: &provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					CurrentResourceState: &state.ResourceState{
+						SpecData: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"arn": core.MappingNodeFromString(testFunctionARN),
+							},
+						},
+					},
+				},
+				ResourceBInfo:  createTestDestinationResourceInfo("orderProcessedTopic", testTopicARN),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectError: true,
+			ExpectedErrorMessage: "execution role ARN could not be retrieved from the linked from " +
+				"\"ordersFunction\" function resource",
+		},
+	}
+}
+
+func (s *FunctionDestinationLinkUpdateSuite) createUpdateIntermediariesConfigureDestinationErrorTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithPutRolePolicyOutput(&iam.PutRolePolicyOutput{}),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(
+			lambdamock.WithGetFunctionEventInvokeConfigError(
+				&types.ResourceNotFoundException{
+					Message: aws.String("The function doesn't have an EventInvokeConfig"),
+				},
+			),
+			lambdamock.WithPutFunctionEventInvokeConfigError(
+				errors.New("throttled"),
+			),
+		),
+		loader,
+	)
+
+	return functionDestinationIntermediariesTestCase{
+		iamService: iamService,
+		createLink: LambdaFunctionSQSQueueLink,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Fails when the queue can not be configured as a destination",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo: createTestFunctionResourceInfo(
+					map[string]*core.MappingNode{
+						"aws.lambda.function.failedOrdersQueue.destination": core.MappingNodeFromString(
+							"onFailure",
+						),
+					},
+				),
+				ResourceBInfo:  createTestDestinationResourceInfo("failedOrdersQueue", testQueueARN),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectError: true,
+			ExpectedErrorMessage: "failed to create event invoke config for function " +
+				testFunctionARN + ": throttled",
+		},
+	}
+}
+
+func createPutDestinationPolicyTestCase(
+	name string,
+	createLink functionDestinationLinkConstructor,
+	targetResourceInfo *provider.ResourceInfo,
+	policyLinkDataKey string,
+	expectedPolicyName string,
+	expectedPolicyDocument string,
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionDestinationIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithPutRolePolicyOutput(&iam.PutRolePolicyOutput{}),
+	)
+	lambdaService := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigError(
+			&types.ResourceNotFoundException{
+				Message: aws.String("The function doesn't have an EventInvokeConfig"),
+			},
+		),
+		lambdamock.WithPutFunctionEventInvokeConfigOutput(
+			&lambda.PutFunctionEventInvokeConfigOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(lambdaService, loader)
+	targetARN := targetResourceInfo.CurrentResourceState.SpecData.Fields["arn"]
+	destinationAnnotation := fmt.Sprintf(
+		"aws.lambda.function.%s.destination",
+		targetResourceInfo.ResourceName,
+	)
+
+	return functionDestinationIntermediariesTestCase{
+		iamService:             iamService,
+		createLink:             createLink,
+		lambdaServiceMockCalls: &lambdaService.MockCalls,
+		// The target is only configured as a destination once
+		// the execution role has been granted permission to send to it.
+		lambdaActionsCalled: map[string]any{
+			"PutFunctionEventInvokeConfig": &lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(testFunctionARN),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: targetARN.Scalar.StringValue,
+					},
+					OnFailure: &types.OnFailure{},
+				},
+			},
+		},
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           name,
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo: createTestFunctionResourceInfo(
+					map[string]*core.MappingNode{
+						destinationAnnotation: core.MappingNodeFromString("onSuccess"),
+					},
+				),
+				ResourceBInfo:  targetResourceInfo,
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						policyLinkDataKey: {
+							Fields: map[string]*core.MappingNode{
+								"roleArn":    core.MappingNodeFromString(testFunctionRoleARN),
+								"targetArn":  targetARN,
+								"policyName": core.MappingNodeFromString(expectedPolicyName),
+							},
+						},
+					},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"PutRolePolicy": &iam.PutRolePolicyInput{
+					RoleName:       aws.String("orders-function-role"),
+					PolicyName:     aws.String(expectedPolicyName),
+					PolicyDocument: aws.String(expectedPolicyDocument),
+				},
+			},
+		},
+	}
+}
+
+// createTestFunctionDestinationLink wraps a destination link constructor
+// so the IAM service used to manage the execution role policy can be injected
+// alongside the lambda services provided by the test case runners.
+func createTestFunctionDestinationLink(
+	createLink functionDestinationLinkConstructor,
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	loader *testutils.MockAWSConfigLoader,
+) func(
+	pluginutils.LinkServiceDeps[*aws.Config, lambdaservice.Service, *aws.Config, lambdaservice.Service],
+) provider.Link {
+	return func(
+		linkServiceDeps pluginutils.LinkServiceDeps[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		],
+	) provider.Link {
+		return createLink(
+			linkServiceDeps,
+			pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+				ServiceFactory: iamServiceFactory,
+				ConfigStore: utils.NewAWSConfigStore(
+					[]string{},
+					utils.AWSConfigFromProviderContext,
+					loader,
+					utils.AWSConfigCacheKey,
+				),
+			},
+		)
+	}
+}
+
+func createTestLinkContext() provider.LinkContext {
+	return plugintestutils.NewTestLinkContext(
+		map[string]map[string]*core.ScalarValue{
+			"aws": {
+				"region": core.ScalarFromString("us-west-2"),
+			},
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func createLambdaServiceDeps(
+	service lambdaservice.Service,
+	loader *testutils.MockAWSConfigLoader,
+) (
+	func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service,
+	*utils.AWSConfigStore,
+) {
+	configStore := utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		loader,
+		utils.AWSConfigCacheKey,
+	)
+	serviceFactory := func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+		return service
+	}
+	return serviceFactory, configStore
+}
+
+func createTestFunctionResourceInfo(
+	annotations map[string]*core.MappingNode,
+) *provider.ResourceInfo {
+	resourceInfo := &provider.ResourceInfo{
+		ResourceName: "ordersFunction",
+		CurrentResourceState: &state.ResourceState{
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":  core.MappingNodeFromString(testFunctionARN),
+					"role": core.MappingNodeFromString(testFunctionRoleARN),
+				},
+			},
+		},
+	}
+
+	if annotations != nil {
+		resourceInfo.ResourceWithResolvedSubs = &provider.ResolvedResource{
+			Metadata: &provider.ResolvedResourceMetadata{
+				Annotations: &core.MappingNode{
+					Fields: annotations,
+				},
+			},
+		}
+	}
+
+	return resourceInfo
+}
+
+func createTestDestinationResourceInfo(resourceName string, arn string) *provider.ResourceInfo {
+	return &provider.ResourceInfo{
+		ResourceName: resourceName,
+		CurrentResourceState: &state.ResourceState{
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString(arn),
+				},
+			},
+		},
+	}
+}
+
+func createTestDestinationConfigLinkData(destinationType string, targetARN string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"ordersFunction": {
+				Fields: map[string]*core.MappingNode{
+					"destinationConfig": {
+						Fields: map[string]*core.MappingNode{
+							destinationType: core.MappingNodeFromString(targetARN),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestEventInvokeConfigOutput(
+	onSuccessARN string,
+	onFailureARN string,
+) *lambda.GetFunctionEventInvokeConfigOutput {
+	return &lambda.GetFunctionEventInvokeConfigOutput{
+		FunctionArn: aws.String(testFunctionARN),
+		DestinationConfig: &types.DestinationConfig{
+			OnSuccess: &types.OnSuccess{
+				Destination: aws.String(onSuccessARN),
+			},
+			OnFailure: &types.OnFailure{
+				Destination: aws.String(onFailureARN),
+			},
+		},
+	}
+}
+
+func TestFunctionDestinationLinkUpdateSuite(t *testing.T) {
+	suite.Run(t, new(FunctionDestinationLinkUpdateSuite))
+}
@@ -0,0 +1,52 @@
+package interservicelinks
+
+import (
+	"errors"
+
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func getSpecStringValue(resourceInfo *provider.ResourceInfo, fieldPath string) (string, bool) {
+	value, hasValue := pluginutils.GetValueByPath(
+		fieldPath,
+		pluginutils.GetCurrentStateSpecDataFromResourceInfo(resourceInfo),
+	)
+	if !hasValue {
+		return "", false
+	}
+
+	return core.StringValue(value), true
+}
+
+// getPreviousLinkDataValue returns the value that was recorded in the link data
+// for a field that has been modified in the staged link changes.
+func getPreviousLinkDataValue(changes *provider.LinkChanges, linkFieldPath string) (string, bool) {
+	if changes == nil {
+		return "", false
+	}
+
+	for _, fieldChange := range changes.ModifiedFields {
+		if fieldChange.FieldPath == linkFieldPath &&
+			!core.IsNilMappingNode(fieldChange.PrevValue) {
+			return core.StringValue(fieldChange.PrevValue), true
+		}
+	}
+
+	return "", false
+}
+
+func getResourceNameFromResourceInfo(resourceInfo *provider.ResourceInfo) string {
+	if resourceInfo == nil {
+		return "unknown"
+	}
+
+	return resourceInfo.ResourceName
+}
+
+func isNoSuchEntityError(err error) bool {
+	var apiError smithy.APIError
+	return errors.As(err, &apiError) && apiError.ErrorCode() == "NoSuchEntity"
+}
@@ -0,0 +1,39 @@
+package interservicelinks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+var eventBusDestinationTarget = &destinationTarget{
+	resourceType:      "aws/events/eventBus",
+	label:             "Event Bus",
+	targetPlaceholder: "<targetEventBus>",
+	action:            "events:PutEvents",
+	policyNameAction:  "put-events",
+	policyLinkDataKey: "putEventsPolicy",
+	descriptionFile:   "descriptions/lambda_function__events_event_bus.md",
+	summary: "A link that configures an EventBridge event bus as a destination for asynchronous invocations " +
+		"of a lambda function and grants the function permission to send to the event bus.",
+	nameFromARN: eventBusNameFromARN,
+}
+
+// LambdaFunctionEventBusLink returns a link implementation for
+// a link from a lambda function to an EventBridge event bus.
+// The lambda function will be given permission to put events on the event bus
+// and can be configured with annotations to use the event bus as a destination
+// for asynchronous invocations.
+func LambdaFunctionEventBusLink(
+	linkServiceDeps pluginutils.LinkServiceDeps[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	],
+	iamService pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
+) provider.Link {
+	return functionDestinationLink(eventBusDestinationTarget, linkServiceDeps, iamService)
+}
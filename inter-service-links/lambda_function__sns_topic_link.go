@@ -0,0 +1,39 @@
+package interservicelinks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+var topicDestinationTarget = &destinationTarget{
+	resourceType:      "aws/sns/topic",
+	label:             "Topic",
+	targetPlaceholder: "<targetTopic>",
+	action:            "sns:Publish",
+	policyNameAction:  "publish",
+	policyLinkDataKey: "publishPolicy",
+	descriptionFile:   "descriptions/lambda_function__sns_topic.md",
+	summary: "A link that configures an SNS topic as a destination for asynchronous invocations " +
+		"of a lambda function and grants the function permission to send to the topic.",
+	nameFromARN: lastARNSegment,
+}
+
+// LambdaFunctionSNSTopicLink returns a link implementation for
+// a link from a lambda function to an SNS topic.
+// The lambda function will be given permission to publish messages to the topic
+// and can be configured with annotations to use the topic as a destination
+// for asynchronous invocations.
+func LambdaFunctionSNSTopicLink(
+	linkServiceDeps pluginutils.LinkServiceDeps[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	],
+	iamService pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
+) provider.Link {
+	return functionDestinationLink(topicDestinationTarget, linkServiceDeps, iamService)
+}
@@ -0,0 +1,39 @@
+package interservicelinks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+var queueDestinationTarget = &destinationTarget{
+	resourceType:      "aws/sqs/queue",
+	label:             "Queue",
+	targetPlaceholder: "<targetQueue>",
+	action:            "sqs:SendMessage",
+	policyNameAction:  "send",
+	policyLinkDataKey: "sendMessagePolicy",
+	descriptionFile:   "descriptions/lambda_function__sqs_queue.md",
+	summary: "A link that configures an SQS queue as a destination for asynchronous invocations " +
+		"of a lambda function and grants the function permission to send to the queue.",
+	nameFromARN: lastARNSegment,
+}
+
+// LambdaFunctionSQSQueueLink returns a link implementation for
+// a link from a lambda function to an SQS queue.
+// The lambda function will be given permission to send messages to the queue
+// and can be configured with annotations to use the queue as a destination
+// for asynchronous invocations.
+func LambdaFunctionSQSQueueLink(
+	linkServiceDeps pluginutils.LinkServiceDeps[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	],
+	iamService pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
+) provider.Link {
+	return functionDestinationLink(queueDestinationTarget, linkServiceDeps, iamService)
+}
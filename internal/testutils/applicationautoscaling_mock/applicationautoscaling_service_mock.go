@@ -0,0 +1,276 @@
+package applicationautoscalingmock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type applicationAutoScalingServiceMock struct {
+	plugintestutils.MockCalls
+
+	registerScalableTargetOutput *applicationautoscaling.RegisterScalableTargetOutput
+	registerScalableTargetError  error
+
+	deregisterScalableTargetOutput *applicationautoscaling.DeregisterScalableTargetOutput
+	deregisterScalableTargetError  error
+
+	describeScalableTargetsOutput *applicationautoscaling.DescribeScalableTargetsOutput
+	describeScalableTargetsError  error
+
+	putScalingPolicyOutput *applicationautoscaling.PutScalingPolicyOutput
+	putScalingPolicyError  error
+
+	deleteScalingPolicyOutput *applicationautoscaling.DeleteScalingPolicyOutput
+	deleteScalingPolicyError  error
+
+	describeScalingPoliciesOutput *applicationautoscaling.DescribeScalingPoliciesOutput
+	describeScalingPoliciesError  error
+
+	putScheduledActionOutput *applicationautoscaling.PutScheduledActionOutput
+	putScheduledActionError  error
+
+	deleteScheduledActionOutput *applicationautoscaling.DeleteScheduledActionOutput
+	deleteScheduledActionError  error
+
+	describeScheduledActionsOutput *applicationautoscaling.DescribeScheduledActionsOutput
+	describeScheduledActionsError  error
+}
+
+type applicationAutoScalingServiceMockOption func(*applicationAutoScalingServiceMock)
+
+func CreateApplicationAutoScalingServiceMockFactory(
+	opts ...applicationAutoScalingServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) applicationautoscalingservice.Service {
+	mock := CreateApplicationAutoScalingServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) applicationautoscalingservice.Service {
+		return mock
+	}
+}
+
+func CreateApplicationAutoScalingServiceMock(
+	opts ...applicationAutoScalingServiceMockOption,
+) *applicationAutoScalingServiceMock {
+	mock := &applicationAutoScalingServiceMock{}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithRegisterScalableTargetOutput(
+	output *applicationautoscaling.RegisterScalableTargetOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.registerScalableTargetOutput = output
+	}
+}
+
+func WithRegisterScalableTargetError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.registerScalableTargetError = err
+	}
+}
+
+func WithDeregisterScalableTargetOutput(
+	output *applicationautoscaling.DeregisterScalableTargetOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deregisterScalableTargetOutput = output
+	}
+}
+
+func WithDeregisterScalableTargetError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deregisterScalableTargetError = err
+	}
+}
+
+func WithDescribeScalableTargetsOutput(
+	output *applicationautoscaling.DescribeScalableTargetsOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScalableTargetsOutput = output
+	}
+}
+
+func WithDescribeScalableTargetsError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScalableTargetsError = err
+	}
+}
+
+func WithPutScalingPolicyOutput(
+	output *applicationautoscaling.PutScalingPolicyOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.putScalingPolicyOutput = output
+	}
+}
+
+func WithPutScalingPolicyError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.putScalingPolicyError = err
+	}
+}
+
+func WithDeleteScalingPolicyOutput(
+	output *applicationautoscaling.DeleteScalingPolicyOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deleteScalingPolicyOutput = output
+	}
+}
+
+func WithDeleteScalingPolicyError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deleteScalingPolicyError = err
+	}
+}
+
+func WithDescribeScalingPoliciesOutput(
+	output *applicationautoscaling.DescribeScalingPoliciesOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScalingPoliciesOutput = output
+	}
+}
+
+func WithDescribeScalingPoliciesError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScalingPoliciesError = err
+	}
+}
+
+func WithPutScheduledActionOutput(
+	output *applicationautoscaling.PutScheduledActionOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.putScheduledActionOutput = output
+	}
+}
+
+func WithPutScheduledActionError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.putScheduledActionError = err
+	}
+}
+
+func WithDeleteScheduledActionOutput(
+	output *applicationautoscaling.DeleteScheduledActionOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deleteScheduledActionOutput = output
+	}
+}
+
+func WithDeleteScheduledActionError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.deleteScheduledActionError = err
+	}
+}
+
+func WithDescribeScheduledActionsOutput(
+	output *applicationautoscaling.DescribeScheduledActionsOutput,
+) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScheduledActionsOutput = output
+	}
+}
+
+func WithDescribeScheduledActionsError(err error) applicationAutoScalingServiceMockOption {
+	return func(m *applicationAutoScalingServiceMock) {
+		m.describeScheduledActionsError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *applicationAutoScalingServiceMock) RegisterScalableTarget(
+	ctx context.Context,
+	params *applicationautoscaling.RegisterScalableTargetInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.registerScalableTargetOutput, m.registerScalableTargetError
+}
+
+func (m *applicationAutoScalingServiceMock) DeregisterScalableTarget(
+	ctx context.Context,
+	params *applicationautoscaling.DeregisterScalableTargetInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DeregisterScalableTargetOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deregisterScalableTargetOutput, m.deregisterScalableTargetError
+}
+
+func (m *applicationAutoScalingServiceMock) DescribeScalableTargets(
+	ctx context.Context,
+	params *applicationautoscaling.DescribeScalableTargetsInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeScalableTargetsOutput, m.describeScalableTargetsError
+}
+
+func (m *applicationAutoScalingServiceMock) PutScalingPolicy(
+	ctx context.Context,
+	params *applicationautoscaling.PutScalingPolicyInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.PutScalingPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putScalingPolicyOutput, m.putScalingPolicyError
+}
+
+func (m *applicationAutoScalingServiceMock) DeleteScalingPolicy(
+	ctx context.Context,
+	params *applicationautoscaling.DeleteScalingPolicyInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DeleteScalingPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteScalingPolicyOutput, m.deleteScalingPolicyError
+}
+
+func (m *applicationAutoScalingServiceMock) DescribeScalingPolicies(
+	ctx context.Context,
+	params *applicationautoscaling.DescribeScalingPoliciesInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeScalingPoliciesOutput, m.describeScalingPoliciesError
+}
+
+func (m *applicationAutoScalingServiceMock) PutScheduledAction(
+	ctx context.Context,
+	params *applicationautoscaling.PutScheduledActionInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.PutScheduledActionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putScheduledActionOutput, m.putScheduledActionError
+}
+
+func (m *applicationAutoScalingServiceMock) DeleteScheduledAction(
+	ctx context.Context,
+	params *applicationautoscaling.DeleteScheduledActionInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DeleteScheduledActionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteScheduledActionOutput, m.deleteScheduledActionError
+}
+
+func (m *applicationAutoScalingServiceMock) DescribeScheduledActions(
+	ctx context.Context,
+	params *applicationautoscaling.DescribeScheduledActionsInput,
+	optFns ...func(*applicationautoscaling.Options),
+) (*applicationautoscaling.DescribeScheduledActionsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeScheduledActionsOutput, m.describeScheduledActionsError
+}
@@ -0,0 +1,66 @@
+package cloudwatchmock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type cloudwatchServiceMock struct {
+	plugintestutils.MockCalls
+
+	describeAlarmsOutput *cloudwatch.DescribeAlarmsOutput
+	describeAlarmsError  error
+}
+
+type cloudwatchServiceMockOption func(*cloudwatchServiceMock)
+
+func CreateCloudWatchServiceMockFactory(
+	opts ...cloudwatchServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) cloudwatchservice.Service {
+	mock := CreateCloudWatchServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) cloudwatchservice.Service {
+		return mock
+	}
+}
+
+func CreateCloudWatchServiceMock(
+	opts ...cloudwatchServiceMockOption,
+) *cloudwatchServiceMock {
+	mock := &cloudwatchServiceMock{}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithDescribeAlarmsOutput(output *cloudwatch.DescribeAlarmsOutput) cloudwatchServiceMockOption {
+	return func(m *cloudwatchServiceMock) {
+		m.describeAlarmsOutput = output
+	}
+}
+
+func WithDescribeAlarmsError(err error) cloudwatchServiceMockOption {
+	return func(m *cloudwatchServiceMock) {
+		m.describeAlarmsError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *cloudwatchServiceMock) DescribeAlarms(
+	ctx context.Context,
+	params *cloudwatch.DescribeAlarmsInput,
+	optFns ...func(*cloudwatch.Options),
+) (*cloudwatch.DescribeAlarmsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeAlarmsOutput, m.describeAlarmsError
+}
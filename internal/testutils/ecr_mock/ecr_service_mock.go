@@ -0,0 +1,66 @@
+package ecrmock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type ecrServiceMock struct {
+	plugintestutils.MockCalls
+
+	describeImagesOutput *ecr.DescribeImagesOutput
+	describeImagesError  error
+}
+
+type ecrServiceMockOption func(*ecrServiceMock)
+
+func CreateECRServiceMockFactory(
+	opts ...ecrServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) ecrservice.Service {
+	mock := CreateECRServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) ecrservice.Service {
+		return mock
+	}
+}
+
+func CreateECRServiceMock(
+	opts ...ecrServiceMockOption,
+) *ecrServiceMock {
+	mock := &ecrServiceMock{}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithDescribeImagesOutput(output *ecr.DescribeImagesOutput) ecrServiceMockOption {
+	return func(m *ecrServiceMock) {
+		m.describeImagesOutput = output
+	}
+}
+
+func WithDescribeImagesError(err error) ecrServiceMockOption {
+	return func(m *ecrServiceMock) {
+		m.describeImagesError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *ecrServiceMock) DescribeImages(
+	ctx context.Context,
+	params *ecr.DescribeImagesInput,
+	optFns ...func(*ecr.Options),
+) (*ecr.DescribeImagesOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeImagesOutput, m.describeImagesError
+}
@@ -76,6 +76,9 @@ type iamServiceMock struct {
 	detachUserPolicyError          error
 	listAttachedUserPoliciesOutput *iam.ListAttachedUserPoliciesOutput
 	listAttachedUserPoliciesError  error
+	// listAttachedUserPoliciesOutputSequence is consumed in order by successive calls
+	// to ListAttachedUserPolicies before falling back to listAttachedUserPoliciesOutput.
+	listAttachedUserPoliciesOutputSequence []*iam.ListAttachedUserPoliciesOutput
 
 	// User policy operations
 	putUserPolicyOutput    *iam.PutUserPolicyOutput
@@ -138,6 +141,9 @@ type iamServiceMock struct {
 	detachGroupPolicyError          error
 	listAttachedGroupPoliciesOutput *iam.ListAttachedGroupPoliciesOutput
 	listAttachedGroupPoliciesError  error
+	// listAttachedGroupPoliciesOutputSequence is consumed in order by successive calls
+	// to ListAttachedGroupPolicies before falling back to listAttachedGroupPoliciesOutput.
+	listAttachedGroupPoliciesOutputSequence []*iam.ListAttachedGroupPoliciesOutput
 
 	// Group inline policy-related mock fields
 	putGroupPolicyOutput    *iam.PutGroupPolicyOutput
@@ -186,6 +192,10 @@ type iamServiceMock struct {
 	deletePolicyVersionError  error
 	listPolicyVersionsOutput  *iam.ListPolicyVersionsOutput
 	listPolicyVersionsError   error
+	getPolicyVersionOutput    *iam.GetPolicyVersionOutput
+	getPolicyVersionError     error
+	listPoliciesOutput        *iam.ListPoliciesOutput
+	listPoliciesError         error
 
 	// Policy tag-related mock fields
 	tagPolicyOutput      *iam.TagPolicyOutput
@@ -574,6 +584,17 @@ func WithListAttachedUserPoliciesOutput(output *iam.ListAttachedUserPoliciesOutp
 	}
 }
 
+// WithListAttachedUserPoliciesOutputSequence configures the mock to return each of the provided
+// outputs in order for successive calls to ListAttachedUserPolicies,
+// where each output represents a page of results.
+func WithListAttachedUserPoliciesOutputSequence(
+	outputs ...*iam.ListAttachedUserPoliciesOutput,
+) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.listAttachedUserPoliciesOutputSequence = outputs
+	}
+}
+
 func WithListAttachedUserPoliciesError(err error) iamServiceMockOption {
 	return func(m *iamServiceMock) {
 		m.listAttachedUserPoliciesError = err
@@ -864,6 +885,17 @@ func WithListAttachedGroupPoliciesOutput(output *iam.ListAttachedGroupPoliciesOu
 	}
 }
 
+// WithListAttachedGroupPoliciesOutputSequence configures the mock to return each of the provided
+// outputs in order for successive calls to ListAttachedGroupPolicies,
+// where each output represents a page of results.
+func WithListAttachedGroupPoliciesOutputSequence(
+	outputs ...*iam.ListAttachedGroupPoliciesOutput,
+) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.listAttachedGroupPoliciesOutputSequence = outputs
+	}
+}
+
 func WithListAttachedGroupPoliciesError(err error) iamServiceMockOption {
 	return func(m *iamServiceMock) {
 		m.listAttachedGroupPoliciesError = err
@@ -1101,6 +1133,30 @@ func WithListPolicyVersionsError(err error) iamServiceMockOption {
 	}
 }
 
+func WithGetPolicyVersionOutput(output *iam.GetPolicyVersionOutput) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.getPolicyVersionOutput = output
+	}
+}
+
+func WithGetPolicyVersionError(err error) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.getPolicyVersionError = err
+	}
+}
+
+func WithListPoliciesOutput(output *iam.ListPoliciesOutput) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.listPoliciesOutput = output
+	}
+}
+
+func WithListPoliciesError(err error) iamServiceMockOption {
+	return func(m *iamServiceMock) {
+		m.listPoliciesError = err
+	}
+}
+
 func WithTagPolicyOutput(output *iam.TagPolicyOutput) iamServiceMockOption {
 	return func(m *iamServiceMock) {
 		m.tagPolicyOutput = output
@@ -1477,6 +1533,11 @@ func (m *iamServiceMock) ListAttachedGroupPolicies(
 	optFns ...func(*iam.Options),
 ) (*iam.ListAttachedGroupPoliciesOutput, error) {
 	m.RegisterCall(ctx, params)
+	if len(m.listAttachedGroupPoliciesOutputSequence) > 0 {
+		output := m.listAttachedGroupPoliciesOutputSequence[0]
+		m.listAttachedGroupPoliciesOutputSequence = m.listAttachedGroupPoliciesOutputSequence[1:]
+		return output, m.listAttachedGroupPoliciesError
+	}
 	return m.listAttachedGroupPoliciesOutput, m.listAttachedGroupPoliciesError
 }
 
@@ -1731,6 +1792,11 @@ func (m *iamServiceMock) ListAttachedUserPolicies(
 	optFns ...func(*iam.Options),
 ) (*iam.ListAttachedUserPoliciesOutput, error) {
 	m.RegisterCall(ctx, params)
+	if len(m.listAttachedUserPoliciesOutputSequence) > 0 {
+		output := m.listAttachedUserPoliciesOutputSequence[0]
+		m.listAttachedUserPoliciesOutputSequence = m.listAttachedUserPoliciesOutputSequence[1:]
+		return output, m.listAttachedUserPoliciesError
+	}
 	return m.listAttachedUserPoliciesOutput, m.listAttachedUserPoliciesError
 }
 
@@ -2036,6 +2102,24 @@ func (m *iamServiceMock) ListPolicyVersions(
 	return m.listPolicyVersionsOutput, m.listPolicyVersionsError
 }
 
+func (m *iamServiceMock) GetPolicyVersion(
+	ctx context.Context,
+	params *iam.GetPolicyVersionInput,
+	optFns ...func(*iam.Options),
+) (*iam.GetPolicyVersionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getPolicyVersionOutput, m.getPolicyVersionError
+}
+
+func (m *iamServiceMock) ListPolicies(
+	ctx context.Context,
+	params *iam.ListPoliciesInput,
+	optFns ...func(*iam.Options),
+) (*iam.ListPoliciesOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.listPoliciesOutput, m.listPoliciesError
+}
+
 func (m *iamServiceMock) TagPolicy(
 	ctx context.Context,
 	params *iam.TagPolicyInput,
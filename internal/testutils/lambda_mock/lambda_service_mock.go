@@ -15,6 +15,28 @@ type lambdaServiceMock struct {
 
 	getFunctionOutput *lambda.GetFunctionOutput
 	getFunctionError  error
+	// getFunctionOutputSequence is consumed in order by successive calls
+	// to GetFunction before falling back to getFunctionOutput.
+	getFunctionOutputSequence []*lambda.GetFunctionOutput
+
+	// listFunctionsOutputSequence is consumed in order by successive calls
+	// to ListFunctions to simulate pages of results.
+	listFunctionsOutputSequence []*lambda.ListFunctionsOutput
+	listFunctionsError          error
+
+	// listVersionsByFunctionOutputSequence is consumed in order by successive calls
+	// to ListVersionsByFunction to simulate pages of results.
+	listVersionsByFunctionOutputSequence []*lambda.ListVersionsByFunctionOutput
+	listVersionsByFunctionError          error
+
+	listAliasesOutput *lambda.ListAliasesOutput
+	listAliasesError  error
+
+	listProvisionedConcurrencyConfigsOutput *lambda.ListProvisionedConcurrencyConfigsOutput
+	listProvisionedConcurrencyConfigsError  error
+
+	listEventSourceMappingsOutput *lambda.ListEventSourceMappingsOutput
+	listEventSourceMappingsError  error
 
 	getFunctionCodeSigningOutput *lambda.GetFunctionCodeSigningConfigOutput
 	getFunctionCodeSigningError  error
@@ -25,6 +47,9 @@ type lambdaServiceMock struct {
 	getFunctionConcurrencyOutput *lambda.GetFunctionConcurrencyOutput
 	getFunctionConcurrencyError  error
 
+	getAccountSettingsOutput *lambda.GetAccountSettingsOutput
+	getAccountSettingsError  error
+
 	// Get Provisioned Concurrency-related mock fields
 	getProvisionedConcurrencyOutput *lambda.GetProvisionedConcurrencyConfigOutput
 	getProvisionedConcurrencyError  error
@@ -32,6 +57,9 @@ type lambdaServiceMock struct {
 	// Delete Function-related mock fields
 	deleteFunctionOutput *lambda.DeleteFunctionOutput
 	deleteFunctionError  error
+	// deleteFunctionErrorByQualifier allows DeleteFunction calls for specific
+	// qualifiers to fail, taking precedence over deleteFunctionError.
+	deleteFunctionErrorByQualifier map[string]error
 
 	// Update Function Configuration-related mock fields
 	updateFunctionConfigurationOutput *lambda.UpdateFunctionConfigurationOutput
@@ -60,6 +88,9 @@ type lambdaServiceMock struct {
 	untagResourceError  error
 	listTagsOutput      *lambda.ListTagsOutput
 	listTagsError       error
+	// listTagsOutputByResource holds outputs for ListTags keyed by
+	// resource ARN, falling back to listTagsOutput for other resources.
+	listTagsOutputByResource map[string]*lambda.ListTagsOutput
 
 	// Create Function-related mock fields
 	createFunctionOutput *lambda.CreateFunctionOutput
@@ -121,6 +152,11 @@ type lambdaServiceMock struct {
 	deleteLayerVersionOutput  *lambda.DeleteLayerVersionOutput
 	deleteLayerVersionError   error
 
+	// listLayerVersionsOutputSequence is consumed in order by successive calls
+	// to ListLayerVersions to simulate pages of results.
+	listLayerVersionsOutputSequence []*lambda.ListLayerVersionsOutput
+	listLayerVersionsError          error
+
 	// Event Invoke Config fields
 	putFunctionEventInvokeConfigOutput    *lambda.PutFunctionEventInvokeConfigOutput
 	putFunctionEventInvokeConfigError     error
@@ -139,6 +175,14 @@ type lambdaServiceMock struct {
 	removeLayerVersionPermissionOutput *lambda.RemoveLayerVersionPermissionOutput
 	removeLayerVersionPermissionError  error
 
+	// Function Permissions fields
+	addPermissionOutput    *lambda.AddPermissionOutput
+	addPermissionError     error
+	removePermissionOutput *lambda.RemovePermissionOutput
+	removePermissionError  error
+	getPolicyOutput        *lambda.GetPolicyOutput
+	getPolicyError         error
+
 	// Event Source Mapping mock methods
 	MockCreateEventSourceMapping func(ctx context.Context, input *lambda.CreateEventSourceMappingInput) (*lambda.CreateEventSourceMappingOutput, error)
 	MockGetEventSourceMapping    func(ctx context.Context, input *lambda.GetEventSourceMappingInput) (*lambda.GetEventSourceMappingOutput, error)
@@ -182,6 +226,84 @@ func WithGetFunctionOutput(output *lambda.GetFunctionOutput) lambdaServiceMockOp
 	}
 }
 
+// WithGetFunctionOutputSequence configures the mock to return each of the provided
+// outputs in order for successive calls to GetFunction, once the sequence
+// is exhausted, the output configured with WithGetFunctionOutput is returned.
+func WithGetFunctionOutputSequence(outputs ...*lambda.GetFunctionOutput) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getFunctionOutputSequence = outputs
+	}
+}
+
+// WithListFunctionsOutputSequence configures the mock to return each of the provided
+// outputs in order for successive calls to ListFunctions,
+// where each output represents a page of results.
+func WithListFunctionsOutputSequence(outputs ...*lambda.ListFunctionsOutput) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listFunctionsOutputSequence = outputs
+	}
+}
+
+func WithListFunctionsError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listFunctionsError = err
+	}
+}
+
+func WithListVersionsByFunctionOutputSequence(
+	outputs ...*lambda.ListVersionsByFunctionOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listVersionsByFunctionOutputSequence = outputs
+	}
+}
+
+func WithListVersionsByFunctionError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listVersionsByFunctionError = err
+	}
+}
+
+func WithListAliasesOutput(output *lambda.ListAliasesOutput) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listAliasesOutput = output
+	}
+}
+
+func WithListAliasesError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listAliasesError = err
+	}
+}
+
+func WithListProvisionedConcurrencyConfigsOutput(
+	output *lambda.ListProvisionedConcurrencyConfigsOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listProvisionedConcurrencyConfigsOutput = output
+	}
+}
+
+func WithListProvisionedConcurrencyConfigsError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listProvisionedConcurrencyConfigsError = err
+	}
+}
+
+func WithListEventSourceMappingsOutput(
+	output *lambda.ListEventSourceMappingsOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listEventSourceMappingsOutput = output
+	}
+}
+
+func WithListEventSourceMappingsError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listEventSourceMappingsError = err
+	}
+}
+
 func WithGetFunctionError(err error) lambdaServiceMockOption {
 	return func(m *lambdaServiceMock) {
 		m.getFunctionError = err
@@ -230,6 +352,20 @@ func WithGetFunctionConcurrencyError(err error) lambdaServiceMockOption {
 	}
 }
 
+func WithGetAccountSettingsOutput(
+	output *lambda.GetAccountSettingsOutput,
+) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getAccountSettingsOutput = output
+	}
+}
+
+func WithGetAccountSettingsError(err error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.getAccountSettingsError = err
+	}
+}
+
 func WithGetProvisionedConcurrencyOutput(
 	output *lambda.GetProvisionedConcurrencyConfigOutput,
 ) lambdaServiceMockOption {
@@ -256,6 +392,12 @@ func WithDeleteFunctionError(err error) lambdaServiceMockOption {
 	}
 }
 
+func WithDeleteFunctionErrorByQualifier(errors map[string]error) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.deleteFunctionErrorByQualifier = errors
+	}
+}
+
 func WithUpdateFunctionConfigurationOutput(
 	output *lambda.UpdateFunctionConfigurationOutput,
 ) lambdaServiceMockOption {
@@ -380,6 +522,14 @@ func WithListTagsOutput(output *lambda.ListTagsOutput) lambdaServiceMockOption {
 	}
 }
 
+// WithListTagsOutputByResource configures the mock to return the provided
+// outputs for ListTags calls for specific resource ARNs.
+func WithListTagsOutputByResource(outputs map[string]*lambda.ListTagsOutput) lambdaServiceMockOption {
+	return func(m *lambdaServiceMock) {
+		m.listTagsOutputByResource = outputs
+	}
+}
+
 func WithListTagsError(err error) lambdaServiceMockOption {
 	return func(m *lambdaServiceMock) {
 		m.listTagsError = err
@@ -611,9 +761,78 @@ func (m *lambdaServiceMock) GetFunction(
 	optFns ...func(*lambda.Options),
 ) (*lambda.GetFunctionOutput, error) {
 	m.RegisterCall(ctx, params)
+	if len(m.getFunctionOutputSequence) > 0 {
+		output := m.getFunctionOutputSequence[0]
+		m.getFunctionOutputSequence = m.getFunctionOutputSequence[1:]
+		return output, m.getFunctionError
+	}
 	return m.getFunctionOutput, m.getFunctionError
 }
 
+func (m *lambdaServiceMock) ListFunctions(
+	ctx context.Context,
+	params *lambda.ListFunctionsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListFunctionsOutput, error) {
+	m.RegisterCall(ctx, params)
+	if len(m.listFunctionsOutputSequence) > 0 {
+		output := m.listFunctionsOutputSequence[0]
+		m.listFunctionsOutputSequence = m.listFunctionsOutputSequence[1:]
+		return output, m.listFunctionsError
+	}
+	return &lambda.ListFunctionsOutput{}, m.listFunctionsError
+}
+
+func (m *lambdaServiceMock) ListVersionsByFunction(
+	ctx context.Context,
+	params *lambda.ListVersionsByFunctionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListVersionsByFunctionOutput, error) {
+	m.RegisterCall(ctx, params)
+	if len(m.listVersionsByFunctionOutputSequence) > 0 {
+		output := m.listVersionsByFunctionOutputSequence[0]
+		m.listVersionsByFunctionOutputSequence = m.listVersionsByFunctionOutputSequence[1:]
+		return output, m.listVersionsByFunctionError
+	}
+	return &lambda.ListVersionsByFunctionOutput{}, m.listVersionsByFunctionError
+}
+
+func (m *lambdaServiceMock) ListAliases(
+	ctx context.Context,
+	params *lambda.ListAliasesInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListAliasesOutput, error) {
+	m.RegisterCall(ctx, params)
+	if m.listAliasesOutput == nil {
+		return &lambda.ListAliasesOutput{}, m.listAliasesError
+	}
+	return m.listAliasesOutput, m.listAliasesError
+}
+
+func (m *lambdaServiceMock) ListEventSourceMappings(
+	ctx context.Context,
+	params *lambda.ListEventSourceMappingsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListEventSourceMappingsOutput, error) {
+	m.RegisterCall(ctx, params)
+	if m.listEventSourceMappingsOutput == nil {
+		return &lambda.ListEventSourceMappingsOutput{}, m.listEventSourceMappingsError
+	}
+	return m.listEventSourceMappingsOutput, m.listEventSourceMappingsError
+}
+
+func (m *lambdaServiceMock) ListProvisionedConcurrencyConfigs(
+	ctx context.Context,
+	params *lambda.ListProvisionedConcurrencyConfigsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListProvisionedConcurrencyConfigsOutput, error) {
+	m.RegisterCall(ctx, params)
+	if m.listProvisionedConcurrencyConfigsOutput == nil {
+		return &lambda.ListProvisionedConcurrencyConfigsOutput{}, m.listProvisionedConcurrencyConfigsError
+	}
+	return m.listProvisionedConcurrencyConfigsOutput, m.listProvisionedConcurrencyConfigsError
+}
+
 func (m *lambdaServiceMock) GetFunctionCodeSigningConfig(
 	ctx context.Context,
 	params *lambda.GetFunctionCodeSigningConfigInput,
@@ -641,6 +860,15 @@ func (m *lambdaServiceMock) GetFunctionConcurrency(
 	return m.getFunctionConcurrencyOutput, m.getFunctionConcurrencyError
 }
 
+func (m *lambdaServiceMock) GetAccountSettings(
+	ctx context.Context,
+	params *lambda.GetAccountSettingsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetAccountSettingsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getAccountSettingsOutput, m.getAccountSettingsError
+}
+
 func (m *lambdaServiceMock) GetProvisionedConcurrencyConfig(
 	ctx context.Context,
 	params *lambda.GetProvisionedConcurrencyConfigInput,
@@ -661,6 +889,9 @@ func (m *lambdaServiceMock) DeleteFunction(
 	optFns ...func(*lambda.Options),
 ) (*lambda.DeleteFunctionOutput, error) {
 	m.RegisterCall(ctx, params)
+	if err, hasErr := m.deleteFunctionErrorByQualifier[aws.ToString(params.Qualifier)]; hasErr {
+		return nil, err
+	}
 	return m.deleteFunctionOutput, m.deleteFunctionError
 }
 
@@ -854,6 +1085,9 @@ func (m *lambdaServiceMock) ListTags(
 	optFns ...func(*lambda.Options),
 ) (*lambda.ListTagsOutput, error) {
 	m.RegisterCall(ctx, params)
+	if output, ok := m.listTagsOutputByResource[aws.ToString(params.Resource)]; ok {
+		return output, m.listTagsError
+	}
 	return m.listTagsOutput, m.listTagsError
 }
 
@@ -902,6 +1136,20 @@ func (m *lambdaServiceMock) GetLayerVersion(
 	return m.getLayerVersionOutput, m.getLayerVersionError
 }
 
+func (m *lambdaServiceMock) ListLayerVersions(
+	ctx context.Context,
+	params *lambda.ListLayerVersionsInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.ListLayerVersionsOutput, error) {
+	m.RegisterCall(ctx, params)
+	if len(m.listLayerVersionsOutputSequence) > 0 {
+		output := m.listLayerVersionsOutputSequence[0]
+		m.listLayerVersionsOutputSequence = m.listLayerVersionsOutputSequence[1:]
+		return output, m.listLayerVersionsError
+	}
+	return &lambda.ListLayerVersionsOutput{}, m.listLayerVersionsError
+}
+
 func (m *lambdaServiceMock) DeleteLayerVersion(
 	ctx context.Context,
 	params *lambda.DeleteLayerVersionInput,
@@ -987,6 +1235,20 @@ func WithGetLayerVersionError(err error) func(*lambdaServiceMock) {
 	}
 }
 
+func WithListLayerVersionsOutputSequence(
+	outputs ...*lambda.ListLayerVersionsOutput,
+) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.listLayerVersionsOutputSequence = outputs
+	}
+}
+
+func WithListLayerVersionsError(err error) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.listLayerVersionsError = err
+	}
+}
+
 func WithDeleteLayerVersionOutput(output *lambda.DeleteLayerVersionOutput) func(*lambdaServiceMock) {
 	return func(m *lambdaServiceMock) {
 		m.deleteLayerVersionOutput = output
@@ -1153,3 +1415,70 @@ func WithRemoveLayerVersionPermissionError(err error) func(*lambdaServiceMock) {
 		m.removeLayerVersionPermissionError = err
 	}
 }
+
+// Function Permissions mock methods
+
+func (m *lambdaServiceMock) AddPermission(
+	ctx context.Context,
+	params *lambda.AddPermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.AddPermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.addPermissionOutput, m.addPermissionError
+}
+
+func (m *lambdaServiceMock) RemovePermission(
+	ctx context.Context,
+	params *lambda.RemovePermissionInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.RemovePermissionOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.removePermissionOutput, m.removePermissionError
+}
+
+func (m *lambdaServiceMock) GetPolicy(
+	ctx context.Context,
+	params *lambda.GetPolicyInput,
+	optFns ...func(*lambda.Options),
+) (*lambda.GetPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getPolicyOutput, m.getPolicyError
+}
+
+// Function Permissions mock helpers
+
+func WithAddPermissionOutput(output *lambda.AddPermissionOutput) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.addPermissionOutput = output
+	}
+}
+
+func WithAddPermissionError(err error) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.addPermissionError = err
+	}
+}
+
+func WithRemovePermissionOutput(output *lambda.RemovePermissionOutput) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.removePermissionOutput = output
+	}
+}
+
+func WithRemovePermissionError(err error) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.removePermissionError = err
+	}
+}
+
+func WithGetPolicyOutput(output *lambda.GetPolicyOutput) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.getPolicyOutput = output
+	}
+}
+
+func WithGetPolicyError(err error) func(*lambdaServiceMock) {
+	return func(m *lambdaServiceMock) {
+		m.getPolicyError = err
+	}
+}
@@ -0,0 +1,353 @@
+package logsmock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type logsServiceMock struct {
+	plugintestutils.MockCalls
+	createLogGroupOutput        *cloudwatchlogs.CreateLogGroupOutput
+	createLogGroupError         error
+	deleteLogGroupOutput        *cloudwatchlogs.DeleteLogGroupOutput
+	deleteLogGroupError         error
+	describeLogGroupsOutput     *cloudwatchlogs.DescribeLogGroupsOutput
+	describeLogGroupsError      error
+	putRetentionPolicyOutput    *cloudwatchlogs.PutRetentionPolicyOutput
+	putRetentionPolicyError     error
+	deleteRetentionPolicyOutput *cloudwatchlogs.DeleteRetentionPolicyOutput
+	deleteRetentionPolicyError  error
+	associateKmsKeyOutput       *cloudwatchlogs.AssociateKmsKeyOutput
+	associateKmsKeyError        error
+	disassociateKmsKeyOutput    *cloudwatchlogs.DisassociateKmsKeyOutput
+	disassociateKmsKeyError     error
+	tagResourceOutput           *cloudwatchlogs.TagResourceOutput
+	tagResourceError            error
+	untagResourceOutput         *cloudwatchlogs.UntagResourceOutput
+	untagResourceError          error
+	listTagsForResourceOutput   *cloudwatchlogs.ListTagsForResourceOutput
+	listTagsForResourceError    error
+	putMetricFilterOutput       *cloudwatchlogs.PutMetricFilterOutput
+	putMetricFilterError        error
+	deleteMetricFilterOutput    *cloudwatchlogs.DeleteMetricFilterOutput
+	deleteMetricFilterError     error
+	describeMetricFiltersOutput *cloudwatchlogs.DescribeMetricFiltersOutput
+	describeMetricFiltersError  error
+}
+
+type logsServiceMockOption func(*logsServiceMock)
+
+func CreateLogsServiceMockFactory(
+	opts ...logsServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+	mock := CreateLogsServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+		return mock
+	}
+}
+
+func CreateLogsServiceMock(
+	opts ...logsServiceMockOption,
+) *logsServiceMock {
+	mock := &logsServiceMock{
+		createLogGroupOutput:        &cloudwatchlogs.CreateLogGroupOutput{},
+		deleteLogGroupOutput:        &cloudwatchlogs.DeleteLogGroupOutput{},
+		putRetentionPolicyOutput:    &cloudwatchlogs.PutRetentionPolicyOutput{},
+		deleteRetentionPolicyOutput: &cloudwatchlogs.DeleteRetentionPolicyOutput{},
+		associateKmsKeyOutput:       &cloudwatchlogs.AssociateKmsKeyOutput{},
+		disassociateKmsKeyOutput:    &cloudwatchlogs.DisassociateKmsKeyOutput{},
+		tagResourceOutput:           &cloudwatchlogs.TagResourceOutput{},
+		untagResourceOutput:         &cloudwatchlogs.UntagResourceOutput{},
+		listTagsForResourceOutput:   &cloudwatchlogs.ListTagsForResourceOutput{},
+		putMetricFilterOutput:       &cloudwatchlogs.PutMetricFilterOutput{},
+		deleteMetricFilterOutput:    &cloudwatchlogs.DeleteMetricFilterOutput{},
+	}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithCreateLogGroupOutput(output *cloudwatchlogs.CreateLogGroupOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.createLogGroupOutput = output
+	}
+}
+
+func WithCreateLogGroupError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.createLogGroupError = err
+	}
+}
+
+func WithDeleteLogGroupOutput(output *cloudwatchlogs.DeleteLogGroupOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteLogGroupOutput = output
+	}
+}
+
+func WithDeleteLogGroupError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteLogGroupError = err
+	}
+}
+
+func WithDescribeLogGroupsOutput(output *cloudwatchlogs.DescribeLogGroupsOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.describeLogGroupsOutput = output
+	}
+}
+
+func WithDescribeLogGroupsError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.describeLogGroupsError = err
+	}
+}
+
+func WithPutRetentionPolicyOutput(output *cloudwatchlogs.PutRetentionPolicyOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.putRetentionPolicyOutput = output
+	}
+}
+
+func WithPutRetentionPolicyError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.putRetentionPolicyError = err
+	}
+}
+
+func WithDeleteRetentionPolicyOutput(output *cloudwatchlogs.DeleteRetentionPolicyOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteRetentionPolicyOutput = output
+	}
+}
+
+func WithDeleteRetentionPolicyError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteRetentionPolicyError = err
+	}
+}
+
+func WithAssociateKmsKeyOutput(output *cloudwatchlogs.AssociateKmsKeyOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.associateKmsKeyOutput = output
+	}
+}
+
+func WithAssociateKmsKeyError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.associateKmsKeyError = err
+	}
+}
+
+func WithDisassociateKmsKeyOutput(output *cloudwatchlogs.DisassociateKmsKeyOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.disassociateKmsKeyOutput = output
+	}
+}
+
+func WithDisassociateKmsKeyError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.disassociateKmsKeyError = err
+	}
+}
+
+func WithTagResourceOutput(output *cloudwatchlogs.TagResourceOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.tagResourceOutput = output
+	}
+}
+
+func WithTagResourceError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.tagResourceError = err
+	}
+}
+
+func WithUntagResourceOutput(output *cloudwatchlogs.UntagResourceOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.untagResourceOutput = output
+	}
+}
+
+func WithUntagResourceError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.untagResourceError = err
+	}
+}
+
+func WithListTagsForResourceOutput(output *cloudwatchlogs.ListTagsForResourceOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.listTagsForResourceOutput = output
+	}
+}
+
+func WithListTagsForResourceError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.listTagsForResourceError = err
+	}
+}
+
+func WithPutMetricFilterOutput(output *cloudwatchlogs.PutMetricFilterOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.putMetricFilterOutput = output
+	}
+}
+
+func WithPutMetricFilterError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.putMetricFilterError = err
+	}
+}
+
+func WithDeleteMetricFilterOutput(output *cloudwatchlogs.DeleteMetricFilterOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteMetricFilterOutput = output
+	}
+}
+
+func WithDeleteMetricFilterError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.deleteMetricFilterError = err
+	}
+}
+
+func WithDescribeMetricFiltersOutput(output *cloudwatchlogs.DescribeMetricFiltersOutput) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.describeMetricFiltersOutput = output
+	}
+}
+
+func WithDescribeMetricFiltersError(err error) logsServiceMockOption {
+	return func(m *logsServiceMock) {
+		m.describeMetricFiltersError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *logsServiceMock) CreateLogGroup(
+	ctx context.Context,
+	params *cloudwatchlogs.CreateLogGroupInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.createLogGroupOutput, m.createLogGroupError
+}
+
+func (m *logsServiceMock) DeleteLogGroup(
+	ctx context.Context,
+	params *cloudwatchlogs.DeleteLogGroupInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteLogGroupOutput, m.deleteLogGroupError
+}
+
+func (m *logsServiceMock) DescribeLogGroups(
+	ctx context.Context,
+	params *cloudwatchlogs.DescribeLogGroupsInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeLogGroupsOutput, m.describeLogGroupsError
+}
+
+func (m *logsServiceMock) PutRetentionPolicy(
+	ctx context.Context,
+	params *cloudwatchlogs.PutRetentionPolicyInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putRetentionPolicyOutput, m.putRetentionPolicyError
+}
+
+func (m *logsServiceMock) DeleteRetentionPolicy(
+	ctx context.Context,
+	params *cloudwatchlogs.DeleteRetentionPolicyInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteRetentionPolicyOutput, m.deleteRetentionPolicyError
+}
+
+func (m *logsServiceMock) AssociateKmsKey(
+	ctx context.Context,
+	params *cloudwatchlogs.AssociateKmsKeyInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.AssociateKmsKeyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.associateKmsKeyOutput, m.associateKmsKeyError
+}
+
+func (m *logsServiceMock) DisassociateKmsKey(
+	ctx context.Context,
+	params *cloudwatchlogs.DisassociateKmsKeyInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DisassociateKmsKeyOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.disassociateKmsKeyOutput, m.disassociateKmsKeyError
+}
+
+func (m *logsServiceMock) TagResource(
+	ctx context.Context,
+	params *cloudwatchlogs.TagResourceInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.TagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.tagResourceOutput, m.tagResourceError
+}
+
+func (m *logsServiceMock) UntagResource(
+	ctx context.Context,
+	params *cloudwatchlogs.UntagResourceInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.UntagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.untagResourceOutput, m.untagResourceError
+}
+
+func (m *logsServiceMock) ListTagsForResource(
+	ctx context.Context,
+	params *cloudwatchlogs.ListTagsForResourceInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.listTagsForResourceOutput, m.listTagsForResourceError
+}
+
+func (m *logsServiceMock) PutMetricFilter(
+	ctx context.Context,
+	params *cloudwatchlogs.PutMetricFilterInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.PutMetricFilterOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putMetricFilterOutput, m.putMetricFilterError
+}
+
+func (m *logsServiceMock) DeleteMetricFilter(
+	ctx context.Context,
+	params *cloudwatchlogs.DeleteMetricFilterInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DeleteMetricFilterOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.deleteMetricFilterOutput, m.deleteMetricFilterError
+}
+
+func (m *logsServiceMock) DescribeMetricFilters(
+	ctx context.Context,
+	params *cloudwatchlogs.DescribeMetricFiltersInput,
+	optFns ...func(*cloudwatchlogs.Options),
+) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.describeMetricFiltersOutput, m.describeMetricFiltersError
+}
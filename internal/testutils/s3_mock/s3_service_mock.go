@@ -0,0 +1,66 @@
+package s3mock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type s3ServiceMock struct {
+	plugintestutils.MockCalls
+
+	putObjectOutput *s3.PutObjectOutput
+	putObjectError  error
+}
+
+type s3ServiceMockOption func(*s3ServiceMock)
+
+func CreateS3ServiceMockFactory(
+	opts ...s3ServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) s3service.Service {
+	mock := CreateS3ServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) s3service.Service {
+		return mock
+	}
+}
+
+func CreateS3ServiceMock(
+	opts ...s3ServiceMockOption,
+) *s3ServiceMock {
+	mock := &s3ServiceMock{}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithPutObjectOutput(output *s3.PutObjectOutput) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.putObjectOutput = output
+	}
+}
+
+func WithPutObjectError(err error) s3ServiceMockOption {
+	return func(m *s3ServiceMock) {
+		m.putObjectError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *s3ServiceMock) PutObject(
+	ctx context.Context,
+	params *s3.PutObjectInput,
+	optFns ...func(*s3.Options),
+) (*s3.PutObjectOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putObjectOutput, m.putObjectError
+}
@@ -0,0 +1,212 @@
+package signermock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+)
+
+type signerServiceMock struct {
+	plugintestutils.MockCalls
+
+	putSigningProfileOutput    *signer.PutSigningProfileOutput
+	putSigningProfileError     error
+	getSigningProfileOutput    *signer.GetSigningProfileOutput
+	getSigningProfileError     error
+	cancelSigningProfileOutput *signer.CancelSigningProfileOutput
+	cancelSigningProfileError  error
+	tagResourceOutput          *signer.TagResourceOutput
+	tagResourceError           error
+	untagResourceOutput        *signer.UntagResourceOutput
+	untagResourceError         error
+	startSigningJobOutput      *signer.StartSigningJobOutput
+	startSigningJobError       error
+
+	// Signing jobs are polled until they complete, each call
+	// to DescribeSigningJob returns the next output in the sequence
+	// and the last output is returned once the sequence is exhausted.
+	describeSigningJobOutputSequence []*signer.DescribeSigningJobOutput
+	describeSigningJobError          error
+}
+
+type signerServiceMockOption func(*signerServiceMock)
+
+func CreateSignerServiceMockFactory(
+	opts ...signerServiceMockOption,
+) func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+	mock := CreateSignerServiceMock(opts...)
+	return func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+		return mock
+	}
+}
+
+func CreateSignerServiceMock(
+	opts ...signerServiceMockOption,
+) *signerServiceMock {
+	mock := &signerServiceMock{
+		cancelSigningProfileOutput: &signer.CancelSigningProfileOutput{},
+		tagResourceOutput:          &signer.TagResourceOutput{},
+		untagResourceOutput:        &signer.UntagResourceOutput{},
+	}
+
+	for _, opt := range opts {
+		opt(mock)
+	}
+
+	return mock
+}
+
+// Mock configuration options.
+
+func WithPutSigningProfileOutput(output *signer.PutSigningProfileOutput) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.putSigningProfileOutput = output
+	}
+}
+
+func WithPutSigningProfileError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.putSigningProfileError = err
+	}
+}
+
+func WithGetSigningProfileOutput(output *signer.GetSigningProfileOutput) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.getSigningProfileOutput = output
+	}
+}
+
+func WithGetSigningProfileError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.getSigningProfileError = err
+	}
+}
+
+func WithCancelSigningProfileError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.cancelSigningProfileError = err
+	}
+}
+
+func WithTagResourceError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.tagResourceError = err
+	}
+}
+
+func WithUntagResourceError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.untagResourceError = err
+	}
+}
+
+func WithStartSigningJobOutput(output *signer.StartSigningJobOutput) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.startSigningJobOutput = output
+	}
+}
+
+func WithStartSigningJobError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.startSigningJobError = err
+	}
+}
+
+func WithDescribeSigningJobOutput(output *signer.DescribeSigningJobOutput) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.describeSigningJobOutputSequence = []*signer.DescribeSigningJobOutput{output}
+	}
+}
+
+func WithDescribeSigningJobOutputSequence(outputs ...*signer.DescribeSigningJobOutput) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.describeSigningJobOutputSequence = outputs
+	}
+}
+
+func WithDescribeSigningJobError(err error) signerServiceMockOption {
+	return func(m *signerServiceMock) {
+		m.describeSigningJobError = err
+	}
+}
+
+// Service interface implementation.
+
+func (m *signerServiceMock) PutSigningProfile(
+	ctx context.Context,
+	params *signer.PutSigningProfileInput,
+	optFns ...func(*signer.Options),
+) (*signer.PutSigningProfileOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.putSigningProfileOutput, m.putSigningProfileError
+}
+
+func (m *signerServiceMock) GetSigningProfile(
+	ctx context.Context,
+	params *signer.GetSigningProfileInput,
+	optFns ...func(*signer.Options),
+) (*signer.GetSigningProfileOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.getSigningProfileOutput, m.getSigningProfileError
+}
+
+func (m *signerServiceMock) CancelSigningProfile(
+	ctx context.Context,
+	params *signer.CancelSigningProfileInput,
+	optFns ...func(*signer.Options),
+) (*signer.CancelSigningProfileOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.cancelSigningProfileOutput, m.cancelSigningProfileError
+}
+
+func (m *signerServiceMock) TagResource(
+	ctx context.Context,
+	params *signer.TagResourceInput,
+	optFns ...func(*signer.Options),
+) (*signer.TagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.tagResourceOutput, m.tagResourceError
+}
+
+func (m *signerServiceMock) UntagResource(
+	ctx context.Context,
+	params *signer.UntagResourceInput,
+	optFns ...func(*signer.Options),
+) (*signer.UntagResourceOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.untagResourceOutput, m.untagResourceError
+}
+
+func (m *signerServiceMock) StartSigningJob(
+	ctx context.Context,
+	params *signer.StartSigningJobInput,
+	optFns ...func(*signer.Options),
+) (*signer.StartSigningJobOutput, error) {
+	m.RegisterCall(ctx, params)
+	return m.startSigningJobOutput, m.startSigningJobError
+}
+
+func (m *signerServiceMock) DescribeSigningJob(
+	ctx context.Context,
+	params *signer.DescribeSigningJobInput,
+	optFns ...func(*signer.Options),
+) (*signer.DescribeSigningJobOutput, error) {
+	m.RegisterCall(ctx, params)
+	if m.describeSigningJobError != nil {
+		return nil, m.describeSigningJobError
+	}
+
+	if len(m.describeSigningJobOutputSequence) == 0 {
+		return nil, nil
+	}
+
+	output := m.describeSigningJobOutputSequence[0]
+	if len(m.describeSigningJobOutputSequence) > 1 {
+		m.describeSigningJobOutputSequence = m.describeSigningJobOutputSequence[1:]
+	}
+	return output, nil
+}
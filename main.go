@@ -8,8 +8,14 @@ import (
 	"os"
 
 	"github.com/newstack-cloud/bluelink-provider-aws/provider"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/plugin"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/pluginservicev1"
@@ -32,6 +38,12 @@ func main() {
 		provider.NewProvider(
 			iamservice.NewService,
 			lambdaservice.NewService,
+			s3service.NewService,
+			cloudwatchservice.NewService,
+			applicationautoscalingservice.NewService,
+			signerservice.NewService,
+			ecrservice.NewService,
+			logsservice.NewService,
 			utils.NewAWSConfigStore(
 				os.Environ(),
 				utils.AWSConfigFromProviderContext,
@@ -4,11 +4,20 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	interservicelinks "github.com/newstack-cloud/bluelink-provider-aws/inter-service-links"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/services/iam"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/services/lambda"
 	lambdalinks "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/links"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/services/logs"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/services/signer"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -20,6 +29,12 @@ import (
 func NewProvider(
 	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3service.Service],
+	cloudwatchServiceFactory pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service],
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service],
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service],
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecrservice.Service],
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
 	awsConfigStore *utils.AWSConfigStore,
 ) provider.Provider {
 	return &providerv1.ProviderPluginDefinition{
@@ -64,6 +79,9 @@ func NewProvider(
 			),
 			"aws/lambda/function": lambda.FunctionResource(
 				lambdaServiceFactory,
+				s3ServiceFactory,
+				ecrServiceFactory,
+				logsServiceFactory,
 				awsConfigStore,
 			),
 			"aws/lambda/functionVersion": lambda.FunctionVersionResource(
@@ -76,6 +94,8 @@ func NewProvider(
 			),
 			"aws/lambda/alias": lambda.AliasResource(
 				lambdaServiceFactory,
+				cloudwatchServiceFactory,
+				autoScalingServiceFactory,
 				awsConfigStore,
 			),
 			"aws/lambda/codeSigningConfig": lambda.CodeSigningConfigResource(
@@ -92,18 +112,63 @@ func NewProvider(
 			),
 			"aws/lambda/layerVersion": lambda.LayerVersionResource(
 				lambdaServiceFactory,
+				s3ServiceFactory,
 				awsConfigStore,
 			),
 			"aws/lambda/layerVersionPermission": lambda.LayerVersionPermissionResource(
 				lambdaServiceFactory,
 				awsConfigStore,
 			),
+			"aws/lambda/permission": lambda.PermissionResource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
+			"aws/logs/logGroup": logs.LogGroupResource(
+				logsServiceFactory,
+				awsConfigStore,
+			),
+			"aws/logs/metricFilter": logs.MetricFilterResource(
+				logsServiceFactory,
+				awsConfigStore,
+			),
+			"aws/signer/signingProfile": signer.SigningProfileResource(
+				signerServiceFactory,
+				awsConfigStore,
+			),
+			"aws/signer/signingJob": signer.SigningJobResource(
+				signerServiceFactory,
+				awsConfigStore,
+			),
 		},
 		DataSources: map[string]provider.DataSource{
+			"aws/iam/role": iam.RoleDataSource(
+				iamServiceFactory,
+				awsConfigStore,
+			),
+			"aws/iam/user": iam.UserDataSource(
+				iamServiceFactory,
+				awsConfigStore,
+			),
+			"aws/iam/group": iam.GroupDataSource(
+				iamServiceFactory,
+				awsConfigStore,
+			),
+			"aws/iam/managedPolicy": iam.ManagedPolicyDataSource(
+				iamServiceFactory,
+				awsConfigStore,
+			),
+			"aws/iam/instanceProfile": iam.InstanceProfileDataSource(
+				iamServiceFactory,
+				awsConfigStore,
+			),
 			"aws/lambda/function": lambda.FunctionDataSource(
 				lambdaServiceFactory,
 				awsConfigStore,
 			),
+			"aws/lambda/functions": lambda.FunctionsDataSource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
 			"aws/lambda/functionUrl": lambda.FunctionUrlDataSource(
 				lambdaServiceFactory,
 				awsConfigStore,
@@ -120,6 +185,10 @@ func NewProvider(
 				lambdaServiceFactory,
 				awsConfigStore,
 			),
+			"aws/lambda/accountSettings": lambda.AccountSettingsDataSource(
+				lambdaServiceFactory,
+				awsConfigStore,
+			),
 		},
 		Links: map[string]provider.Link{
 			"aws/lambda/function::aws/lambda/codeSigningConfig": lambdalinks.FunctionCodeSigningConfigLink(
@@ -128,9 +197,51 @@ func NewProvider(
 					awsConfigStore,
 				),
 			),
+			"aws/lambda/function::aws/lambda/function": lambdalinks.FunctionFunctionLink(
+				pluginutils.NewSingleLinkServiceDeps(
+					lambdaServiceFactory,
+					awsConfigStore,
+				),
+				pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+					ServiceFactory: iamServiceFactory,
+					ConfigStore:    awsConfigStore,
+				},
+			),
+			"aws/lambda/function::aws/sqs/queue": interservicelinks.LambdaFunctionSQSQueueLink(
+				pluginutils.NewSingleLinkServiceDeps(
+					lambdaServiceFactory,
+					awsConfigStore,
+				),
+				pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+					ServiceFactory: iamServiceFactory,
+					ConfigStore:    awsConfigStore,
+				},
+			),
+			"aws/lambda/function::aws/sns/topic": interservicelinks.LambdaFunctionSNSTopicLink(
+				pluginutils.NewSingleLinkServiceDeps(
+					lambdaServiceFactory,
+					awsConfigStore,
+				),
+				pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+					ServiceFactory: iamServiceFactory,
+					ConfigStore:    awsConfigStore,
+				},
+			),
+			"aws/lambda/function::aws/events/eventBus": interservicelinks.LambdaFunctionEventBusLink(
+				pluginutils.NewSingleLinkServiceDeps(
+					lambdaServiceFactory,
+					awsConfigStore,
+				),
+				pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+					ServiceFactory: iamServiceFactory,
+					ConfigStore:    awsConfigStore,
+				},
+			),
 		},
 		CustomVariableTypes: map[string]provider.CustomVariableType{},
-		Functions:           map[string]provider.Function{},
+		Functions: map[string]provider.Function{
+			"lambda_filter_pattern_matches": lambda.FilterPatternMatchesFunction(),
+		},
 	}
 }
 
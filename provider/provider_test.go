@@ -4,8 +4,14 @@ import (
 	"context"
 	"testing"
 
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/stretchr/testify/suite"
@@ -59,7 +65,17 @@ func (s *ProviderSuite) Test_loads_provider_and_applies_duration_validation() {
 		&utils.DefaultAWSConfigLoader{},
 		utils.AWSConfigCacheKey,
 	)
-	provider := NewProvider(iamservice.NewService, lambdaservice.NewService, configStore)
+	provider := NewProvider(
+		iamservice.NewService,
+		lambdaservice.NewService,
+		s3service.NewService,
+		cloudwatchservice.NewService,
+		applicationautoscalingservice.NewService,
+		signerservice.NewService,
+		ecrservice.NewService,
+		logsservice.NewService,
+		configStore,
+	)
 	configDef, err := provider.ConfigDefinition(context.Background())
 	s.Require().NoError(err, "should get config definition without error")
 
@@ -138,7 +154,17 @@ func (s *ProviderSuite) Test_loads_provider_and_applies_role_arn_validation() {
 		&utils.DefaultAWSConfigLoader{},
 		utils.AWSConfigCacheKey,
 	)
-	provider := NewProvider(iamservice.NewService, lambdaservice.NewService, configStore)
+	provider := NewProvider(
+		iamservice.NewService,
+		lambdaservice.NewService,
+		s3service.NewService,
+		cloudwatchservice.NewService,
+		applicationautoscalingservice.NewService,
+		signerservice.NewService,
+		ecrservice.NewService,
+		logsservice.NewService,
+		configStore,
+	)
 	configDef, err := provider.ConfigDefinition(context.Background())
 	s.Require().NoError(err, "should get config definition without error")
 
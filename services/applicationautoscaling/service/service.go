@@ -0,0 +1,179 @@
+package applicationautoscalingservice
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the
+// Application Auto Scaling service used by resource implementations that
+// need to scale the capacity of a resource, such as Lambda aliases that scale
+// provisioned concurrency based on utilisation or a schedule.
+type Service interface {
+	// Registers or updates a scalable target, which is the resource that you want to
+	// scale.
+	//
+	// Scalable targets are uniquely identified by the combination of resource ID,
+	// scalable dimension, and namespace, which represents some capacity dimension of
+	// the underlying service.
+	//
+	// When you register a new scalable target, you must specify values for the
+	// minimum and maximum capacity. If the specified resource is not active in the
+	// target service, this operation does not change the resource's current capacity.
+	// Otherwise, it changes the resource's current capacity to a value that is inside
+	// of this range.
+	//
+	// To update a scalable target, specify the parameters that you want to change.
+	// Include the parameters that identify the scalable target: resource ID, scalable
+	// dimension, and namespace. Any parameters that you don't specify are not changed
+	// by this update request.
+	RegisterScalableTarget(
+		ctx context.Context,
+		params *applicationautoscaling.RegisterScalableTargetInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+
+	// Deregisters an Application Auto Scaling scalable target when you have finished
+	// using it. To see which resources have been registered, use [DescribeScalableTargets].
+	//
+	// Deregistering a scalable target deletes the scaling policies and the scheduled
+	// actions that are associated with it.
+	//
+	// [DescribeScalableTargets]: https://docs.aws.amazon.com/autoscaling/application/APIReference/API_DescribeScalableTargets.html
+	DeregisterScalableTarget(
+		ctx context.Context,
+		params *applicationautoscaling.DeregisterScalableTargetInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DeregisterScalableTargetOutput, error)
+
+	// Gets information about the scalable targets in the specified namespace.
+	//
+	// You can filter the results using ResourceIds and ScalableDimension .
+	DescribeScalableTargets(
+		ctx context.Context,
+		params *applicationautoscaling.DescribeScalableTargetsInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+
+	// Creates or updates a scaling policy for an Application Auto Scaling scalable
+	// target.
+	//
+	// Each scalable target is identified by a service namespace, resource ID, and
+	// scalable dimension. A scaling policy applies to the scalable target identified
+	// by those three attributes. You cannot create a scaling policy until you have
+	// registered the resource as a scalable target.
+	//
+	// If a scalable target is deregistered, the scalable target is no longer
+	// available to use scaling policies. Any scaling policies that were specified for
+	// the scalable target are deleted.
+	PutScalingPolicy(
+		ctx context.Context,
+		params *applicationautoscaling.PutScalingPolicyInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.PutScalingPolicyOutput, error)
+
+	// Deletes the specified scaling policy for an Application Auto Scaling scalable
+	// target.
+	//
+	// Deleting a step scaling policy deletes the underlying alarm action, but does
+	// not delete the CloudWatch alarm associated with the scaling policy, even if it
+	// no longer has an associated action.
+	DeleteScalingPolicy(
+		ctx context.Context,
+		params *applicationautoscaling.DeleteScalingPolicyInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DeleteScalingPolicyOutput, error)
+
+	// Describes the Application Auto Scaling scaling policies for the specified
+	// service namespace.
+	//
+	// You can filter the results using ResourceId , ScalableDimension , and
+	// PolicyNames .
+	DescribeScalingPolicies(
+		ctx context.Context,
+		params *applicationautoscaling.DescribeScalingPoliciesInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+
+	// Creates or updates a scheduled action for an Application Auto Scaling scalable
+	// target.
+	//
+	// Each scalable target is identified by a service namespace, resource ID, and
+	// scalable dimension. A scheduled action applies to the scalable target identified
+	// by those three attributes. You cannot create a scheduled action until you have
+	// registered the resource as a scalable target.
+	//
+	// To update a scheduled action, specify the parameters that you want to change.
+	// If you don't specify start and end times, the old values are deleted.
+	PutScheduledAction(
+		ctx context.Context,
+		params *applicationautoscaling.PutScheduledActionInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.PutScheduledActionOutput, error)
+
+	// Deletes the specified scheduled action for an Application Auto Scaling scalable
+	// target.
+	DeleteScheduledAction(
+		ctx context.Context,
+		params *applicationautoscaling.DeleteScheduledActionInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DeleteScheduledActionOutput, error)
+
+	// Describes the Application Auto Scaling scheduled actions for the specified
+	// service namespace.
+	//
+	// You can filter the results using the ResourceId , ScalableDimension , and
+	// ScheduledActionNames parameters.
+	DescribeScheduledActions(
+		ctx context.Context,
+		params *applicationautoscaling.DescribeScheduledActionsInput,
+		optFns ...func(*applicationautoscaling.Options),
+	) (*applicationautoscaling.DescribeScheduledActionsOutput, error)
+}
+
+// NewService creates a new instance of the Application Auto Scaling service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return applicationautoscaling.NewFromConfig(
+		*awsConfig,
+		applicationautoscaling.WithEndpointResolverV2(
+			&applicationAutoScalingEndpointResolverV2{
+				providerContext,
+			},
+		),
+	)
+}
+
+type applicationAutoScalingEndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (a *applicationAutoScalingEndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params applicationautoscaling.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	applicationAutoScalingAliases := utils.Services["applicationautoscaling"]
+	applicationAutoScalingEndpoint, hasApplicationAutoScalingEndpoint := utils.GetEndpointFromProviderConfig(
+		a.providerContext,
+		"applicationautoscaling",
+		applicationAutoScalingAliases,
+	)
+	if hasApplicationAutoScalingEndpoint && !core.IsScalarNil(applicationAutoScalingEndpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(applicationAutoScalingEndpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return applicationautoscaling.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
@@ -0,0 +1,71 @@
+package cloudwatchservice
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the Amazon CloudWatch service
+// used by resource implementations that need to check the state of alarms,
+// such as Lambda aliases that roll back progressive deployments when an alarm fires.
+type Service interface {
+	// Retrieves the specified alarms. You can filter the results by specifying a
+	// prefix for the alarm name, the alarm state, or a prefix for any action.
+	//
+	// To use this operation and return information about composite alarms, you must
+	// be signed on with the cloudwatch:DescribeAlarms permission that is scoped to * .
+	// You can't return information about composite alarms if your
+	// cloudwatch:DescribeAlarms permission has a narrower scope.
+	DescribeAlarms(
+		ctx context.Context,
+		params *cloudwatch.DescribeAlarmsInput,
+		optFns ...func(*cloudwatch.Options),
+	) (*cloudwatch.DescribeAlarmsOutput, error)
+}
+
+// NewService creates a new instance of the Amazon CloudWatch service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return cloudwatch.NewFromConfig(
+		*awsConfig,
+		cloudwatch.WithEndpointResolverV2(
+			&cloudwatchEndpointResolverV2{
+				providerContext,
+			},
+		),
+	)
+}
+
+type cloudwatchEndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (c *cloudwatchEndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params cloudwatch.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	cloudwatchAliases := utils.Services["cloudwatch"]
+	cloudwatchEndpoint, hasCloudwatchEndpoint := utils.GetEndpointFromProviderConfig(
+		c.providerContext,
+		"cloudwatch",
+		cloudwatchAliases,
+	)
+	if hasCloudwatchEndpoint && !core.IsScalarNil(cloudwatchEndpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(cloudwatchEndpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return cloudwatch.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
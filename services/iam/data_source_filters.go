@@ -0,0 +1,306 @@
+package iam
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// entityFilters holds the filters that are shared by the IAM data sources.
+// An IAM entity is looked up by name or ARN, the path and tag filters
+// must then match the entity that was found.
+type entityFilters struct {
+	name        string
+	arn         string
+	pathFilters []*provider.ResolvedDataSourceFilter
+	tagFilters  []*tagFilter
+}
+
+// entityFilterFields creates the filter fields shared by the IAM data sources
+// for an entity type such as "role", tag filters are only included for
+// entity types that can be tagged.
+func entityFilterFields(entityLabel string, taggable bool) map[string]*provider.DataSourceFilterSchema {
+	filterFields := map[string]*provider.DataSourceFilterSchema{
+		"name": {
+			Type:        provider.DataSourceFilterSearchValueTypeString,
+			Description: fmt.Sprintf("The name of the IAM %s to retrieve.", entityLabel),
+			SupportedOperators: []schema.DataSourceFilterOperator{
+				schema.DataSourceFilterOperatorEquals,
+			},
+			ConflictsWith: []string{"arn"},
+		},
+		"arn": {
+			Type:        provider.DataSourceFilterSearchValueTypeString,
+			Description: fmt.Sprintf("The ARN of the IAM %s to retrieve.", entityLabel),
+			SupportedOperators: []schema.DataSourceFilterOperator{
+				schema.DataSourceFilterOperatorEquals,
+			},
+			ConflictsWith: []string{"name"},
+		},
+		"path": {
+			Type: provider.DataSourceFilterSearchValueTypeString,
+			Description: fmt.Sprintf(
+				"The path or path prefix that the IAM %s must have (e.g. /service-roles/).",
+				entityLabel,
+			),
+			FormattedDescription: fmt.Sprintf(
+				"The [path](https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html#identifiers-friendly-names) "+
+					"or path prefix that the IAM %s must have (e.g. `/service-roles/`).",
+				entityLabel,
+			),
+			SupportedOperators: []schema.DataSourceFilterOperator{
+				schema.DataSourceFilterOperatorEquals,
+				schema.DataSourceFilterOperatorStartsWith,
+			},
+		},
+	}
+
+	if taggable {
+		filterFields["tag"] = &provider.DataSourceFilterSchema{
+			Type: provider.DataSourceFilterSearchValueTypeString,
+			Description: fmt.Sprintf(
+				"A tag that the IAM %s must have. Use the equals operator with a "+
+					"key=value search to match a tag value or the has key operator with a tag key "+
+					"to match a tag with any value.",
+				entityLabel,
+			),
+			FormattedDescription: fmt.Sprintf(
+				"A tag that the IAM %s must have. Use the `=` operator with a "+
+					"`key=value` search to match a tag value or the `has key` operator with a tag key "+
+					"to match a tag with any value.",
+				entityLabel,
+			),
+			SupportedOperators: []schema.DataSourceFilterOperator{
+				schema.DataSourceFilterOperatorEquals,
+				schema.DataSourceFilterOperatorHasKey,
+			},
+		}
+	}
+
+	return filterFields
+}
+
+func extractEntityFilters(
+	filters *provider.ResolvedDataSourceFilters,
+	entityLabel string,
+) (*entityFilters, error) {
+	extracted := &entityFilters{}
+	tagFilters := []*provider.ResolvedDataSourceFilter{}
+	for _, filter := range filters.Filters {
+		switch core.StringValueFromScalar(filter.Field) {
+		case "name":
+			extracted.name = core.StringValue(pluginutils.GetDataSourceFilterSearchValue(filter, 0))
+		case "arn":
+			extracted.arn = core.StringValue(pluginutils.GetDataSourceFilterSearchValue(filter, 0))
+		case "path":
+			extracted.pathFilters = append(extracted.pathFilters, filter)
+		case "tag":
+			tagFilters = append(tagFilters, filter)
+		}
+	}
+
+	if extracted.name == "" && extracted.arn == "" {
+		return nil, fmt.Errorf("name or ARN filter is required for the IAM %s data source", entityLabel)
+	}
+
+	parsedTagFilters, err := extractTagFilters(tagFilters)
+	if err != nil {
+		return nil, err
+	}
+	extracted.tagFilters = parsedTagFilters
+
+	return extracted, nil
+}
+
+// nameOrNameFromARN returns the name filter, or when the entity is looked up
+// by ARN, the name at the end of an ARN in the form
+// arn:{partition}:iam::{account}:{entityType}/{path}{name}.
+func (f *entityFilters) nameOrNameFromARN() string {
+	if f.name != "" {
+		return f.name
+	}
+	return f.arn[strings.LastIndex(f.arn, "/")+1:]
+}
+
+// check makes sure that an entity that was found by name or ARN
+// matches the rest of the filters.
+func (f *entityFilters) check(
+	entityLabel string,
+	arn string,
+	path string,
+	tags []types.Tag,
+) error {
+	if f.arn != "" && f.arn != arn {
+		return fmt.Errorf("IAM %s with ARN %q was not found", entityLabel, f.arn)
+	}
+
+	if !f.pathMatches(path) {
+		return fmt.Errorf(
+			"IAM %s %q with path %q does not match the path filter",
+			entityLabel,
+			arn,
+			path,
+		)
+	}
+
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	for _, tagFilter := range f.tagFilters {
+		if !tagFilter.matches(tagMap) {
+			return fmt.Errorf("IAM %s %q does not match the tag filter", entityLabel, arn)
+		}
+	}
+
+	return nil
+}
+
+func (f *entityFilters) pathMatches(path string) bool {
+	for _, pathFilter := range f.pathFilters {
+		if !pathFilterMatches(pathFilter, path) {
+			return false
+		}
+	}
+	return true
+}
+
+func pathFilterMatches(filter *provider.ResolvedDataSourceFilter, path string) bool {
+	searchValues := pluginutils.GetDataSourceFilterSearchValues(filter)
+	return slices.ContainsFunc(searchValues, func(searchValue *core.MappingNode) bool {
+		search := core.StringValue(searchValue)
+		if pluginutils.GetDataSourceFilterOperator(filter) == schema.DataSourceFilterOperatorStartsWith {
+			return strings.HasPrefix(path, search)
+		}
+		return path == search
+	})
+}
+
+// tagFilter matches entities that have a tag with the given key,
+// and if hasValue is true, the given value.
+type tagFilter struct {
+	keys     []string
+	values   []string
+	hasValue bool
+}
+
+func extractTagFilters(filters []*provider.ResolvedDataSourceFilter) ([]*tagFilter, error) {
+	tagFilters := []*tagFilter{}
+	for _, filter := range filters {
+		filterForTag := &tagFilter{
+			hasValue: pluginutils.GetDataSourceFilterOperator(filter) == schema.DataSourceFilterOperatorEquals,
+		}
+
+		for _, searchValue := range pluginutils.GetDataSourceFilterSearchValues(filter) {
+			search := core.StringValue(searchValue)
+			if !filterForTag.hasValue {
+				filterForTag.keys = append(filterForTag.keys, search)
+				continue
+			}
+
+			key, value, hasSeparator := strings.Cut(search, "=")
+			if !hasSeparator {
+				return nil, fmt.Errorf(
+					"tag filter search value %q must be in the form \"key=value\"",
+					search,
+				)
+			}
+			filterForTag.keys = append(filterForTag.keys, key)
+			filterForTag.values = append(filterForTag.values, value)
+		}
+
+		tagFilters = append(tagFilters, filterForTag)
+	}
+
+	return tagFilters, nil
+}
+
+// matches determines whether any of the search values of the tag filter
+// match the provided tags.
+func (f *tagFilter) matches(tags map[string]string) bool {
+	for i, key := range f.keys {
+		value, hasKey := tags[key]
+		if hasKey && (!f.hasValue || value == f.values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodePolicyDocument decodes a URL-encoded policy document
+// returned by the IAM API into a JSON string.
+func decodePolicyDocument(policyDocument *string) (string, error) {
+	decoded, err := url.QueryUnescape(aws.ToString(policyDocument))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode policy document: %w", err)
+	}
+	return decoded, nil
+}
+
+func tagsToDataSourceValue(tags []types.Tag) *core.MappingNode {
+	tagNodes := make([]*core.MappingNode, 0, len(tags))
+	for _, tag := range tags {
+		tagNodes = append(tagNodes, &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"key":   core.MappingNodeFromString(aws.ToString(tag.Key)),
+				"value": core.MappingNodeFromString(aws.ToString(tag.Value)),
+			},
+		})
+	}
+	return &core.MappingNode{Items: tagNodes}
+}
+
+func stringsToDataSourceValue(values []string) *core.MappingNode {
+	items := make([]*core.MappingNode, 0, len(values))
+	for _, value := range values {
+		items = append(items, core.MappingNodeFromString(value))
+	}
+	return &core.MappingNode{Items: items}
+}
+
+func attachedPolicyARNs(attachedPolicies []types.AttachedPolicy) []string {
+	arns := make([]string, 0, len(attachedPolicies))
+	for _, attachedPolicy := range attachedPolicies {
+		arns = append(arns, aws.ToString(attachedPolicy.PolicyArn))
+	}
+	return arns
+}
+
+// inlinePolicyData sets the names and documents of the inline policies of an IAM entity
+// as parallel arrays in the data source output.
+func inlinePolicyData(
+	targetData map[string]*core.MappingNode,
+	policyNames []string,
+	getPolicyDocument func(policyName string) (*string, error),
+) error {
+	documents := make([]string, 0, len(policyNames))
+	for _, policyName := range policyNames {
+		policyDocument, err := getPolicyDocument(policyName)
+		if err != nil {
+			return fmt.Errorf("failed to get inline policy %q: %w", policyName, err)
+		}
+
+		decoded, err := decodePolicyDocument(policyDocument)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, decoded)
+	}
+
+	targetData["inlinePolicyNames"] = stringsToDataSourceValue(policyNames)
+	targetData["inlinePolicyDocuments"] = stringsToDataSourceValue(documents)
+	return nil
+}
+
+func formatDataSourceDate(date *time.Time) *core.MappingNode {
+	return core.MappingNodeFromString(aws.ToTime(date).Format("2006-01-02T15:04:05Z"))
+}
@@ -0,0 +1,70 @@
+package iam
+
+import (
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// exclusivePolicyManagementEnabled determines whether the inline policies and
+// managed policy attachments of a role, user or group are exclusively managed
+// by the blueprint.
+func exclusivePolicyManagementEnabled(specData *core.MappingNode) bool {
+	exclusive, hasExclusive := pluginutils.GetValueByPath("$.exclusivePolicyManagement", specData)
+	return hasExclusive && core.BoolValue(exclusive)
+}
+
+// carryOverExclusivePolicyManagement copies the exclusive policy management
+// setting from the current resource spec into the external state,
+// the setting only exists in the blueprint so should never be reported as drift.
+func carryOverExclusivePolicyManagement(
+	currentResourceSpec *core.MappingNode,
+	externalState map[string]*core.MappingNode,
+) {
+	exclusive, hasExclusive := pluginutils.GetValueByPath(
+		"$.exclusivePolicyManagement",
+		currentResourceSpec,
+	)
+	if hasExclusive && exclusive != nil {
+		externalState["exclusivePolicyManagement"] = exclusive
+	}
+}
+
+// undeclaredInlinePolicies returns the names of the live inline policies
+// that are not declared in the given spec.
+// Inline policies added by links are managed by the links and are never included.
+func undeclaredInlinePolicies(livePolicyNames []string, specData *core.MappingNode) []string {
+	declared := map[string]bool{}
+	if policies, hasPolicies := pluginutils.GetValueByPath("$.policies", specData); hasPolicies && policies != nil {
+		for _, policy := range policies.Items {
+			declared[core.StringValue(policy.Fields["policyName"])] = true
+		}
+	}
+
+	undeclared := []string{}
+	for _, policyName := range livePolicyNames {
+		if !declared[policyName] && !utils.IsLinkInlinePolicy(policyName) {
+			undeclared = append(undeclared, policyName)
+		}
+	}
+	return undeclared
+}
+
+// undeclaredManagedPolicyArns returns the ARNs of the live managed policy
+// attachments that are not declared in the given spec.
+func undeclaredManagedPolicyArns(livePolicyArns []string, specData *core.MappingNode) []string {
+	declared := map[string]bool{}
+	if policyArns, hasPolicyArns := pluginutils.GetValueByPath("$.managedPolicyArns", specData); hasPolicyArns && policyArns != nil {
+		for _, policyArn := range policyArns.Items {
+			declared[core.StringValue(policyArn)] = true
+		}
+	}
+
+	undeclared := []string{}
+	for _, policyArn := range livePolicyArns {
+		if !declared[policyArn] {
+			undeclared = append(undeclared, policyArn)
+		}
+	}
+	return undeclared
+}
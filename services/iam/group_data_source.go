@@ -0,0 +1,154 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// GroupDataSource returns a data source implementation for an AWS IAM Group.
+func GroupDataSource(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/iam_group_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/iam_group_jsonc.md")
+
+	iamGroupFetcher := &iamGroupDataSourceFetcher{
+		iamServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/iam/group",
+		Label:            "AWS IAM Group",
+		PlainTextSummary: "A data source for retrieving an AWS IAM group.",
+		FormattedDescription: "The data source type used to define an [IAM group](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_groups.html) " +
+			"managed externally in AWS. IAM groups can not be tagged, so groups can only be filtered by name, ARN and path.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields:       iamGroupDataSourceSchema(),
+		FilterFields: entityFilterFields("group", false),
+		FetchFunc:    iamGroupFetcher.Fetch,
+	}
+}
+
+type iamGroupDataSourceFetcher struct {
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	awsConfigStore    pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (i *iamGroupDataSourceFetcher) getIamService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (iamservice.Service, error) {
+	awsConfig, err := i.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.iamServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (i *iamGroupDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	iamService, err := i.getIamService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM service: %w", err)
+	}
+
+	filters, err := extractEntityFilters(input.DataSourceWithResolvedSubs.Filter, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	groupName := aws.String(filters.nameOrNameFromARN())
+	var group *types.Group
+	userNames := []string{}
+	var marker *string
+	for {
+		groupOutput, err := iamService.GetGroup(ctx, &iam.GetGroupInput{
+			GroupName: groupName,
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IAM group: %w", err)
+		}
+
+		group = groupOutput.Group
+		for _, user := range groupOutput.Users {
+			userNames = append(userNames, aws.ToString(user.UserName))
+		}
+		if !groupOutput.IsTruncated {
+			break
+		}
+		marker = groupOutput.Marker
+	}
+
+	err = filters.check("group", aws.ToString(group.Arn), aws.ToString(group.Path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]*core.MappingNode{
+		"arn":        core.MappingNodeFromString(aws.ToString(group.Arn)),
+		"name":       core.MappingNodeFromString(aws.ToString(group.GroupName)),
+		"groupId":    core.MappingNodeFromString(aws.ToString(group.GroupId)),
+		"path":       core.MappingNodeFromString(aws.ToString(group.Path)),
+		"createDate": formatDataSourceDate(group.CreateDate),
+		"userNames":  stringsToDataSourceValue(userNames),
+	}
+
+	err = i.addPolicies(ctx, iamService, group.GroupName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: data,
+	}, nil
+}
+
+func (i *iamGroupDataSourceFetcher) addPolicies(
+	ctx context.Context,
+	iamService iamservice.Service,
+	groupName *string,
+	data map[string]*core.MappingNode,
+) error {
+	managedPolicyARNs, err := listAttachedGroupPolicyArns(ctx, iamService, aws.ToString(groupName))
+	if err != nil {
+		return err
+	}
+	data["managedPolicyArns"] = stringsToDataSourceValue(managedPolicyARNs)
+
+	policyNames, err := listGroupPolicyNames(ctx, iamService, aws.ToString(groupName))
+	if err != nil {
+		return err
+	}
+
+	return inlinePolicyData(data, policyNames, func(policyName string) (*string, error) {
+		output, err := iamService.GetGroupPolicy(ctx, &iam.GetGroupPolicyInput{
+			GroupName:  groupName,
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.PolicyDocument, nil
+	})
+}
@@ -0,0 +1,74 @@
+package iam
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func iamGroupDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arn": {
+			Label:       "Group ARN",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The Amazon Resource Name (ARN) of the group.",
+			Nullable:    false,
+		},
+		"name": {
+			Label:       "Group Name",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The name of the group.",
+			Nullable:    false,
+		},
+		"groupId": {
+			Label:       "Group ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The stable and unique string identifying the group.",
+			Nullable:    false,
+		},
+		"path": {
+			Label:       "Path",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The path to the group.",
+			Nullable:    false,
+		},
+		"createDate": {
+			Label:       "Create Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the group was created.",
+			Nullable:    false,
+		},
+		"userNames": {
+			Label:       "User Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the users that belong to the group.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"managedPolicyArns": {
+			Label:       "Managed Policy ARNs",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The ARNs of the managed policies attached to the group.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyNames": {
+			Label:       "Inline Policy Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the inline policies embedded in the group.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyDocuments": {
+			Label:       "Inline Policy Documents",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The policy documents of the inline policies embedded in the group as JSON strings, in the same order as inlinePolicyNames.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package iam
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IamGroupDataSourceSuite struct {
+	suite.Suite
+}
+
+func (s *IamGroupDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []IamDataSourceFetchTestCase{
+		createGroupFetchByNameTestCase(providerCtx, loader),
+		createGroupPathFilterMismatchTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := GroupDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func (s *IamGroupDataSourceSuite) Test_does_not_support_tag_filters() {
+	dataSource := GroupDataSource(
+		iammock.CreateIamServiceMockFactory(),
+		createTestConfigStore(&testutils.MockAWSConfigLoader{}),
+	)
+
+	output, err := dataSource.GetFilterFields(
+		context.Background(),
+		&provider.DataSourceGetFilterFieldsInput{},
+	)
+	s.Require().NoError(err)
+	s.Contains(output.FilterFields, "name")
+	s.Contains(output.FilterFields, "arn")
+	s.Contains(output.FilterFields, "path")
+	s.NotContains(output.FilterFields, "tag")
+}
+
+func TestIamGroupDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(IamGroupDataSourceSuite))
+}
+
+// Test case generator functions below.
+
+const testGroupARN = "arn:aws:iam::123456789012:group/engineering/developers"
+
+func createGroupFetchByNameTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "successfully fetches group by name",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetGroupOutput(&iam.GetGroupOutput{
+				Group: &types.Group{
+					Arn:        aws.String(testGroupARN),
+					GroupName:  aws.String("developers"),
+					GroupId:    aws.String("AGPA123456789EXAMPLE"),
+					Path:       aws.String("/engineering/"),
+					CreateDate: aws.Time(testDataSourceCreateDate),
+				},
+				Users: []types.User{
+					{UserName: aws.String("alice")},
+					{UserName: aws.String("bob")},
+				},
+			}),
+			iammock.WithListAttachedGroupPoliciesOutput(&iam.ListAttachedGroupPoliciesOutput{
+				AttachedPolicies: []types.AttachedPolicy{},
+			}),
+			iammock.WithListGroupPoliciesOutput(&iam.ListGroupPoliciesOutput{
+				PolicyNames: []string{"s3-read"},
+			}),
+			iammock.WithGetGroupPolicyOutput(&iam.GetGroupPolicyOutput{
+				PolicyName:     aws.String("s3-read"),
+				PolicyDocument: aws.String(url.QueryEscape(testInlinePolicy)),
+			}),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "developers").Filters[0],
+			createFilter("path", schema.DataSourceFilterOperatorEquals, "/engineering/"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: map[string]*core.MappingNode{
+				"arn":        core.MappingNodeFromString(testGroupARN),
+				"name":       core.MappingNodeFromString("developers"),
+				"groupId":    core.MappingNodeFromString("AGPA123456789EXAMPLE"),
+				"path":       core.MappingNodeFromString("/engineering/"),
+				"createDate": core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+				"userNames": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("alice"),
+						core.MappingNodeFromString("bob"),
+					},
+				},
+				"managedPolicyArns": {
+					Items: []*core.MappingNode{},
+				},
+				"inlinePolicyNames": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("s3-read"),
+					},
+				},
+				"inlinePolicyDocuments": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString(testInlinePolicy),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createGroupPathFilterMismatchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "fails when the group does not match the path prefix filter",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetGroupOutput(&iam.GetGroupOutput{
+				Group: &types.Group{
+					Arn:       aws.String(testGroupARN),
+					GroupName: aws.String("developers"),
+					Path:      aws.String("/engineering/"),
+				},
+			}),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", testGroupARN).Filters[0],
+			createFilter("path", schema.DataSourceFilterOperatorStartsWith, "/finance/"),
+		),
+		ExpectError: true,
+		ExpectedErrorMessage: "IAM group \"" + testGroupARN + "\" with path \"/engineering/\" " +
+			"does not match the path filter",
+	}
+}
@@ -53,6 +53,7 @@ func GroupResource(
 		UpdateFunc:           iamGroupActions.Update,
 		DestroyFunc:          iamGroupActions.Destroy,
 		StabilisedFunc:       iamGroupActions.Stabilised,
+		CustomValidateFunc:   iamGroupActions.CustomValidate,
 	}
 }
 
@@ -2,7 +2,6 @@ package iam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -82,7 +81,7 @@ func (i *iamGroupResourceActions) GetExternalState(
 	}
 
 	// Get inline policies
-	inlinePolicies, err := i.getInlinePolicies(ctx, iamService, groupName)
+	inlinePolicies, err := i.getInlinePolicies(ctx, iamService, groupName, input.CurrentResourceSpec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inline policies: %w", err)
 	}
@@ -92,6 +91,8 @@ func (i *iamGroupResourceActions) GetExternalState(
 		}
 	}
 
+	carryOverExclusivePolicyManagement(input.CurrentResourceSpec, externalState)
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: &core.MappingNode{
 			Fields: externalState,
@@ -104,16 +105,14 @@ func (i *iamGroupResourceActions) getManagedPolicies(
 	iamService iamservice.Service,
 	groupName string,
 ) ([]*core.MappingNode, error) {
-	result, err := iamService.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	})
+	policyArns, err := listAttachedGroupPolicyArns(ctx, iamService, groupName)
 	if err != nil {
 		return nil, err
 	}
 
 	var policies []*core.MappingNode
-	for _, policy := range result.AttachedPolicies {
-		policies = append(policies, core.MappingNodeFromString(aws.ToString(policy.PolicyArn)))
+	for _, policyArn := range policyArns {
+		policies = append(policies, core.MappingNodeFromString(policyArn))
 	}
 
 	return policies, nil
@@ -123,17 +122,18 @@ func (i *iamGroupResourceActions) getInlinePolicies(
 	ctx context.Context,
 	iamService iamservice.Service,
 	groupName string,
+	currentResourceSpec *core.MappingNode,
 ) ([]*core.MappingNode, error) {
+	declaredPolicyDocuments := declaredInlinePolicyDocuments(currentResourceSpec)
+
 	// First, list all inline policy names
-	listResult, err := iamService.ListGroupPolicies(ctx, &iam.ListGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	})
+	policyNames, err := listGroupPolicyNames(ctx, iamService, groupName)
 	if err != nil {
 		return nil, err
 	}
 
 	var policies []*core.MappingNode
-	for _, policyName := range listResult.PolicyNames {
+	for _, policyName := range policyNames {
 		// Get the policy document for each policy
 		policyResult, err := iamService.GetGroupPolicy(ctx, &iam.GetGroupPolicyInput{
 			GroupName:  aws.String(groupName),
@@ -143,16 +143,12 @@ func (i *iamGroupResourceActions) getInlinePolicies(
 			return nil, fmt.Errorf("failed to get policy %s: %w", policyName, err)
 		}
 
-		// Parse the policy document JSON
-		var policyDoc map[string]interface{}
-		if err := json.Unmarshal([]byte(aws.ToString(policyResult.PolicyDocument)), &policyDoc); err != nil {
-			return nil, fmt.Errorf("failed to parse policy document for %s: %w", policyName, err)
-		}
-
-		// Convert to MappingNode
-		policyDocNode, err := convertInterfaceToMappingNode(policyDoc)
+		policyDocNode, err := externalPolicyDocument(
+			declaredPolicyDocuments[policyName],
+			aws.ToString(policyResult.PolicyDocument),
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert policy document to mapping node: %w", err)
+			return nil, fmt.Errorf("failed to parse policy document for %s: %w", policyName, err)
 		}
 
 		policyNode := &core.MappingNode{
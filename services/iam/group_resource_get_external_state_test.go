@@ -37,6 +37,7 @@ func (s *IAMGroupResourceGetExternalStateSuite) Test_get_external_state_iam_grou
 		createBasicGroupGetExternalStateTestCase(providerCtx, loader),
 		createGroupWithPoliciesGetExternalStateTestCase(providerCtx, loader),
 		createGroupWithManagedPoliciesGetExternalStateTestCase(providerCtx, loader),
+		createGroupWithPagedManagedPoliciesGetExternalStateTestCase(providerCtx, loader),
 		createGroupGetExternalStateFailureTestCase(providerCtx, loader),
 	}
 
@@ -274,6 +275,90 @@ func createGroupWithManagedPoliciesGetExternalStateTestCase(
 	}
 }
 
+func createGroupWithPagedManagedPoliciesGetExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	resourceARN := "arn:aws:iam::123456789012:group/test-group-with-managed-policies"
+	groupId := "AGPA1234567890123458"
+
+	currentResourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":       core.MappingNodeFromString(resourceARN),
+			"groupId":   core.MappingNodeFromString(groupId),
+			"groupName": core.MappingNodeFromString("test-group-with-managed-policies"),
+			"path":      core.MappingNodeFromString("/"),
+		},
+	}
+
+	expectedResourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":       core.MappingNodeFromString(resourceARN),
+			"groupId":   core.MappingNodeFromString(groupId),
+			"groupName": core.MappingNodeFromString("test-group-with-managed-policies"),
+			"path":      core.MappingNodeFromString("/"),
+			"managedPolicyArns": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name: "get external state group with managed policies across multiple pages",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetGroupOutput(&iam.GetGroupOutput{
+				Group: &types.Group{
+					Arn:       aws.String(resourceARN),
+					GroupId:   aws.String(groupId),
+					GroupName: aws.String("test-group-with-managed-policies"),
+					Path:      aws.String("/"),
+				},
+			}),
+			iammock.WithListGroupPoliciesOutput(&iam.ListGroupPoliciesOutput{
+				PolicyNames: []string{},
+			}),
+			iammock.WithListAttachedGroupPoliciesOutputSequence(
+				&iam.ListAttachedGroupPoliciesOutput{
+					AttachedPolicies: []types.AttachedPolicy{
+						{
+							PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+							PolicyName: aws.String("ReadOnlyAccess"),
+						},
+					},
+					IsTruncated: true,
+					Marker:      aws.String("page-2"),
+				},
+				&iam.ListAttachedGroupPoliciesOutput{
+					AttachedPolicies: []types.AttachedPolicy{
+						{
+							PolicyArn:  aws.String("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"),
+							PolicyName: aws.String("AmazonS3ReadOnlyAccess"),
+						},
+					},
+				},
+			),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID:          "test-instance-id",
+			ResourceID:          "test-group-id",
+			CurrentResourceSpec: currentResourceSpec,
+			ProviderContext:     providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: expectedResourceSpecState,
+		},
+	}
+}
+
 func createGroupGetExternalStateFailureTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
@@ -11,6 +11,14 @@ func iamGroupResourceSchema() *provider.ResourceDefinitionsSchema {
 		Label:       "IAMGroupDefinition",
 		Description: "The definition of an AWS IAM group.",
 		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"exclusivePolicyManagement": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether the inline policies and managed policy attachments of the group are exclusively " +
+					"managed by the blueprint. When enabled, inline policies and managed policies attached to the group " +
+					"outside of the blueprint are reported as drift and are removed when the group is updated.",
+				Default:  core.MappingNodeFromBool(false),
+				Nullable: true,
+			},
 			"managedPolicyArns": {
 				Type:        provider.ResourceDefinitionsSchemaTypeArray,
 				Description: "A list of Amazon Resource Names (ARNs) of the IAM managed policies that you want to attach to the group.",
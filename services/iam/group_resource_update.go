@@ -47,6 +47,7 @@ func (i *iamGroupResourceActions) Update(
 		&groupUpdate{groupName: groupName},
 		&groupInlinePoliciesUpdate{groupName: groupName},
 		&groupManagedPoliciesUpdate{groupName: groupName},
+		&groupExclusivePoliciesUpdate{groupName: groupName},
 	}
 
 	saveOpCtx := pluginutils.SaveOperationContext{
@@ -95,51 +95,13 @@ func (g *groupInlinePoliciesUpdate) Prepare(
 	currentPolicies, _ := pluginutils.GetValueByPath("$.policies", currentStateSpecData)
 	newPolicies, _ := pluginutils.GetValueByPath("$.policies", specData)
 
-	// Create maps for easier comparison
-	currentMap := make(map[string]*core.MappingNode)
-	if currentPolicies != nil {
-		for _, policy := range currentPolicies.Items {
-			if policyName, hasPolicyName := pluginutils.GetValueByPath("$.policyName", policy); hasPolicyName {
-				policyNameStr := core.StringValue(policyName)
-				if policyNameStr != "" {
-					currentMap[policyNameStr] = policy
-				}
-			}
-		}
-	}
-
-	newMap := make(map[string]*core.MappingNode)
-	if newPolicies != nil {
-		for _, policy := range newPolicies.Items {
-			if policyName, hasPolicyName := pluginutils.GetValueByPath("$.policyName", policy); hasPolicyName {
-				policyNameStr := core.StringValue(policyName)
-				if policyNameStr != "" {
-					newMap[policyNameStr] = policy
-				}
-			}
-		}
-	}
-
-	// Determine what needs to be added, updated, or removed
-	var policiesToAdd []*core.MappingNode
-	var policiesToRemove []string
+	result := diffIAMPolicies(currentPolicies, newPolicies)
 
-	for policyName, policy := range newMap {
-		if _, exists := currentMap[policyName]; !exists {
-			policiesToAdd = append(policiesToAdd, policy)
-		}
-	}
+	// Both new and updated policies are saved with PutGroupPolicy.
+	g.policiesToAdd = append(result.toAdd, result.toUpdate...)
+	g.policiesToRemove = result.toRemove
 
-	for policyName := range currentMap {
-		if _, exists := newMap[policyName]; !exists {
-			policiesToRemove = append(policiesToRemove, policyName)
-		}
-	}
-
-	g.policiesToAdd = policiesToAdd
-	g.policiesToRemove = policiesToRemove
-
-	return len(policiesToAdd) > 0 || len(policiesToRemove) > 0, saveOpCtx, nil
+	return len(g.policiesToAdd) > 0 || len(g.policiesToRemove) > 0, saveOpCtx, nil
 }
 
 func (g *groupInlinePoliciesUpdate) Execute(
@@ -158,7 +120,7 @@ func (g *groupInlinePoliciesUpdate) Execute(
 		}
 	}
 
-	// Add policies
+	// Add and update policies
 	for _, policy := range g.policiesToAdd {
 		policyName, hasPolicyName := pluginutils.GetValueByPath("$.policyName", policy)
 		if !hasPolicyName {
@@ -284,3 +246,64 @@ func (g *groupManagedPoliciesUpdate) Execute(
 
 	return saveOpCtx, nil
 }
+
+// groupExclusivePoliciesUpdate removes the inline policies and managed policy attachments
+// that are not declared in the blueprint when exclusive policy management
+// is enabled for the group.
+type groupExclusivePoliciesUpdate struct {
+	groupName string
+	specData  *core.MappingNode
+}
+
+func (g *groupExclusivePoliciesUpdate) Name() string {
+	return "remove undeclared policies"
+}
+
+func (g *groupExclusivePoliciesUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	g.specData = specData
+	return exclusivePolicyManagementEnabled(specData), saveOpCtx, nil
+}
+
+func (g *groupExclusivePoliciesUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	iamService iamservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	// Policies are listed after the declared policies have been updated
+	// so that only policies added outside of the blueprint are removed.
+	policyNames, err := listGroupPolicyNames(ctx, iamService, g.groupName)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	for _, policyName := range undeclaredInlinePolicies(policyNames, g.specData) {
+		_, err := iamService.DeleteGroupPolicy(ctx, &iam.DeleteGroupPolicyInput{
+			GroupName:  aws.String(g.groupName),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to delete undeclared inline policy %s: %w", policyName, err)
+		}
+	}
+
+	policyArns, err := listAttachedGroupPolicyArns(ctx, iamService, g.groupName)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	for _, policyArn := range undeclaredManagedPolicyArns(policyArns, g.specData) {
+		_, err := iamService.DetachGroupPolicy(ctx, &iam.DetachGroupPolicyInput{
+			GroupName: aws.String(g.groupName),
+			PolicyArn: aws.String(policyArn),
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to detach undeclared managed policy %s: %w", policyArn, err)
+		}
+	}
+
+	return saveOpCtx, nil
+}
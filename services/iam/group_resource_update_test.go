@@ -40,6 +40,7 @@ func (s *IAMGroupResourceUpdateSuite) Test_update_iam_group() {
 		createGroupNoUpdatesTestCase(providerCtx, loader),
 		createGroupPoliciesUpdateTestCase(providerCtx, loader),
 		createGroupManagedPoliciesUpdateTestCase(providerCtx, loader),
+		createGroupExclusivePolicyManagementUpdateTestCase(providerCtx, loader),
 		createGroupUpdateFailureTestCase(providerCtx, loader),
 		recreateGroupOnGroupNameChangeTestCase(providerCtx, loader),
 	}
@@ -527,6 +528,134 @@ func createGroupManagedPoliciesUpdateTestCase(
 	}
 }
 
+func createGroupExclusivePolicyManagementUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service] {
+	resourceARN := "arn:aws:iam::123456789012:group/test-group"
+	groupId := "AGPA1234567890123456"
+
+	service := iammock.CreateIamServiceMock(
+		iammock.WithGetGroupOutput(&iam.GetGroupOutput{
+			Group: &types.Group{
+				Arn:       aws.String(resourceARN),
+				GroupId:   aws.String(groupId),
+				GroupName: aws.String("test-group"),
+				Path:      aws.String("/"),
+			},
+		}),
+		iammock.WithListGroupPoliciesOutput(&iam.ListGroupPoliciesOutput{
+			PolicyNames: []string{"ConsolePolicy"},
+		}),
+		iammock.WithListAttachedGroupPoliciesOutput(&iam.ListAttachedGroupPoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+					PolicyName: aws.String("ReadOnlyAccess"),
+				},
+				{
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+					PolicyName: aws.String("AdministratorAccess"),
+				},
+			},
+		}),
+		iammock.WithDeleteGroupPolicyOutput(&iam.DeleteGroupPolicyOutput{}),
+		iammock.WithDetachGroupPolicyOutput(&iam.DetachGroupPolicyOutput{}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":       core.MappingNodeFromString(resourceARN),
+			"groupId":   core.MappingNodeFromString(groupId),
+			"groupName": core.MappingNodeFromString("test-group"),
+			"path":      core.MappingNodeFromString("/"),
+			"managedPolicyArns": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+			},
+		},
+	}
+
+	// ConsolePolicy and the AdministratorAccess attachment were added outside of the blueprint.
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"groupName":                 core.MappingNodeFromString("test-group"),
+			"path":                      core.MappingNodeFromString("/"),
+			"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+			"managedPolicyArns": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
+		Name: "update group removes undeclared policies with exclusive policy management",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-group-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-group-id",
+					ResourceName: "TestGroup",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-group-id",
+						Name:       "TestGroup",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/iam/group",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.exclusivePolicyManagement",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":       core.MappingNodeFromString(resourceARN),
+				"spec.groupId":   core.MappingNodeFromString(groupId),
+				"spec.groupName": core.MappingNodeFromString("test-group"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"GetGroup": &iam.GetGroupInput{
+				GroupName: aws.String("test-group"),
+			},
+			"DeleteGroupPolicy": &iam.DeleteGroupPolicyInput{
+				GroupName:  aws.String("test-group"),
+				PolicyName: aws.String("ConsolePolicy"),
+			},
+			"DetachGroupPolicy": &iam.DetachGroupPolicyInput{
+				GroupName: aws.String("test-group"),
+				PolicyArn: aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+			},
+		},
+		SaveActionsNotCalled: []string{"AttachGroupPolicy", "PutGroupPolicy"},
+	}
+}
+
 func createGroupUpdateFailureTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
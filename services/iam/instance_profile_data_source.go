@@ -0,0 +1,116 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// InstanceProfileDataSource returns a data source implementation for an AWS IAM Instance Profile.
+func InstanceProfileDataSource(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/iam_instance_profile_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/iam_instance_profile_jsonc.md")
+
+	iamInstanceProfileFetcher := &iamInstanceProfileDataSourceFetcher{
+		iamServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/iam/instanceProfile",
+		Label:            "AWS IAM Instance Profile",
+		PlainTextSummary: "A data source for retrieving an AWS IAM instance profile.",
+		FormattedDescription: "The data source type used to define an [IAM instance profile](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use_switch-role-ec2_instance-profiles.html) " +
+			"managed externally in AWS.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields:       iamInstanceProfileDataSourceSchema(),
+		FilterFields: entityFilterFields("instance profile", true),
+		FetchFunc:    iamInstanceProfileFetcher.Fetch,
+	}
+}
+
+type iamInstanceProfileDataSourceFetcher struct {
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	awsConfigStore    pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (i *iamInstanceProfileDataSourceFetcher) getIamService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (iamservice.Service, error) {
+	awsConfig, err := i.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.iamServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (i *iamInstanceProfileDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	iamService, err := i.getIamService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM service: %w", err)
+	}
+
+	filters, err := extractEntityFilters(input.DataSourceWithResolvedSubs.Filter, "instance profile")
+	if err != nil {
+		return nil, err
+	}
+
+	instanceProfileOutput, err := iamService.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(filters.nameOrNameFromARN()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM instance profile: %w", err)
+	}
+
+	instanceProfile := instanceProfileOutput.InstanceProfile
+	err = filters.check(
+		"instance profile",
+		aws.ToString(instanceProfile.Arn),
+		aws.ToString(instanceProfile.Path),
+		instanceProfile.Tags,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roleARNs := make([]string, 0, len(instanceProfile.Roles))
+	roleNames := make([]string, 0, len(instanceProfile.Roles))
+	for _, role := range instanceProfile.Roles {
+		roleARNs = append(roleARNs, aws.ToString(role.Arn))
+		roleNames = append(roleNames, aws.ToString(role.RoleName))
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: map[string]*core.MappingNode{
+			"arn":               core.MappingNodeFromString(aws.ToString(instanceProfile.Arn)),
+			"name":              core.MappingNodeFromString(aws.ToString(instanceProfile.InstanceProfileName)),
+			"instanceProfileId": core.MappingNodeFromString(aws.ToString(instanceProfile.InstanceProfileId)),
+			"path":              core.MappingNodeFromString(aws.ToString(instanceProfile.Path)),
+			"createDate":        formatDataSourceDate(instanceProfile.CreateDate),
+			"roleArns":          stringsToDataSourceValue(roleARNs),
+			"roleNames":         stringsToDataSourceValue(roleNames),
+			"tags":              tagsToDataSourceValue(instanceProfile.Tags),
+		},
+	}, nil
+}
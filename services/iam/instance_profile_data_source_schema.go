@@ -0,0 +1,62 @@
+package iam
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func iamInstanceProfileDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arn": {
+			Label:       "Instance Profile ARN",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The Amazon Resource Name (ARN) of the instance profile.",
+			Nullable:    false,
+		},
+		"name": {
+			Label:       "Instance Profile Name",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The name of the instance profile.",
+			Nullable:    false,
+		},
+		"instanceProfileId": {
+			Label:       "Instance Profile ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The stable and unique string identifying the instance profile.",
+			Nullable:    false,
+		},
+		"path": {
+			Label:       "Path",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The path to the instance profile.",
+			Nullable:    false,
+		},
+		"createDate": {
+			Label:       "Create Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the instance profile was created.",
+			Nullable:    false,
+		},
+		"roleArns": {
+			Label:       "Role ARNs",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The ARNs of the roles associated with the instance profile.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"roleNames": {
+			Label:       "Role Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the roles associated with the instance profile.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"tags": {
+			Label:       "Tags",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The tags attached to the instance profile.",
+			Nullable:    false,
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IamInstanceProfileDataSourceSuite struct {
+	suite.Suite
+}
+
+func (s *IamInstanceProfileDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []IamDataSourceFetchTestCase{
+		createInstanceProfileFetchByARNTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := InstanceProfileDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func TestIamInstanceProfileDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(IamInstanceProfileDataSourceSuite))
+}
+
+// Test case generator functions below.
+
+const testInstanceProfileARN = "arn:aws:iam::123456789012:instance-profile/web-server-profile"
+
+func createInstanceProfileFetchByARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "successfully fetches instance profile by ARN",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetInstanceProfileOutput(&iam.GetInstanceProfileOutput{
+				InstanceProfile: &types.InstanceProfile{
+					Arn:                 aws.String(testInstanceProfileARN),
+					InstanceProfileName: aws.String("web-server-profile"),
+					InstanceProfileId:   aws.String("AIPA123456789EXAMPLE"),
+					Path:                aws.String("/"),
+					CreateDate:          aws.Time(testDataSourceCreateDate),
+					Roles: []types.Role{
+						{
+							Arn:      aws.String("arn:aws:iam::123456789012:role/web-server"),
+							RoleName: aws.String("web-server"),
+						},
+					},
+				},
+			}),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", testInstanceProfileARN).Filters[0],
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: map[string]*core.MappingNode{
+				"arn":               core.MappingNodeFromString(testInstanceProfileARN),
+				"name":              core.MappingNodeFromString("web-server-profile"),
+				"instanceProfileId": core.MappingNodeFromString("AIPA123456789EXAMPLE"),
+				"path":              core.MappingNodeFromString("/"),
+				"createDate":        core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+				"roleArns": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("arn:aws:iam::123456789012:role/web-server"),
+					},
+				},
+				"roleNames": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("web-server"),
+					},
+				},
+				"tags": {
+					Items: []*core.MappingNode{},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// ManagedPolicyDataSource returns a data source implementation for an AWS IAM Managed Policy.
+func ManagedPolicyDataSource(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/iam_managed_policy_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/iam_managed_policy_jsonc.md")
+
+	iamManagedPolicyFetcher := &iamManagedPolicyDataSourceFetcher{
+		iamServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/iam/managedPolicy",
+		Label:            "AWS IAM Managed Policy",
+		PlainTextSummary: "A data source for retrieving an AWS IAM managed policy.",
+		FormattedDescription: "The data source type used to define an [IAM managed policy](https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies_managed-vs-inline.html) " +
+			"managed externally in AWS. Both customer managed policies and AWS managed policies can be retrieved, " +
+			"when a policy is looked up by name and more than one policy has the same name, " +
+			"the ARN or path filter must be used to select a single policy.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields:       iamManagedPolicyDataSourceSchema(),
+		FilterFields: entityFilterFields("managed policy", true),
+		FetchFunc:    iamManagedPolicyFetcher.Fetch,
+	}
+}
+
+type iamManagedPolicyDataSourceFetcher struct {
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	awsConfigStore    pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (i *iamManagedPolicyDataSourceFetcher) getIamService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (iamservice.Service, error) {
+	awsConfig, err := i.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.iamServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (i *iamManagedPolicyDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	iamService, err := i.getIamService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM service: %w", err)
+	}
+
+	filters, err := extractEntityFilters(input.DataSourceWithResolvedSubs.Filter, "managed policy")
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := filters.arn
+	if policyARN == "" {
+		policyARN, err = i.findPolicyARNByName(ctx, iamService, filters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	policyOutput, err := iamService.GetPolicy(ctx, &iam.GetPolicyInput{
+		PolicyArn: aws.String(policyARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM managed policy: %w", err)
+	}
+
+	policy := policyOutput.Policy
+	err = filters.check("managed policy", aws.ToString(policy.Arn), aws.ToString(policy.Path), policy.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	policyVersionOutput, err := iamService.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: policy.Arn,
+		VersionId: policy.DefaultVersionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default version of IAM managed policy: %w", err)
+	}
+
+	policyDocument, err := decodePolicyDocument(policyVersionOutput.PolicyVersion.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]*core.MappingNode{
+		"arn":              core.MappingNodeFromString(aws.ToString(policy.Arn)),
+		"name":             core.MappingNodeFromString(aws.ToString(policy.PolicyName)),
+		"policyId":         core.MappingNodeFromString(aws.ToString(policy.PolicyId)),
+		"path":             core.MappingNodeFromString(aws.ToString(policy.Path)),
+		"defaultVersionId": core.MappingNodeFromString(aws.ToString(policy.DefaultVersionId)),
+		"policyDocument":   core.MappingNodeFromString(policyDocument),
+		"attachmentCount":  core.MappingNodeFromInt(int(aws.ToInt32(policy.AttachmentCount))),
+		"isAttachable":     core.MappingNodeFromBool(policy.IsAttachable),
+		"createDate":       formatDataSourceDate(policy.CreateDate),
+		"updateDate":       formatDataSourceDate(policy.UpdateDate),
+		"tags":             tagsToDataSourceValue(policy.Tags),
+	}
+
+	if policy.Description != nil {
+		data["description"] = core.MappingNodeFromString(aws.ToString(policy.Description))
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: data,
+	}, nil
+}
+
+// findPolicyARNByName searches customer managed and AWS managed policies
+// for a policy with the name from the filters, as the IAM API only
+// allows retrieving a managed policy by ARN.
+// Path filters are applied to the search to allow selecting one of multiple
+// policies with the same name.
+func (i *iamManagedPolicyDataSourceFetcher) findPolicyARNByName(
+	ctx context.Context,
+	iamService iamservice.Service,
+	filters *entityFilters,
+) (string, error) {
+	matchingARNs := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListPolicies(ctx, &iam.ListPoliciesInput{
+			Scope:  types.PolicyScopeTypeAll,
+			Marker: marker,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list IAM managed policies: %w", err)
+		}
+
+		for _, policy := range output.Policies {
+			if aws.ToString(policy.PolicyName) == filters.name &&
+				filters.pathMatches(aws.ToString(policy.Path)) {
+				matchingARNs = append(matchingARNs, aws.ToString(policy.Arn))
+			}
+		}
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	if len(matchingARNs) == 0 {
+		return "", fmt.Errorf("IAM managed policy with name %q was not found", filters.name)
+	}
+
+	if len(matchingARNs) > 1 {
+		return "", fmt.Errorf(
+			"multiple IAM managed policies with name %q were found (%s), "+
+				"use the arn or path filter to select a single policy",
+			filters.name,
+			strings.Join(matchingARNs, ", "),
+		)
+	}
+
+	return matchingARNs[0], nil
+}
@@ -0,0 +1,80 @@
+package iam
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func iamManagedPolicyDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arn": {
+			Label:       "Policy ARN",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The Amazon Resource Name (ARN) of the managed policy.",
+			Nullable:    false,
+		},
+		"name": {
+			Label:       "Policy Name",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The name of the managed policy.",
+			Nullable:    false,
+		},
+		"policyId": {
+			Label:       "Policy ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The stable and unique string identifying the managed policy.",
+			Nullable:    false,
+		},
+		"path": {
+			Label:       "Path",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The path to the managed policy.",
+			Nullable:    false,
+		},
+		"description": {
+			Label:       "Description",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "A description of the managed policy.",
+			Nullable:    true,
+		},
+		"defaultVersionId": {
+			Label:       "Default Version ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The identifier of the default version of the managed policy (e.g. v1).",
+			Nullable:    false,
+		},
+		"policyDocument": {
+			Label:       "Policy Document",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The policy document of the default version of the managed policy, as a JSON string.",
+			Nullable:    false,
+		},
+		"attachmentCount": {
+			Label:       "Attachment Count",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The number of users, groups and roles that the managed policy is attached to.",
+			Nullable:    false,
+		},
+		"isAttachable": {
+			Label:       "Is Attachable",
+			Type:        provider.DataSourceSpecTypeBoolean,
+			Description: "Whether the managed policy can be attached to users, groups and roles.",
+			Nullable:    false,
+		},
+		"createDate": {
+			Label:       "Create Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the managed policy was created.",
+			Nullable:    false,
+		},
+		"updateDate": {
+			Label:       "Update Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the managed policy was last updated.",
+			Nullable:    false,
+		},
+		"tags": {
+			Label:       "Tags",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The tags attached to the managed policy.",
+			Nullable:    false,
+		},
+	}
+}
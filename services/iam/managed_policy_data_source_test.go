@@ -0,0 +1,240 @@
+package iam
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IamManagedPolicyDataSourceSuite struct {
+	suite.Suite
+}
+
+func (s *IamManagedPolicyDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []IamDataSourceFetchTestCase{
+		createManagedPolicyFetchByARNTestCase(providerCtx, loader),
+		createManagedPolicyFetchByNameTestCase(providerCtx, loader),
+		createManagedPolicyAmbiguousNameTestCase(providerCtx, loader),
+		createManagedPolicyNameNotFoundTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := ManagedPolicyDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func TestIamManagedPolicyDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(IamManagedPolicyDataSourceSuite))
+}
+
+// Test case generator functions below.
+
+const (
+	testManagedPolicyARN    = "arn:aws:iam::123456789012:policy/app/orders-policy"
+	testAWSManagedPolicyARN = "arn:aws:iam::aws:policy/app/orders-policy"
+)
+
+func createManagedPolicyServiceMockFactory(
+	listPoliciesOutput *iam.ListPoliciesOutput,
+) func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+	return iammock.CreateIamServiceMockFactory(
+		iammock.WithListPoliciesOutput(listPoliciesOutput),
+		iammock.WithGetPolicyOutput(&iam.GetPolicyOutput{
+			Policy: &types.Policy{
+				Arn:              aws.String(testManagedPolicyARN),
+				PolicyName:       aws.String("orders-policy"),
+				PolicyId:         aws.String("ANPA123456789EXAMPLE"),
+				Path:             aws.String("/app/"),
+				Description:      aws.String("Access to the orders bucket"),
+				DefaultVersionId: aws.String("v3"),
+				AttachmentCount:  aws.Int32(2),
+				IsAttachable:     true,
+				CreateDate:       aws.Time(testDataSourceCreateDate),
+				UpdateDate:       aws.Time(testDataSourceCreateDate),
+				Tags: []types.Tag{
+					{Key: aws.String("Service"), Value: aws.String("orders")},
+				},
+			},
+		}),
+		iammock.WithGetPolicyVersionOutput(&iam.GetPolicyVersionOutput{
+			PolicyVersion: &types.PolicyVersion{
+				VersionId:        aws.String("v3"),
+				IsDefaultVersion: true,
+				Document:         aws.String(url.QueryEscape(testInlinePolicy)),
+			},
+		}),
+	)
+}
+
+func expectedManagedPolicyData() map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"arn":              core.MappingNodeFromString(testManagedPolicyARN),
+		"name":             core.MappingNodeFromString("orders-policy"),
+		"policyId":         core.MappingNodeFromString("ANPA123456789EXAMPLE"),
+		"path":             core.MappingNodeFromString("/app/"),
+		"description":      core.MappingNodeFromString("Access to the orders bucket"),
+		"defaultVersionId": core.MappingNodeFromString("v3"),
+		"policyDocument":   core.MappingNodeFromString(testInlinePolicy),
+		"attachmentCount":  core.MappingNodeFromInt(2),
+		"isAttachable":     core.MappingNodeFromBool(true),
+		"createDate":       core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+		"updateDate":       core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+		"tags": {
+			Items: []*core.MappingNode{
+				{
+					Fields: map[string]*core.MappingNode{
+						"key":   core.MappingNodeFromString("Service"),
+						"value": core.MappingNodeFromString("orders"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createManagedPolicyFetchByARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "successfully fetches managed policy by ARN",
+		ServiceFactory: createManagedPolicyServiceMockFactory(nil),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", testManagedPolicyARN).Filters[0],
+			createFilter("tag", schema.DataSourceFilterOperatorEquals, "Service=orders"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: expectedManagedPolicyData(),
+		},
+	}
+}
+
+func createManagedPolicyFetchByNameTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "successfully fetches managed policy by name using the path filter to select a policy",
+		ServiceFactory: createManagedPolicyServiceMockFactory(&iam.ListPoliciesOutput{
+			Policies: []types.Policy{
+				{
+					Arn:        aws.String("arn:aws:iam::123456789012:policy/other-policy"),
+					PolicyName: aws.String("other-policy"),
+					Path:       aws.String("/"),
+				},
+				{
+					Arn:        aws.String(testAWSManagedPolicyARN),
+					PolicyName: aws.String("orders-policy"),
+					Path:       aws.String("/service-role/"),
+				},
+				{
+					Arn:        aws.String(testManagedPolicyARN),
+					PolicyName: aws.String("orders-policy"),
+					Path:       aws.String("/app/"),
+				},
+			},
+		}),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "orders-policy").Filters[0],
+			createFilter("path", schema.DataSourceFilterOperatorEquals, "/app/"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: expectedManagedPolicyData(),
+		},
+	}
+}
+
+func createManagedPolicyAmbiguousNameTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "fails when multiple managed policies have the same name",
+		ServiceFactory: createManagedPolicyServiceMockFactory(&iam.ListPoliciesOutput{
+			Policies: []types.Policy{
+				{
+					Arn:        aws.String(testAWSManagedPolicyARN),
+					PolicyName: aws.String("orders-policy"),
+					Path:       aws.String("/app/"),
+				},
+				{
+					Arn:        aws.String(testManagedPolicyARN),
+					PolicyName: aws.String("orders-policy"),
+					Path:       aws.String("/app/"),
+				},
+			},
+		}),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "orders-policy").Filters[0],
+		),
+		ExpectError: true,
+		ExpectedErrorMessage: "multiple IAM managed policies with name \"orders-policy\" were found (" +
+			testAWSManagedPolicyARN + ", " + testManagedPolicyARN + "), " +
+			"use the arn or path filter to select a single policy",
+	}
+}
+
+func createManagedPolicyNameNotFoundTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "fails when no managed policy has the name",
+		ServiceFactory: createManagedPolicyServiceMockFactory(&iam.ListPoliciesOutput{
+			Policies: []types.Policy{},
+		}),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "orders-policy").Filters[0],
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "IAM managed policy with name \"orders-policy\" was not found",
+	}
+}
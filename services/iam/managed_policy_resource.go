@@ -51,6 +51,7 @@ func ManagedPolicyResource(
 		UpdateFunc:           iamManagedPolicyActions.Update,
 		DestroyFunc:          iamManagedPolicyActions.Destroy,
 		StabilisedFunc:       iamManagedPolicyActions.Stabilised,
+		CustomValidateFunc:   iamManagedPolicyActions.CustomValidate,
 	}
 }
 
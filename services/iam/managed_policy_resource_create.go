@@ -63,6 +63,16 @@ func (i *iamManagedPolicyResourceActions) Create(
 		"spec.isAttachable":                  core.MappingNodeFromBool(createPolicyOutput.Policy.IsAttachable),
 		"spec.permissionsBoundaryUsageCount": core.MappingNodeFromInt(int(aws.ToInt32(createPolicyOutput.Policy.PermissionsBoundaryUsageCount))),
 		"spec.updateDate":                    core.MappingNodeFromString(createPolicyOutput.Policy.UpdateDate.Format("2006-01-02T15:04:05Z")),
+		// A new policy only has the default version created from the policy document.
+		"spec.versions": {
+			Items: []*core.MappingNode{
+				policyVersionToMappingNode(
+					aws.ToString(createPolicyOutput.Policy.DefaultVersionId),
+					true,
+					createPolicyOutput.Policy.CreateDate,
+				),
+			},
+		},
 	}
 
 	return &provider.ResourceDeployOutput{
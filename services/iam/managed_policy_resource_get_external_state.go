@@ -55,6 +55,40 @@ func (i *iamManagedPolicyResourceActions) GetExternalState(
 		"id":         core.MappingNodeFromString(aws.ToString(getPolicyOutput.Policy.PolicyId)),
 	}
 
+	// Add the policy document of the default version
+	if getPolicyOutput.Policy.DefaultVersionId != nil {
+		getPolicyVersionOutput, err := iamService.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+			PolicyArn: aws.String(arnStr),
+			VersionId: getPolicyOutput.Policy.DefaultVersionId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default version of IAM managed policy %s: %w", arnStr, err)
+		}
+
+		declaredPolicyDocument, _ := pluginutils.GetValueByPath("$.policyDocument", input.CurrentResourceSpec)
+		policyDocument, err := externalPolicyDocument(
+			declaredPolicyDocument,
+			aws.ToString(getPolicyVersionOutput.PolicyVersion.Document),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse policy document of IAM managed policy %s: %w", arnStr, err)
+		}
+		externalState["policyDocument"] = policyDocument
+	}
+
+	versions, err := listPolicyVersions(ctx, iamService, arnStr)
+	if err != nil {
+		return nil, err
+	}
+	externalState["versions"] = policyVersionsToMappingNode(versions)
+
+	// The version cap is only applied by the provider when pruning versions
+	// and is not stored in AWS, so it is carried over from the current spec.
+	maxVersions, hasMaxVersions := pluginutils.GetValueByPath("$.maxVersions", input.CurrentResourceSpec)
+	if hasMaxVersions && maxVersions != nil {
+		externalState["maxVersions"] = maxVersions
+	}
+
 	// Add optional fields if they exist
 	if getPolicyOutput.Policy.Description != nil {
 		externalState["description"] = core.MappingNodeFromString(aws.ToString(getPolicyOutput.Policy.Description))
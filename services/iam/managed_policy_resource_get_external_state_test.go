@@ -0,0 +1,225 @@
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IAMManagedPolicyResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *IAMManagedPolicyResourceGetExternalStateSuite) Test_get_external_state_iam_managed_policy() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		createManagedPolicyGetExternalStateTestCase(providerCtx, loader),
+		createManagedPolicyWithMaxVersionsGetExternalStateTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		ManagedPolicyResource,
+		&s.Suite,
+	)
+}
+
+func createManagedPolicyGetExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	currentResourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":            core.MappingNodeFromString(testManagedPolicyExternalStateARN),
+			"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+			"policyDocument": testManagedPolicyExternalStateDocument(),
+		},
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name:           "get external state managed policy",
+		ServiceFactory: createManagedPolicyExternalStateServiceMockFactory(),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID:          "test-instance-id",
+			ResourceID:          "test-managed-policy-id",
+			CurrentResourceSpec: currentResourceSpec,
+			ProviderContext:     providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: createExpectedManagedPolicyExternalStateFields(),
+			},
+		},
+	}
+}
+
+func createManagedPolicyWithMaxVersionsGetExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	currentResourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":            core.MappingNodeFromString(testManagedPolicyExternalStateARN),
+			"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+			"policyDocument": testManagedPolicyExternalStateDocument(),
+			"maxVersions":    core.MappingNodeFromInt(3),
+		},
+	}
+
+	// The version cap is not stored in AWS, it must be carried over
+	// from the current spec so that it is not reported as drift.
+	expectedFields := createExpectedManagedPolicyExternalStateFields()
+	expectedFields["maxVersions"] = core.MappingNodeFromInt(3)
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name:           "get external state managed policy with max versions",
+		ServiceFactory: createManagedPolicyExternalStateServiceMockFactory(),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID:          "test-instance-id",
+			ResourceID:          "test-managed-policy-id",
+			CurrentResourceSpec: currentResourceSpec,
+			ProviderContext:     providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: expectedFields,
+			},
+		},
+	}
+}
+
+const testManagedPolicyExternalStateARN = "arn:aws:iam::123456789012:policy/OrdersReadAccess"
+
+var testManagedPolicyExternalStateCreateDate = time.Date(2025, 3, 12, 10, 30, 0, 0, time.UTC)
+
+func createManagedPolicyExternalStateServiceMockFactory() func(
+	awsConfig *aws.Config,
+	providerContext provider.Context,
+) iamservice.Service {
+	return iammock.CreateIamServiceMockFactory(
+		iammock.WithGetPolicyOutput(&iam.GetPolicyOutput{
+			Policy: &types.Policy{
+				Arn:              aws.String(testManagedPolicyExternalStateARN),
+				PolicyId:         aws.String("ANPA1234567890EXAMPLE"),
+				PolicyName:       aws.String("OrdersReadAccess"),
+				Path:             aws.String("/"),
+				DefaultVersionId: aws.String("v2"),
+				AttachmentCount:  aws.Int32(1),
+				IsAttachable:     true,
+				CreateDate:       aws.Time(testManagedPolicyExternalStateCreateDate),
+			},
+		}),
+		iammock.WithListPolicyTagsOutput(&iam.ListPolicyTagsOutput{}),
+		iammock.WithGetPolicyVersionOutput(&iam.GetPolicyVersionOutput{
+			PolicyVersion: &types.PolicyVersion{
+				VersionId:        aws.String("v2"),
+				IsDefaultVersion: true,
+				Document: aws.String(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				),
+			},
+		}),
+		iammock.WithListPolicyVersionsOutput(&iam.ListPolicyVersionsOutput{
+			Versions: []types.PolicyVersion{
+				{
+					VersionId:        aws.String("v1"),
+					IsDefaultVersion: false,
+					CreateDate:       aws.Time(testManagedPolicyExternalStateCreateDate),
+				},
+				{
+					VersionId:        aws.String("v2"),
+					IsDefaultVersion: true,
+					CreateDate:       aws.Time(testManagedPolicyExternalStateCreateDate.Add(time.Hour)),
+				},
+			},
+		}),
+	)
+}
+
+func createExpectedManagedPolicyExternalStateFields() map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"arn":              core.MappingNodeFromString(testManagedPolicyExternalStateARN),
+		"id":               core.MappingNodeFromString("ANPA1234567890EXAMPLE"),
+		"policyName":       core.MappingNodeFromString("OrdersReadAccess"),
+		"path":             core.MappingNodeFromString("/"),
+		"policyDocument":   testManagedPolicyExternalStateDocument(),
+		"attachmentCount":  core.MappingNodeFromInt(1),
+		"createDate":       core.MappingNodeFromString("2025-03-12T10:30:00Z"),
+		"defaultVersionId": core.MappingNodeFromString("v2"),
+		"isAttachable":     core.MappingNodeFromBool(true),
+		"versions": {
+			Items: []*core.MappingNode{
+				{
+					Fields: map[string]*core.MappingNode{
+						"versionId":        core.MappingNodeFromString("v1"),
+						"isDefaultVersion": core.MappingNodeFromBool(false),
+						"createDate":       core.MappingNodeFromString("2025-03-12T10:30:00Z"),
+					},
+				},
+				{
+					Fields: map[string]*core.MappingNode{
+						"versionId":        core.MappingNodeFromString("v2"),
+						"isDefaultVersion": core.MappingNodeFromBool(true),
+						"createDate":       core.MappingNodeFromString("2025-03-12T11:30:00Z"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func testManagedPolicyExternalStateDocument() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect":   core.MappingNodeFromString("Allow"),
+							"Action":   core.MappingNodeFromString("s3:GetObject"),
+							"Resource": core.MappingNodeFromString("*"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIAMManagedPolicyResourceGetExternalState(t *testing.T) {
+	suite.Run(t, new(IAMManagedPolicyResourceGetExternalStateSuite))
+}
@@ -147,6 +147,21 @@ func iamManagedPolicyResourceSchema() *provider.ResourceDefinitionsSchema {
 				MustRecreate: true,
 				Nullable:     true,
 			},
+			"maxVersions": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The maximum number of versions to keep for the managed policy. " +
+					"When the policy document changes and the policy has reached this number of versions, " +
+					"the oldest versions that are not the default version are deleted before the new version is created.",
+				FormattedDescription: "The maximum number of versions to keep for the managed policy. " +
+					"When the policy document changes and the policy has reached this number of versions, " +
+					"the oldest versions that are not the default version are deleted before the new version is created. " +
+					"IAM allows a managed policy to have at most 5 versions, see " +
+					"[Versioning IAM policies](https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies_managed-versioning.html) in the IAM User Guide.",
+				Default:  core.MappingNodeFromInt(maxManagedPolicyVersions),
+				Minimum:  core.ScalarFromInt(2),
+				Maximum:  core.ScalarFromInt(maxManagedPolicyVersions),
+				Nullable: true,
+			},
 			"tags": {
 				Type:        provider.ResourceDefinitionsSchemaTypeArray,
 				Description: "A list of tags that are attached to the managed policy.",
@@ -213,6 +228,31 @@ func iamManagedPolicyResourceSchema() *provider.ResourceDefinitionsSchema {
 					"This is a computed field that is automatically set after the managed policy is created.",
 				Computed: true,
 			},
+			"versions": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "The versions of the managed policy ordered from the oldest to the most recent version.",
+				FormattedDescription: "The versions of the managed policy ordered from the oldest to the most recent version. " +
+					"This is a computed field that is automatically set after the managed policy is created or updated.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:  provider.ResourceDefinitionsSchemaTypeObject,
+					Label: "PolicyVersion",
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"versionId": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The identifier for the policy version, for example \"v2\".",
+						},
+						"isDefaultVersion": {
+							Type:        provider.ResourceDefinitionsSchemaTypeBoolean,
+							Description: "Whether the policy version is the default version of the policy.",
+						},
+						"createDate": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The date and time, in ISO 8601 date-time format, when the policy version was created.",
+						},
+					},
+				},
+				Computed: true,
+			},
 			"isAttachable": {
 				Type:        provider.ResourceDefinitionsSchemaTypeBoolean,
 				Description: "Specifies whether the policy can be attached to an IAM user, group, or role.",
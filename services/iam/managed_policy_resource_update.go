@@ -66,7 +66,13 @@ func (i *iamManagedPolicyResourceActions) Update(
 			return nil, err
 		}
 
+		versions, err := listPolicyVersions(ctx, iamService, arn)
+		if err != nil {
+			return nil, err
+		}
+
 		computedFields := i.extractComputedFieldsFromPolicy(getPolicyOutput.Policy)
+		computedFields["spec.versions"] = policyVersionsToMappingNode(versions)
 		return &provider.ResourceDeployOutput{
 			ComputedFieldValues: computedFields,
 		}, nil
@@ -122,6 +128,7 @@ func (i *iamManagedPolicyResourceActions) extractComputedFieldsFromCurrentState(
 		"$.defaultVersionId":              "spec.defaultVersionId",
 		"$.isAttachable":                  "spec.isAttachable",
 		"$.permissionsBoundaryUsageCount": "spec.permissionsBoundaryUsageCount",
+		"$.versions":                      "spec.versions",
 	}
 
 	for path, field := range fieldsToExtract {
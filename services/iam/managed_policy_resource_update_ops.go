@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	iampolicy "github.com/newstack-cloud/bluelink-provider-aws/services/iam/policy"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
@@ -17,6 +18,7 @@ import (
 
 type managedPolicyVersionUpdate struct {
 	policyDocument *core.MappingNode
+	maxVersions    int
 }
 
 func (m *managedPolicyVersionUpdate) Name() string {
@@ -28,12 +30,23 @@ func (m *managedPolicyVersionUpdate) Prepare(
 	specData *core.MappingNode,
 	changes *provider.Changes,
 ) (bool, pluginutils.SaveOperationContext, error) {
-	// Check if the policy document has changed
-	if policyDocNode, ok := specData.Fields["policyDocument"]; ok && policyDocNode != nil {
-		m.policyDocument = policyDocNode
-		return true, saveOpCtx, nil
+	// Check if the policy document has changed, a new version is only created
+	// when the permissions of the policy have changed, not for a different
+	// but equivalent form of the current policy document.
+	policyDocNode, ok := specData.Fields["policyDocument"]
+	if !ok || policyDocNode == nil {
+		return false, saveOpCtx, nil
 	}
-	return false, saveOpCtx, nil
+
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	currentPolicyDocNode, hasCurrent := pluginutils.GetValueByPath("$.policyDocument", currentStateSpecData)
+	if hasCurrent && iampolicy.Equal(currentPolicyDocNode, policyDocNode) {
+		return false, saveOpCtx, nil
+	}
+
+	m.policyDocument = policyDocNode
+	m.maxVersions = maxPolicyVersionsFromSpec(specData)
+	return true, saveOpCtx, nil
 }
 
 func (m *managedPolicyVersionUpdate) Execute(
@@ -49,6 +62,28 @@ func (m *managedPolicyVersionUpdate) Execute(
 		return saveOpCtx, fmt.Errorf("failed to marshal policy document: %w", err)
 	}
 
+	// Make room for the new version, IAM rejects new versions
+	// once a policy has reached the version quota.
+	versions, err := listPolicyVersions(ctx, iamService, policyArn)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	for _, version := range policyVersionsToPrune(versions, m.maxVersions) {
+		_, err = iamService.DeletePolicyVersion(ctx, &iam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: version.VersionId,
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf(
+				"failed to delete version %s of policy %s: %w",
+				aws.ToString(version.VersionId),
+				policyArn,
+				err,
+			)
+		}
+	}
+
 	// Create a new policy version
 	_, err = iamService.CreatePolicyVersion(ctx, &iam.CreatePolicyVersionInput{
 		PolicyArn:      aws.String(policyArn),
@@ -40,6 +40,8 @@ func (s *IAMManagedPolicyResourceUpdateSuite) Test_update_iam_managed_policy() {
 
 	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
 		recreateManagedPolicyOnNameOrPathChangeTestCase(providerCtx, loader),
+		skipPolicyVersionForEquivalentPolicyDocumentTestCase(providerCtx, loader),
+		pruneOldestPolicyVersionsBeforeCreatingVersionTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
@@ -200,6 +202,17 @@ func recreateManagedPolicyOnNameOrPathChangeTestCase(
 				"spec.isAttachable":                  core.MappingNodeFromBool(true),
 				"spec.permissionsBoundaryUsageCount": core.MappingNodeFromInt(0),
 				"spec.updateDate":                    core.MappingNodeFromString(timestamp),
+				"spec.versions": {
+					Items: []*core.MappingNode{
+						{
+							Fields: map[string]*core.MappingNode{
+								"versionId":        core.MappingNodeFromString("v1"),
+								"isDefaultVersion": core.MappingNodeFromBool(true),
+								"createDate":       core.MappingNodeFromString(timestamp),
+							},
+						},
+					},
+				},
 			},
 		},
 		SaveActionsCalled: map[string]any{
@@ -253,6 +266,270 @@ func recreateManagedPolicyOnNameOrPathChangeTestCase(
 	}
 }
 
+func skipPolicyVersionForEquivalentPolicyDocumentTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service] {
+	resourceARN := "arn:aws:iam::123456789012:policy/TestPolicy"
+	policyId := "ANPA1234567890123456"
+
+	service := iammock.CreateIamServiceMock()
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"policyName": core.MappingNodeFromString("TestPolicy"),
+			"policyDocument": {
+				Fields: map[string]*core.MappingNode{
+					"Version": core.MappingNodeFromString("2012-10-17"),
+					"Statement": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"Effect": core.MappingNodeFromString("Allow"),
+									"Action": {
+										Items: []*core.MappingNode{
+											core.MappingNodeFromString("s3:PutObject"),
+											core.MappingNodeFromString("s3:GetObject"),
+										},
+									},
+									"Resource": {
+										Items: []*core.MappingNode{
+											core.MappingNodeFromString("*"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn":              core.MappingNodeFromString(resourceARN),
+			"id":               core.MappingNodeFromString(policyId),
+			"defaultVersionId": core.MappingNodeFromString("v2"),
+		},
+	}
+	// The same permissions expressed with a different action order and
+	// a single resource string instead of a single-element list.
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"policyName": core.MappingNodeFromString("TestPolicy"),
+			"policyDocument": {
+				Fields: map[string]*core.MappingNode{
+					"Version": core.MappingNodeFromString("2012-10-17"),
+					"Statement": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"Effect": core.MappingNodeFromString("Allow"),
+									"Action": {
+										Items: []*core.MappingNode{
+											core.MappingNodeFromString("s3:GetObject"),
+											core.MappingNodeFromString("s3:PutObject"),
+										},
+									},
+									"Resource": core.MappingNodeFromString("*"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
+		Name: "does not create a policy version for a semantically equivalent policy document",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-policy-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-policy-id",
+					ResourceName: "TestPolicy",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-policy-id",
+						Name:       "TestPolicy",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/iam/managedPolicy",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.policyDocument",
+						PrevValue: currentStateSpecData.Fields["policyDocument"],
+						NewValue:  updatedSpecData.Fields["policyDocument"],
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":              core.MappingNodeFromString(resourceARN),
+				"spec.id":               core.MappingNodeFromString(policyId),
+				"spec.defaultVersionId": core.MappingNodeFromString("v2"),
+			},
+		},
+		SaveActionsNotCalled: []string{"CreatePolicyVersion"},
+	}
+}
+
+func pruneOldestPolicyVersionsBeforeCreatingVersionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service] {
+	resourceARN := "arn:aws:iam::123456789012:policy/TestPolicy"
+	policyId := "ANPA1234567890123456"
+	baseTime := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+	timestamp := baseTime.Format("2006-01-02T15:04:05Z")
+
+	// The versions are returned out of order to make sure that the oldest
+	// versions are determined by their creation date.
+	// v2 is the default version so it must be kept even though it is one of the oldest.
+	existingVersions := []types.PolicyVersion{
+		{VersionId: aws.String("v5"), CreateDate: aws.Time(baseTime.Add(4 * time.Hour))},
+		{VersionId: aws.String("v1"), CreateDate: aws.Time(baseTime)},
+		{VersionId: aws.String("v3"), CreateDate: aws.Time(baseTime.Add(2 * time.Hour))},
+		{VersionId: aws.String("v2"), CreateDate: aws.Time(baseTime.Add(1 * time.Hour)), IsDefaultVersion: true},
+		{VersionId: aws.String("v4"), CreateDate: aws.Time(baseTime.Add(3 * time.Hour))},
+	}
+
+	service := iammock.CreateIamServiceMock(
+		iammock.WithListPolicyVersionsOutput(&iam.ListPolicyVersionsOutput{
+			Versions: existingVersions,
+		}),
+		iammock.WithDeletePolicyVersionOutput(&iam.DeletePolicyVersionOutput{}),
+		iammock.WithCreatePolicyVersionOutput(&iam.CreatePolicyVersionOutput{
+			PolicyVersion: &types.PolicyVersion{
+				VersionId:        aws.String("v6"),
+				IsDefaultVersion: true,
+			},
+		}),
+		iammock.WithGetPolicyOutput(&iam.GetPolicyOutput{
+			Policy: &types.Policy{
+				Arn:              aws.String(resourceARN),
+				PolicyId:         aws.String(policyId),
+				PolicyName:       aws.String("TestPolicy"),
+				CreateDate:       aws.Time(baseTime),
+				UpdateDate:       aws.Time(baseTime),
+				AttachmentCount:  aws.Int32(1),
+				DefaultVersionId: aws.String("v6"),
+				IsAttachable:     true,
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"policyName":     core.MappingNodeFromString("TestPolicy"),
+			"policyDocument": policyDocumentWithResources(1),
+			"arn":            core.MappingNodeFromString(resourceARN),
+			"id":             core.MappingNodeFromString(policyId),
+		},
+	}
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"policyName":     core.MappingNodeFromString("TestPolicy"),
+			"policyDocument": policyDocumentWithResources(2),
+			"maxVersions":    core.MappingNodeFromInt(4),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
+		Name: "deletes the oldest non-default policy versions before creating a new policy version",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-policy-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-policy-id",
+					ResourceName: "TestPolicy",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-policy-id",
+						Name:       "TestPolicy",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/iam/managedPolicy",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.policyDocument",
+						PrevValue: currentStateSpecData.Fields["policyDocument"],
+						NewValue:  updatedSpecData.Fields["policyDocument"],
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":              core.MappingNodeFromString(resourceARN),
+				"spec.id":               core.MappingNodeFromString(policyId),
+				"spec.attachmentCount":  core.MappingNodeFromInt(1),
+				"spec.createDate":       core.MappingNodeFromString(timestamp),
+				"spec.defaultVersionId": core.MappingNodeFromString("v6"),
+				"spec.isAttachable":     core.MappingNodeFromBool(true),
+				"spec.updateDate":       core.MappingNodeFromString(timestamp),
+				// The mock returns the versions from before the update,
+				// the versions are ordered from oldest to most recent.
+				"spec.versions": policyVersionsToMappingNode([]types.PolicyVersion{
+					existingVersions[1],
+					existingVersions[3],
+					existingVersions[2],
+					existingVersions[4],
+					existingVersions[0],
+				}),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"DeletePolicyVersion": []any{
+				&iam.DeletePolicyVersionInput{
+					PolicyArn: aws.String(resourceARN),
+					VersionId: aws.String("v1"),
+				},
+				&iam.DeletePolicyVersionInput{
+					PolicyArn: aws.String(resourceARN),
+					VersionId: aws.String("v3"),
+				},
+			},
+		},
+	}
+}
+
 func TestIAMManagedPolicyResourceUpdate(t *testing.T) {
 	suite.Run(t, new(IAMManagedPolicyResourceUpdateSuite))
 }
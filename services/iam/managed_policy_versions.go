@@ -0,0 +1,124 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// IAM allows a managed policy to have at most 5 versions,
+// see: https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies_managed-versioning.html
+const maxManagedPolicyVersions = 5
+
+// listPolicyVersions retrieves all versions of a managed policy
+// ordered from the oldest to the most recent version.
+func listPolicyVersions(
+	ctx context.Context,
+	iamService iamservice.Service,
+	policyArn string,
+) ([]types.PolicyVersion, error) {
+	versions := []types.PolicyVersion{}
+	var marker *string
+	for {
+		output, err := iamService.ListPolicyVersions(ctx, &iam.ListPolicyVersionsInput{
+			PolicyArn: aws.String(policyArn),
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of IAM managed policy %s: %w", policyArn, err)
+		}
+
+		versions = append(versions, output.Versions...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	slices.SortStableFunc(versions, func(a, b types.PolicyVersion) int {
+		return aws.ToTime(a.CreateDate).Compare(aws.ToTime(b.CreateDate))
+	})
+	return versions, nil
+}
+
+// policyVersionsToPrune selects the versions that must be deleted
+// to make room for a new version without exceeding the version cap.
+// The oldest versions are deleted first, the default version is never deleted.
+func policyVersionsToPrune(
+	versions []types.PolicyVersion,
+	maxVersions int,
+) []types.PolicyVersion {
+	// Keep room for the version that is about to be created.
+	toPruneCount := len(versions) - (maxVersions - 1)
+	if toPruneCount <= 0 {
+		return nil
+	}
+
+	toPrune := []types.PolicyVersion{}
+	for _, version := range versions {
+		if len(toPrune) == toPruneCount {
+			break
+		}
+
+		if !version.IsDefaultVersion {
+			toPrune = append(toPrune, version)
+		}
+	}
+	return toPrune
+}
+
+// maxPolicyVersionsFromSpec returns the version cap configured
+// for a managed policy, falling back to the IAM quota.
+func maxPolicyVersionsFromSpec(specData *core.MappingNode) int {
+	maxVersions, hasMaxVersions := pluginutils.GetValueByPath("$.maxVersions", specData)
+	if !hasMaxVersions {
+		return maxManagedPolicyVersions
+	}
+
+	maxVersionsInt := core.IntValue(maxVersions)
+	if maxVersionsInt < 2 || maxVersionsInt > maxManagedPolicyVersions {
+		return maxManagedPolicyVersions
+	}
+	return maxVersionsInt
+}
+
+func policyVersionsToMappingNode(versions []types.PolicyVersion) *core.MappingNode {
+	items := make([]*core.MappingNode, 0, len(versions))
+	for _, version := range versions {
+		items = append(items, policyVersionToMappingNode(
+			aws.ToString(version.VersionId),
+			version.IsDefaultVersion,
+			version.CreateDate,
+		))
+	}
+
+	return &core.MappingNode{
+		Items: items,
+	}
+}
+
+func policyVersionToMappingNode(
+	versionID string,
+	isDefaultVersion bool,
+	createDate *time.Time,
+) *core.MappingNode {
+	fields := map[string]*core.MappingNode{
+		"versionId":        core.MappingNodeFromString(versionID),
+		"isDefaultVersion": core.MappingNodeFromBool(isDefaultVersion),
+	}
+	if createDate != nil {
+		fields["createDate"] = core.MappingNodeFromString(createDate.Format("2006-01-02T15:04:05Z"))
+	}
+
+	return &core.MappingNode{
+		Fields: fields,
+	}
+}
@@ -1,8 +1,7 @@
 package iam
 
 import (
-	"encoding/json"
-
+	iampolicy "github.com/newstack-cloud/bluelink-provider-aws/services/iam/policy"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 )
 
@@ -52,15 +51,50 @@ func diffIAMPolicies(currentPolicies *core.MappingNode, newPolicies *core.Mappin
 }
 
 // Helper function to compare policy documents.
-// A simple serialised JSON comparison is used to compare the policy documents
-// in the current implementation.
+// Policy documents are compared semantically so that equivalent forms
+// of the same policy, such as a string or a single-element array for an action,
+// are not treated as changes.
 func policiesEqual(policy1, policy2 *core.MappingNode) bool {
-	doc1JSON, err1 := json.Marshal(policy1.Fields["policyDocument"])
-	doc2JSON, err2 := json.Marshal(policy2.Fields["policyDocument"])
+	return iampolicy.Equal(policy1.Fields["policyDocument"], policy2.Fields["policyDocument"])
+}
+
+// externalPolicyDocument converts a policy document retrieved from the IAM API
+// into a mapping node for the external state of a resource.
+// When the policy document is semantically equal to the policy document
+// declared in the current resource spec, the declared policy document is used
+// so that drift is only reported for changes to the permissions of the policy.
+func externalPolicyDocument(
+	declaredPolicyDocument *core.MappingNode,
+	policyDocument string,
+) (*core.MappingNode, error) {
+	parsed, err := iampolicy.Parse(policyDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	if declaredPolicyDocument != nil &&
+		declaredPolicyDocument.Fields != nil &&
+		iampolicy.Equal(declaredPolicyDocument, parsed) {
+		return declaredPolicyDocument, nil
+	}
+
+	return convertInterfaceToMappingNode(parsed)
+}
 
-	if err1 != nil || err2 != nil {
-		return false
+// declaredInlinePolicyDocuments returns the inline policy documents in a resource spec
+// keyed by policy name.
+func declaredInlinePolicyDocuments(resourceSpec *core.MappingNode) map[string]*core.MappingNode {
+	documents := map[string]*core.MappingNode{}
+	if resourceSpec == nil || resourceSpec.Fields["policies"] == nil {
+		return documents
 	}
 
-	return string(doc1JSON) == string(doc2JSON)
+	for _, policy := range resourceSpec.Fields["policies"].Items {
+		if policy == nil {
+			continue
+		}
+		policyName := core.StringValue(policy.Fields["policyName"])
+		documents[policyName] = policy.Fields["policyDocument"]
+	}
+	return documents
 }
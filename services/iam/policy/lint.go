@@ -0,0 +1,296 @@
+package iampolicy
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+var (
+	// An action is made up of a service prefix and an action name
+	// that can contain wildcards, for example "s3:Get*".
+	actionPattern = regexp.MustCompile(`^(\*|[A-Za-z0-9-]+):[A-Za-z0-9*?]+$`)
+	// Partitions include "aws", "aws-cn", "aws-us-gov" and the isolated
+	// partitions such as "aws-iso-b".
+	arnPartitionPattern = regexp.MustCompile(`^(\*|aws(-[a-z]+)*)$`)
+	arnServicePattern   = regexp.MustCompile(`^(\*|[a-z0-9*?-]+)$`)
+)
+
+// conditionOperators holds the base condition operators that can be used in
+// the condition block of a policy statement,
+// see: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+var conditionOperators = []string{
+	"StringEquals",
+	"StringNotEquals",
+	"StringEqualsIgnoreCase",
+	"StringNotEqualsIgnoreCase",
+	"StringLike",
+	"StringNotLike",
+	"NumericEquals",
+	"NumericNotEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"DateEquals",
+	"DateNotEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"Bool",
+	"BinaryEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"Null",
+}
+
+// Lint checks a policy document defined in a blueprint for mistakes that
+// would cause the policy to be rejected by IAM or to grant
+// more access than intended.
+// Diagnostics are reported with the source range of the offending value
+// where it is available.
+// Values that contain substitutions are skipped as they are not known
+// at the validation stage.
+func Lint(path string, document *core.MappingNode) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	if document == nil || document.Fields == nil {
+		return diagnostics
+	}
+
+	statementsNode, hasStatements := document.Fields["Statement"]
+	if !hasStatements || statementsNode == nil {
+		return diagnostics
+	}
+
+	statementsPath := fmt.Sprintf("%s.Statement", path)
+	if statementsNode.Fields != nil {
+		// A policy document can contain a single statement
+		// instead of a list of statements.
+		return lintStatement(statementsPath, statementsNode)
+	}
+
+	for i, statement := range statementsNode.Items {
+		diagnostics = append(
+			diagnostics,
+			lintStatement(fmt.Sprintf("%s[%d]", statementsPath, i), statement)...,
+		)
+	}
+	return diagnostics
+}
+
+// Size returns the number of characters in a policy document that count
+// towards IAM policy size quotas, IAM does not count white space.
+// The document can be any of the forms accepted by Normalise,
+// values that contain substitutions are counted in their unresolved form.
+func Size(document any) (int, error) {
+	decoded, err := decode(document)
+	if err != nil {
+		return 0, err
+	}
+
+	serialised, err := marshal(decoded)
+	if err != nil {
+		return 0, err
+	}
+
+	size := 0
+	for _, char := range serialised {
+		if !unicode.IsSpace(char) {
+			size++
+		}
+	}
+	return size, nil
+}
+
+func lintStatement(path string, statement *core.MappingNode) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	if statement == nil || statement.Fields == nil {
+		return diagnostics
+	}
+
+	for _, field := range []string{"Action", "NotAction"} {
+		diagnostics = append(
+			diagnostics,
+			lintActions(fmt.Sprintf("%s.%s", path, field), statement.Fields[field])...,
+		)
+	}
+
+	for _, field := range []string{"Resource", "NotResource"} {
+		diagnostics = append(
+			diagnostics,
+			lintResources(fmt.Sprintf("%s.%s", path, field), statement.Fields[field])...,
+		)
+	}
+
+	diagnostics = append(
+		diagnostics,
+		lintConditionOperators(fmt.Sprintf("%s.Condition", path), statement.Fields["Condition"])...,
+	)
+
+	effect := core.StringValue(statement.Fields["Effect"])
+	if effect != "Allow" {
+		return diagnostics
+	}
+
+	if notAction, hasNotAction := statement.Fields["NotAction"]; hasNotAction && notAction != nil {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"The statement in %s uses NotAction with the Allow effect, this allows all actions "+
+					"that are not listed, including actions for services that are added in the future. "+
+					"Consider listing the allowed actions with Action instead.",
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(notAction.SourceMeta, nil),
+		})
+	}
+
+	for _, action := range stringValues(statement.Fields["Action"]) {
+		actionValue := core.StringValue(action)
+		if actionValue == "*" || actionValue == "*:*" {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelWarning,
+				Message: fmt.Sprintf(
+					"The statement in %s allows the %q action which grants full administrator access "+
+						"to all services, consider granting only the actions that are needed.",
+					path,
+					actionValue,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(action.SourceMeta, nil),
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+func lintActions(path string, actions *core.MappingNode) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	for _, action := range stringValues(actions) {
+		actionValue := core.StringValue(action)
+		if actionValue == "*" || actionPattern.MatchString(actionValue) {
+			continue
+		}
+
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The action %q in %s is not valid, actions must be in the form "+
+					"\"<service-prefix>:<action-name>\", for example \"s3:GetObject\".",
+				actionValue,
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(action.SourceMeta, nil),
+		})
+	}
+	return diagnostics
+}
+
+func lintResources(path string, resources *core.MappingNode) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	for _, resource := range stringValues(resources) {
+		resourceValue := core.StringValue(resource)
+		if resourceValue == "*" || isValidResourceARN(resourceValue) {
+			continue
+		}
+
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The resource %q in %s is not a valid ARN, resources must be \"*\" or an ARN in the form "+
+					"\"arn:<partition>:<service>:<region>:<account-id>:<resource>\".",
+				resourceValue,
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(resource.SourceMeta, nil),
+		})
+	}
+	return diagnostics
+}
+
+func isValidResourceARN(arn string) bool {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return false
+	}
+
+	return arnPartitionPattern.MatchString(parts[1]) &&
+		arnServicePattern.MatchString(parts[2]) &&
+		parts[5] != ""
+}
+
+func lintConditionOperators(path string, condition *core.MappingNode) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	if condition == nil || condition.Fields == nil {
+		return diagnostics
+	}
+
+	// Sort the operators so that diagnostics are reported in a consistent order.
+	operators := make([]string, 0, len(condition.Fields))
+	for operator := range condition.Fields {
+		operators = append(operators, operator)
+	}
+	slices.Sort(operators)
+
+	for _, operator := range operators {
+		if isValidConditionOperator(operator) {
+			continue
+		}
+
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The condition operator %q in %s is not a known IAM condition operator.",
+				operator,
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(condition.Fields[operator].SourceMeta, nil),
+		})
+	}
+	return diagnostics
+}
+
+func isValidConditionOperator(operator string) bool {
+	baseOperator := operator
+	for _, setOperator := range []string{"ForAllValues:", "ForAnyValue:"} {
+		baseOperator = strings.TrimPrefix(baseOperator, setOperator)
+	}
+
+	baseOperator, hasIfExists := strings.CutSuffix(baseOperator, "IfExists")
+	if hasIfExists && baseOperator == "Null" {
+		// The Null operator checks whether a key is present
+		// so can not be combined with IfExists.
+		return false
+	}
+
+	return slices.Contains(conditionOperators, baseOperator)
+}
+
+// stringValues returns the string values of a field that can hold
+// a single string or a list of strings, skipping values with substitutions.
+func stringValues(node *core.MappingNode) []*core.MappingNode {
+	if node == nil {
+		return nil
+	}
+
+	values := []*core.MappingNode{}
+	if core.IsScalarString(node.Scalar) {
+		return append(values, node)
+	}
+
+	for _, item := range node.Items {
+		if item != nil && core.IsScalarString(item.Scalar) {
+			values = append(values, item)
+		}
+	}
+	return values
+}
@@ -0,0 +1,167 @@
+package iampolicy
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type LintSuite struct {
+	suite.Suite
+}
+
+func (s *LintSuite) Test_lint() {
+	testCases := []struct {
+		name             string
+		document         string
+		expectedLevels   []core.DiagnosticLevel
+		expectedMessages []string
+	}{
+		{
+			name: "accepts a valid policy document",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":["s3:GetObject","s3:List*"],` +
+				`"Resource":["arn:aws:s3:::bucket/*","arn:aws-us-gov:s3:::bucket"],` +
+				`"Condition":{"ForAnyValue:StringLikeIfExists":{"s3:prefix":["home/*"]},"Null":{"aws:TokenIssueTime":"true"}}},` +
+				`{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`,
+		},
+		{
+			name: "accepts a single statement",
+			document: `{"Version":"2012-10-17","Statement":` +
+				`{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}}`,
+		},
+		{
+			name: "reports invalid actions",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":["s3GetObject","s3:Get Object"],"Resource":"*"}]}`,
+			expectedLevels: []core.DiagnosticLevel{core.DiagnosticLevelError, core.DiagnosticLevelError},
+			expectedMessages: []string{
+				"The action \"s3GetObject\" in $.policyDocument.Statement[0].Action is not valid, actions must be in the form " +
+					"\"<service-prefix>:<action-name>\", for example \"s3:GetObject\".",
+				"The action \"s3:Get Object\" in $.policyDocument.Statement[0].Action is not valid, actions must be in the form " +
+					"\"<service-prefix>:<action-name>\", for example \"s3:GetObject\".",
+			},
+		},
+		{
+			name: "reports malformed resource ARNs",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::","bucket/*","arn:amazon:s3:::bucket"]}]}`,
+			expectedLevels: []core.DiagnosticLevel{
+				core.DiagnosticLevelError, core.DiagnosticLevelError, core.DiagnosticLevelError,
+			},
+			expectedMessages: []string{
+				"The resource \"arn:aws:s3:::\" in $.policyDocument.Statement[0].Resource is not a valid ARN, " +
+					"resources must be \"*\" or an ARN in the form \"arn:<partition>:<service>:<region>:<account-id>:<resource>\".",
+				"The resource \"bucket/*\" in $.policyDocument.Statement[0].Resource is not a valid ARN, " +
+					"resources must be \"*\" or an ARN in the form \"arn:<partition>:<service>:<region>:<account-id>:<resource>\".",
+				"The resource \"arn:amazon:s3:::bucket\" in $.policyDocument.Statement[0].Resource is not a valid ARN, " +
+					"resources must be \"*\" or an ARN in the form \"arn:<partition>:<service>:<region>:<account-id>:<resource>\".",
+			},
+		},
+		{
+			name: "reports unknown condition operators",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",` +
+				`"Condition":{"StringEqual":{"aws:PrincipalTag/team":"a"},"NullIfExists":{"aws:TokenIssueTime":"true"}}}]}`,
+			expectedLevels: []core.DiagnosticLevel{core.DiagnosticLevelError, core.DiagnosticLevelError},
+			expectedMessages: []string{
+				"The condition operator \"NullIfExists\" in $.policyDocument.Statement[0].Condition " +
+					"is not a known IAM condition operator.",
+				"The condition operator \"StringEqual\" in $.policyDocument.Statement[0].Condition " +
+					"is not a known IAM condition operator.",
+			},
+		},
+		{
+			name: "warns about NotAction with the Allow effect",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`,
+			expectedLevels: []core.DiagnosticLevel{core.DiagnosticLevelWarning},
+			expectedMessages: []string{
+				"The statement in $.policyDocument.Statement[0] uses NotAction with the Allow effect, this allows all actions " +
+					"that are not listed, including actions for services that are added in the future. " +
+					"Consider listing the allowed actions with Action instead.",
+			},
+		},
+		{
+			name: "warns about administrator access grants",
+			document: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"*:*","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			expectedLevels: []core.DiagnosticLevel{core.DiagnosticLevelWarning},
+			expectedMessages: []string{
+				"The statement in $.policyDocument.Statement[0] allows the \"*:*\" action which grants full " +
+					"administrator access to all services, consider granting only the actions that are needed.",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			document := &core.MappingNode{}
+			s.Require().NoError(json.Unmarshal([]byte(testCase.document), document))
+
+			diagnostics := Lint("$.policyDocument", document)
+
+			levels := []core.DiagnosticLevel{}
+			messages := []string{}
+			for _, diagnostic := range diagnostics {
+				levels = append(levels, diagnostic.Level)
+				messages = append(messages, diagnostic.Message)
+			}
+			s.Equal(len(testCase.expectedMessages), len(messages))
+			if len(testCase.expectedMessages) > 0 {
+				s.Equal(testCase.expectedLevels, levels)
+				s.Equal(testCase.expectedMessages, messages)
+			}
+		})
+	}
+}
+
+func (s *LintSuite) Test_lint_reports_source_range_of_value() {
+	action := core.MappingNodeFromString("s3-GetObject")
+	action.SourceMeta = &source.Meta{Position: source.Position{Line: 14, Column: 19}}
+	document := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect": core.MappingNodeFromString("Allow"),
+							"Action": {
+								Items: []*core.MappingNode{action},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diagnostics := Lint("$.policyDocument", document)
+	s.Require().Len(diagnostics, 1)
+	s.Equal(14, diagnostics[0].Range.Start.Line)
+	s.Equal(19, diagnostics[0].Range.Start.Column)
+}
+
+func (s *LintSuite) Test_size_excludes_white_space() {
+	document := &core.MappingNode{}
+	s.Require().NoError(json.Unmarshal([]byte(`{
+		"Version": "2012-10-17",
+		"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/a b&c"}]
+	}`), document))
+
+	size, err := Size(document)
+	s.Require().NoError(err)
+
+	expectedDocument := `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow",` +
+		`"Resource":"arn:aws:s3:::bucket/a b&c"}],"Version":"2012-10-17"}`
+	s.Equal(len(strings.ReplaceAll(expectedDocument, " ", "")), size)
+}
+
+func TestLintSuite(t *testing.T) {
+	suite.Run(t, new(LintSuite))
+}
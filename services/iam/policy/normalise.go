@@ -0,0 +1,317 @@
+// Package iampolicy provides semantic normalisation, comparison
+// and linting of IAM policy documents.
+//
+// IAM accepts many equivalent forms of the same policy, a single string or a
+// single-element array for an action, statements and actions in any order and
+// condition values as strings, numbers or booleans.
+// The IAM API also returns policy documents URL-encoded and may rewrite them
+// into one of the equivalent forms, so comparing the serialised JSON of a
+// declared policy with a policy retrieved from AWS reports changes that do not
+// affect the permissions that the policy grants.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// listStatementFields are the statement fields that hold a string or
+// a list of strings where the order of the values is not significant.
+var listStatementFields = []string{
+	"Action",
+	"NotAction",
+	"Resource",
+	"NotResource",
+}
+
+// Parse decodes a policy document as returned by the IAM API,
+// URL-decoding the document first when it is URL-encoded.
+func Parse(document string) (any, error) {
+	trimmed := strings.TrimSpace(document)
+	if !strings.HasPrefix(trimmed, "{") {
+		// The IAM API returns policy documents URL-encoded
+		// in compliance with RFC 3986, path unescaping is used so that
+		// "+" is not treated as an encoded space.
+		decoded, err := url.PathUnescape(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode policy document: %w", err)
+		}
+		trimmed = decoded
+	}
+
+	var parsed any
+	if err := json.Unmarshal([]byte(trimmed), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse policy document: %w", err)
+	}
+	return parsed, nil
+}
+
+// Normalise converts a policy document into a canonical form where
+// equivalent policies have the same structure.
+// The document can be a JSON string (optionally URL-encoded), a value
+// decoded from JSON or any value that can be serialised to JSON,
+// such as a *core.MappingNode.
+//
+// In the canonical form:
+//   - Statement is always a list of statements, ordered by their canonical JSON.
+//   - Action, NotAction, Resource and NotResource are sorted lists of unique values,
+//     actions are lower case as IAM action names are case-insensitive.
+//   - A wildcard principal "*" is expressed as {"AWS": ["*"]} and
+//     principal values are sorted lists of unique values.
+//   - Condition values are sorted lists of unique strings.
+func Normalise(document any) (map[string]any, error) {
+	decoded, err := decode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, isObject := decoded.(map[string]any)
+	if !isObject {
+		return nil, fmt.Errorf("policy document must be a JSON object")
+	}
+
+	normalised := make(map[string]any, len(policy))
+	for key, value := range policy {
+		if key != "Statement" {
+			normalised[key] = value
+			continue
+		}
+
+		statements, err := normaliseStatements(value)
+		if err != nil {
+			return nil, err
+		}
+		normalised[key] = statements
+	}
+
+	return normalised, nil
+}
+
+// Canonical returns the canonical JSON serialisation of a policy document,
+// two policy documents that grant the same permissions in different forms
+// have the same canonical JSON.
+func Canonical(document any) (string, error) {
+	normalised, err := Normalise(document)
+	if err != nil {
+		return "", err
+	}
+
+	return marshal(normalised)
+}
+
+// Equal determines whether two policy documents are semantically equal.
+// Documents that can not be parsed are never considered equal.
+func Equal(documentA any, documentB any) bool {
+	canonicalA, errA := Canonical(documentA)
+	canonicalB, errB := Canonical(documentB)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return canonicalA == canonicalB
+}
+
+func decode(document any) (any, error) {
+	switch value := document.(type) {
+	case nil:
+		return nil, fmt.Errorf("policy document is empty")
+	case string:
+		return Parse(value)
+	case []byte:
+		return Parse(string(value))
+	case map[string]any:
+		return value, nil
+	}
+
+	serialised, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialise policy document: %w", err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(serialised, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to parse policy document: %w", err)
+	}
+
+	// A policy document can be provided as a JSON string
+	// in a mapping node.
+	if documentString, isString := decoded.(string); isString {
+		return Parse(documentString)
+	}
+	return decoded, nil
+}
+
+func normaliseStatements(value any) ([]any, error) {
+	statements, isList := value.([]any)
+	if !isList {
+		statements = []any{value}
+	}
+
+	type canonicalStatement struct {
+		statement map[string]any
+		canonical string
+	}
+	canonicalStatements := make([]canonicalStatement, 0, len(statements))
+	for i, rawStatement := range statements {
+		statement, isObject := rawStatement.(map[string]any)
+		if !isObject {
+			return nil, fmt.Errorf("statement %d must be a JSON object", i)
+		}
+
+		normalised := normaliseStatement(statement)
+		canonical, err := marshal(normalised)
+		if err != nil {
+			return nil, err
+		}
+		canonicalStatements = append(canonicalStatements, canonicalStatement{normalised, canonical})
+	}
+
+	slices.SortFunc(canonicalStatements, func(a, b canonicalStatement) int {
+		return strings.Compare(a.canonical, b.canonical)
+	})
+
+	normalised := make([]any, 0, len(canonicalStatements))
+	for _, statement := range canonicalStatements {
+		normalised = append(normalised, statement.statement)
+	}
+	return normalised, nil
+}
+
+func normaliseStatement(statement map[string]any) map[string]any {
+	normalised := make(map[string]any, len(statement))
+	for key, value := range statement {
+		switch {
+		case key == "Action" || key == "NotAction":
+			normalised[key] = normaliseActions(value)
+		case slices.Contains(listStatementFields, key):
+			normalised[key] = normaliseStringSet(value)
+		case key == "Principal" || key == "NotPrincipal":
+			normalised[key] = normalisePrincipal(value)
+		case key == "Condition":
+			normalised[key] = normaliseCondition(value)
+		default:
+			normalised[key] = value
+		}
+	}
+	return normalised
+}
+
+func normaliseActions(value any) any {
+	actions := normaliseStringSet(value)
+	list, isList := actions.([]any)
+	if !isList {
+		return actions
+	}
+
+	lowerCaseActions := make([]string, 0, len(list))
+	for _, action := range list {
+		lowerCaseActions = append(lowerCaseActions, strings.ToLower(action.(string)))
+	}
+	return toSortedSet(lowerCaseActions)
+}
+
+func normalisePrincipal(value any) any {
+	if value == "*" {
+		return map[string]any{
+			"AWS": []any{"*"},
+		}
+	}
+
+	principal, isObject := value.(map[string]any)
+	if !isObject {
+		return value
+	}
+
+	normalised := make(map[string]any, len(principal))
+	for principalType, principalValue := range principal {
+		normalised[principalType] = normaliseStringSet(principalValue)
+	}
+	return normalised
+}
+
+func normaliseCondition(value any) any {
+	condition, isObject := value.(map[string]any)
+	if !isObject {
+		return value
+	}
+
+	normalised := make(map[string]any, len(condition))
+	for operator, rawKeys := range condition {
+		keys, isObject := rawKeys.(map[string]any)
+		if !isObject {
+			normalised[operator] = rawKeys
+			continue
+		}
+
+		normalisedKeys := make(map[string]any, len(keys))
+		for key, keyValue := range keys {
+			normalisedKeys[key] = normaliseStringSet(keyValue)
+		}
+		normalised[operator] = normalisedKeys
+	}
+	return normalised
+}
+
+// normaliseStringSet converts a scalar or a list of scalars into a sorted
+// list of unique strings.
+// Values that are not scalars or lists of scalars are returned as they are
+// so that they are still compared as part of the policy document.
+func normaliseStringSet(value any) any {
+	list, isList := value.([]any)
+	if !isList {
+		list = []any{value}
+	}
+
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		stringValue, isScalar := scalarToString(item)
+		if !isScalar {
+			return value
+		}
+		values = append(values, stringValue)
+	}
+
+	return toSortedSet(values)
+}
+
+func scalarToString(value any) (string, bool) {
+	switch scalar := value.(type) {
+	case string:
+		return scalar, true
+	case bool:
+		return strconv.FormatBool(scalar), true
+	case float64:
+		return strconv.FormatFloat(scalar, 'f', -1, 64), true
+	case json.Number:
+		return scalar.String(), true
+	}
+	return "", false
+}
+
+func toSortedSet(values []string) []any {
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	set := make([]any, 0, len(values))
+	for _, value := range values {
+		set = append(set, value)
+	}
+	return set
+}
+
+// marshal serialises a value to JSON without escaping HTML characters
+// such as "&" and "<" which are valid in policy documents.
+func marshal(value any) (string, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", fmt.Errorf("failed to serialise policy document: %w", err)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
@@ -0,0 +1,184 @@
+package iampolicy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type NormaliseSuite struct {
+	suite.Suite
+}
+
+func (s *NormaliseSuite) Test_equal_for_semantically_equivalent_documents() {
+	testCases := []struct {
+		name      string
+		documentA any
+		documentB any
+	}{
+		{
+			name: "single string action and single-element action list",
+			documentA: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			documentB: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+		},
+		{
+			name: "reordered and duplicated actions with different casing",
+			documentA: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			documentB: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":["s3:getobject","s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name: "reordered statements",
+			documentA: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},` +
+				`{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			documentB: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name: "single statement object and statement list",
+			documentA: `{"Version":"2012-10-17","Statement":` +
+				`{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}}`,
+			documentB: `{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+		{
+			name: "URL-encoded document returned by the IAM API",
+			documentA: url.PathEscape(`{"Version":"2012-10-17","Statement":[` +
+				`{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`),
+			documentB: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"Version": core.MappingNodeFromString("2012-10-17"),
+					"Statement": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"Effect": core.MappingNodeFromString("Allow"),
+									"Principal": {
+										Fields: map[string]*core.MappingNode{
+											"Service": {
+												Items: []*core.MappingNode{
+													core.MappingNodeFromString("lambda.amazonaws.com"),
+												},
+											},
+										},
+									},
+									"Action": core.MappingNodeFromString("sts:AssumeRole"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "wildcard principal forms",
+			documentA: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage"}]}`,
+			documentB: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"sqs:SendMessage"}]}`,
+		},
+		{
+			name: "condition values as booleans, numbers and strings",
+			documentA: `{"Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*",` +
+				`"Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:max-keys":10}}}]}`,
+			documentB: `{"Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*",` +
+				`"Condition":{"Bool":{"aws:SecureTransport":["false"]},"NumericLessThan":{"s3:max-keys":"10"}}}]}`,
+		},
+		{
+			name: "reordered condition values",
+			documentA: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",` +
+				`"Condition":{"StringEquals":{"aws:PrincipalTag/team":["b","a"]}}}]}`,
+			documentB: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",` +
+				`"Condition":{"StringEquals":{"aws:PrincipalTag/team":["a","b"]}}}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.True(Equal(tc.documentA, tc.documentB))
+		})
+	}
+}
+
+func (s *NormaliseSuite) Test_not_equal_for_permission_changes() {
+	testCases := []struct {
+		name      string
+		documentA string
+		documentB string
+	}{
+		{
+			name:      "added action",
+			documentA: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			documentB: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:      "changed effect",
+			documentA: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			documentB: `{"Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:      "resource ARNs are case-sensitive",
+			documentA: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Bucket/*"}]}`,
+			documentB: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		{
+			name: "changed condition value",
+			documentA: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",` +
+				`"Condition":{"StringEquals":{"aws:PrincipalTag/team":"a"}}}]}`,
+			documentB: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",` +
+				`"Condition":{"StringEquals":{"aws:PrincipalTag/team":"b"}}}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.False(Equal(tc.documentA, tc.documentB))
+		})
+	}
+}
+
+func (s *NormaliseSuite) Test_not_equal_for_invalid_documents() {
+	s.False(Equal(`{"Statement":`, `{"Statement":`))
+	s.False(Equal(nil, nil))
+	s.False(Equal(`["not", "a", "policy"]`, `["not", "a", "policy"]`))
+}
+
+func (s *NormaliseSuite) Test_canonical() {
+	canonical, err := Canonical(
+		`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],` +
+			`"Resource":"arn:aws:s3:::bucket/*","Condition":{"StringLike":{"s3:prefix":"home/&"}}}}`,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		`{"Statement":[{"Action":["s3:getobject","s3:putobject"],`+
+			`"Condition":{"StringLike":{"s3:prefix":["home/&"]}},`+
+			`"Effect":"Allow","Resource":["arn:aws:s3:::bucket/*"]}],"Version":"2012-10-17"}`,
+		canonical,
+	)
+}
+
+func (s *NormaliseSuite) Test_parse_url_encoded_document() {
+	parsed, err := Parse(url.PathEscape(`{"Statement":[{"Condition":{"StringLike":{"aws:userid":"a+b"}}}]}`))
+	s.Require().NoError(err)
+	s.Equal(
+		map[string]any{
+			"Statement": []any{
+				map[string]any{
+					"Condition": map[string]any{
+						"StringLike": map[string]any{"aws:userid": "a+b"},
+					},
+				},
+			},
+		},
+		parsed,
+	)
+}
+
+func TestNormaliseSuite(t *testing.T) {
+	suite.Run(t, new(NormaliseSuite))
+}
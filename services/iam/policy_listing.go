@@ -0,0 +1,175 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+)
+
+// listRolePolicyNames lists the names of all of the inline policies of a role,
+// following the pagination markers until every page has been retrieved.
+func listRolePolicyNames(
+	ctx context.Context,
+	iamService iamservice.Service,
+	roleName string,
+) ([]string, error) {
+	policyNames := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListRolePolicies(ctx, &iam.ListRolePoliciesInput{
+			RoleName: aws.String(roleName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list inline policies for role %s: %w", roleName, err)
+		}
+
+		policyNames = append(policyNames, output.PolicyNames...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyNames, nil
+}
+
+// listAttachedRolePolicyArns lists the ARNs of all of the managed policies
+// attached to a role, following the pagination markers until every page
+// has been retrieved.
+func listAttachedRolePolicyArns(
+	ctx context.Context,
+	iamService iamservice.Service,
+	roleName string,
+) ([]string, error) {
+	policyArns := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attached policies for role %s: %w", roleName, err)
+		}
+
+		policyArns = append(policyArns, attachedPolicyARNs(output.AttachedPolicies)...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyArns, nil
+}
+
+// listUserPolicyNames lists the names of all of the inline policies of a user,
+// following the pagination markers until every page has been retrieved.
+func listUserPolicyNames(
+	ctx context.Context,
+	iamService iamservice.Service,
+	userName string,
+) ([]string, error) {
+	policyNames := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListUserPolicies(ctx, &iam.ListUserPoliciesInput{
+			UserName: aws.String(userName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list inline policies for user %s: %w", userName, err)
+		}
+
+		policyNames = append(policyNames, output.PolicyNames...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyNames, nil
+}
+
+// listAttachedUserPolicyArns lists the ARNs of all of the managed policies
+// attached to a user, following the pagination markers until every page
+// has been retrieved.
+func listAttachedUserPolicyArns(
+	ctx context.Context,
+	iamService iamservice.Service,
+	userName string,
+) ([]string, error) {
+	policyArns := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{
+			UserName: aws.String(userName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attached policies for user %s: %w", userName, err)
+		}
+
+		policyArns = append(policyArns, attachedPolicyARNs(output.AttachedPolicies)...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyArns, nil
+}
+
+// listGroupPolicyNames lists the names of all of the inline policies of a group,
+// following the pagination markers until every page has been retrieved.
+func listGroupPolicyNames(
+	ctx context.Context,
+	iamService iamservice.Service,
+	groupName string,
+) ([]string, error) {
+	policyNames := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListGroupPolicies(ctx, &iam.ListGroupPoliciesInput{
+			GroupName: aws.String(groupName),
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list inline policies for group %s: %w", groupName, err)
+		}
+
+		policyNames = append(policyNames, output.PolicyNames...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyNames, nil
+}
+
+// listAttachedGroupPolicyArns lists the ARNs of all of the managed policies
+// attached to a group, following the pagination markers until every page
+// has been retrieved.
+func listAttachedGroupPolicyArns(
+	ctx context.Context,
+	iamService iamservice.Service,
+	groupName string,
+) ([]string, error) {
+	policyArns := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
+			GroupName: aws.String(groupName),
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attached policies for group %s: %w", groupName, err)
+		}
+
+		policyArns = append(policyArns, attachedPolicyARNs(output.AttachedPolicies)...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	return policyArns, nil
+}
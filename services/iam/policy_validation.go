@@ -0,0 +1,173 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	iampolicy "github.com/newstack-cloud/bluelink-provider-aws/services/iam/policy"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// Policy size quotas in characters, white space is not counted,
+// see: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html
+const (
+	maxManagedPolicyDocumentSize = 6144
+	maxRoleInlinePoliciesSize    = 10240
+	maxUserInlinePoliciesSize    = 2048
+	maxGroupInlinePoliciesSize   = 5120
+)
+
+// Policy documents are validated in the custom validation step of each resource
+// as the blueprint framework only calls the validation functions of
+// schema definitions for scalar values.
+
+func (i *iamRoleResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	spec := input.SchemaResource.Spec
+	trustPolicy, hasTrustPolicy := pluginutils.GetValueByPath("$.assumeRolePolicyDocument", spec)
+	if hasTrustPolicy {
+		diagnostics = append(diagnostics, iampolicy.Lint("$.assumeRolePolicyDocument", trustPolicy)...)
+	}
+	diagnostics = append(diagnostics, validateInlinePolicies(spec, "role", maxRoleInlinePoliciesSize)...)
+
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
+
+func (i *iamUserResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	diagnostics = append(
+		diagnostics,
+		validateInlinePolicies(input.SchemaResource.Spec, "user", maxUserInlinePoliciesSize)...,
+	)
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
+
+func (i *iamGroupResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	diagnostics = append(
+		diagnostics,
+		validateInlinePolicies(input.SchemaResource.Spec, "group", maxGroupInlinePoliciesSize)...,
+	)
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
+
+func (i *iamManagedPolicyResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	policyDocument, hasPolicyDocument := pluginutils.GetValueByPath(
+		"$.policyDocument",
+		input.SchemaResource.Spec,
+	)
+	if hasPolicyDocument {
+		diagnostics = append(
+			diagnostics,
+			validateManagedPolicyDocument("$.policyDocument", policyDocument)...,
+		)
+	}
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
+
+// validateManagedPolicyDocument lints a managed policy document
+// and makes sure that it does not exceed the managed policy size quota.
+func validateManagedPolicyDocument(
+	path string,
+	value *core.MappingNode,
+) []*core.Diagnostic {
+	diagnostics := iampolicy.Lint(path, value)
+
+	size, err := iampolicy.Size(value)
+	if err != nil || size <= maxManagedPolicyDocumentSize {
+		return diagnostics
+	}
+
+	return append(diagnostics, &core.Diagnostic{
+		Level: core.DiagnosticLevelError,
+		Message: fmt.Sprintf(
+			"The %s field contains a policy document of %d characters, "+
+				"a managed policy document can have at most %d characters excluding white space.",
+			path,
+			size,
+			maxManagedPolicyDocumentSize,
+		),
+		Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+	})
+}
+
+// validateInlinePolicies lints the inline policies of an IAM entity
+// and makes sure that their combined size does not exceed
+// the quota for the entity type.
+func validateInlinePolicies(
+	spec *core.MappingNode,
+	entityLabel string,
+	maxSize int,
+) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	policies, hasPolicies := pluginutils.GetValueByPath("$.policies", spec)
+	if !hasPolicies {
+		return diagnostics
+	}
+
+	totalSize := 0
+	for i, policy := range policies.Items {
+		if policy == nil || policy.Fields == nil || policy.Fields["policyDocument"] == nil {
+			continue
+		}
+
+		policyDocument := policy.Fields["policyDocument"]
+		diagnostics = append(
+			diagnostics,
+			iampolicy.Lint(fmt.Sprintf("$.policies[%d].policyDocument", i), policyDocument)...,
+		)
+
+		size, err := iampolicy.Size(policyDocument)
+		if err != nil {
+			continue
+		}
+		totalSize += size
+	}
+
+	if totalSize <= maxSize {
+		return diagnostics
+	}
+
+	return append(diagnostics, &core.Diagnostic{
+		Level: core.DiagnosticLevelError,
+		Message: fmt.Sprintf(
+			"The inline policies in $.policies have a combined size of %d characters, "+
+				"the inline policies of an IAM %s can have at most %d characters excluding white space.",
+			totalSize,
+			entityLabel,
+			maxSize,
+		),
+		Range: core.DiagnosticRangeFromSourceMeta(policies.SourceMeta, nil),
+	})
+}
@@ -0,0 +1,274 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type PolicyValidationSuite struct {
+	suite.Suite
+}
+
+func (s *PolicyValidationSuite) Test_validate_managed_policy_document_size() {
+	resource := s.createResource(ManagedPolicyResource)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/managedPolicy", map[string]*core.MappingNode{
+			"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+			"policyDocument": policyDocumentWithResources(10),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+
+	document := policyDocumentWithResources(200)
+	document.SourceMeta = &source.Meta{Position: source.Position{Line: 8, Column: 5}}
+	output, err = resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/managedPolicy", map[string]*core.MappingNode{
+			"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+			"policyDocument": document,
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Equal(core.DiagnosticLevelError, output.Diagnostics[0].Level)
+	s.Equal(8, output.Diagnostics[0].Range.Start.Line)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"a managed policy document can have at most 6144 characters excluding white space.",
+	)
+}
+
+func (s *PolicyValidationSuite) Test_validate_managed_policy_document_lints_statements() {
+	resource := s.createResource(ManagedPolicyResource)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/managedPolicy", map[string]*core.MappingNode{
+			"policyDocument": policyDocumentWithAction("GetObject"),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Equal(core.DiagnosticLevelError, output.Diagnostics[0].Level)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"The action \"GetObject\" in $.policyDocument.Statement[0].Action is not valid",
+	)
+}
+
+func (s *PolicyValidationSuite) Test_validate_role_lints_trust_policy_and_inline_policies() {
+	resource := s.createResource(RoleResource)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/role", map[string]*core.MappingNode{
+			"assumeRolePolicyDocument": policyDocumentWithAction("sts-AssumeRole"),
+			"policies": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+							"policyDocument": policyDocumentWithAction("s3:GetObject"),
+						},
+					},
+					{
+						Fields: map[string]*core.MappingNode{
+							"policyName":     core.MappingNodeFromString("OrdersWriteAccess"),
+							"policyDocument": policyDocumentWithAction("PutObject"),
+						},
+					},
+				},
+			},
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 2)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"The action \"sts-AssumeRole\" in $.assumeRolePolicyDocument.Statement[0].Action is not valid",
+	)
+	s.Contains(
+		output.Diagnostics[1].Message,
+		"The action \"PutObject\" in $.policies[1].policyDocument.Statement[0].Action is not valid",
+	)
+}
+
+func (s *PolicyValidationSuite) Test_validate_inline_policies_combined_size() {
+	// Each policy is under the managed policy quota but the combined size
+	// exceeds the inline policy quota for a role.
+	policies := []*core.MappingNode{}
+	for i := range 3 {
+		policies = append(policies, &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"policyName":     core.MappingNodeFromString(fmt.Sprintf("Policy%d", i)),
+				"policyDocument": policyDocumentWithResources(100),
+			},
+		})
+	}
+
+	resource := s.createResource(RoleResource)
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/role", map[string]*core.MappingNode{
+			"policies": {Items: policies[:2]},
+		}),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+
+	output, err = resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/role", map[string]*core.MappingNode{
+			"policies": {Items: policies},
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Equal(core.DiagnosticLevelError, output.Diagnostics[0].Level)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"the inline policies of an IAM role can have at most 10240 characters excluding white space.",
+	)
+}
+
+func (s *PolicyValidationSuite) Test_validate_user_and_group_inline_policies_combined_size() {
+	// A single policy of 70 resources is within the group quota
+	// but exceeds the user quota.
+	spec := map[string]*core.MappingNode{
+		"policies": {
+			Items: []*core.MappingNode{
+				{
+					Fields: map[string]*core.MappingNode{
+						"policyName":     core.MappingNodeFromString("OrdersReadAccess"),
+						"policyDocument": policyDocumentWithResources(70),
+					},
+				},
+			},
+		},
+	}
+
+	output, err := s.createResource(UserResource).CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/user", spec),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"the inline policies of an IAM user can have at most 2048 characters excluding white space.",
+	)
+
+	output, err = s.createResource(GroupResource).CustomValidate(
+		context.Background(),
+		s.createValidateInput("aws/iam/group", spec),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+}
+
+func (s *PolicyValidationSuite) createResource(
+	resourceFactory func(
+		pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+		pluginutils.ServiceConfigStore[*aws.Config],
+	) provider.Resource,
+) provider.Resource {
+	return resourceFactory(
+		iammock.CreateIamServiceMockFactory(),
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+			utils.AWSConfigCacheKey,
+		),
+	)
+}
+
+func (s *PolicyValidationSuite) createValidateInput(
+	resourceType string,
+	specFields map[string]*core.MappingNode,
+) *provider.ResourceValidateInput {
+	return &provider.ResourceValidateInput{
+		SchemaResource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: resourceType},
+			Spec: &core.MappingNode{
+				Fields: specFields,
+			},
+		},
+		ProviderContext: plugintestutils.NewTestProviderContext(
+			"aws",
+			map[string]*core.ScalarValue{
+				"region": core.ScalarFromString("us-west-2"),
+			},
+			map[string]*core.ScalarValue{},
+		),
+	}
+}
+
+func policyDocumentWithAction(action string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect":   core.MappingNodeFromString("Allow"),
+							"Action":   core.MappingNodeFromString(action),
+							"Resource": core.MappingNodeFromString("*"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policyDocumentWithResources creates a policy document with the given
+// number of resources, each resource adds 35 characters to the size of the document.
+func policyDocumentWithResources(count int) *core.MappingNode {
+	resources := &core.MappingNode{}
+	for i := range count {
+		resources.Items = append(
+			resources.Items,
+			core.MappingNodeFromString(fmt.Sprintf("arn:aws:s3:::orders-bucket-%04d/*", i)),
+		)
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect":   core.MappingNodeFromString("Allow"),
+							"Action":   core.MappingNodeFromString("s3:GetObject"),
+							"Resource": resources,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPolicyValidationSuite(t *testing.T) {
+	suite.Run(t, new(PolicyValidationSuite))
+}
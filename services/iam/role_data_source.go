@@ -0,0 +1,155 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// RoleDataSource returns a data source implementation for an AWS IAM Role.
+func RoleDataSource(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/iam_role_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/iam_role_jsonc.md")
+
+	iamRoleFetcher := &iamRoleDataSourceFetcher{
+		iamServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/iam/role",
+		Label:            "AWS IAM Role",
+		PlainTextSummary: "A data source for retrieving an AWS IAM role.",
+		FormattedDescription: "The data source type used to define an [IAM role](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles.html) " +
+			"managed externally in AWS.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields:       iamRoleDataSourceSchema(),
+		FilterFields: entityFilterFields("role", true),
+		FetchFunc:    iamRoleFetcher.Fetch,
+	}
+}
+
+type iamRoleDataSourceFetcher struct {
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	awsConfigStore    pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (i *iamRoleDataSourceFetcher) getIamService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (iamservice.Service, error) {
+	awsConfig, err := i.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.iamServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (i *iamRoleDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	iamService, err := i.getIamService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM service: %w", err)
+	}
+
+	filters, err := extractEntityFilters(input.DataSourceWithResolvedSubs.Filter, "role")
+	if err != nil {
+		return nil, err
+	}
+
+	roleOutput, err := iamService.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(filters.nameOrNameFromARN()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM role: %w", err)
+	}
+
+	role := roleOutput.Role
+	err = filters.check("role", aws.ToString(role.Arn), aws.ToString(role.Path), role.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	assumeRolePolicyDocument, err := decodePolicyDocument(role.AssumeRolePolicyDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]*core.MappingNode{
+		"arn":                      core.MappingNodeFromString(aws.ToString(role.Arn)),
+		"name":                     core.MappingNodeFromString(aws.ToString(role.RoleName)),
+		"roleId":                   core.MappingNodeFromString(aws.ToString(role.RoleId)),
+		"path":                     core.MappingNodeFromString(aws.ToString(role.Path)),
+		"createDate":               formatDataSourceDate(role.CreateDate),
+		"assumeRolePolicyDocument": core.MappingNodeFromString(assumeRolePolicyDocument),
+		"maxSessionDuration":       core.MappingNodeFromInt(int(aws.ToInt32(role.MaxSessionDuration))),
+		"tags":                     tagsToDataSourceValue(role.Tags),
+	}
+
+	if role.Description != nil {
+		data["description"] = core.MappingNodeFromString(aws.ToString(role.Description))
+	}
+
+	if role.PermissionsBoundary != nil {
+		data["permissionsBoundary"] = core.MappingNodeFromString(
+			aws.ToString(role.PermissionsBoundary.PermissionsBoundaryArn),
+		)
+	}
+
+	err = i.addPolicies(ctx, iamService, role.RoleName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: data,
+	}, nil
+}
+
+func (i *iamRoleDataSourceFetcher) addPolicies(
+	ctx context.Context,
+	iamService iamservice.Service,
+	roleName *string,
+	data map[string]*core.MappingNode,
+) error {
+	managedPolicyARNs, err := listAttachedRolePolicyArns(ctx, iamService, aws.ToString(roleName))
+	if err != nil {
+		return err
+	}
+	data["managedPolicyArns"] = stringsToDataSourceValue(managedPolicyARNs)
+
+	policyNames, err := listRolePolicyNames(ctx, iamService, aws.ToString(roleName))
+	if err != nil {
+		return err
+	}
+
+	return inlinePolicyData(data, policyNames, func(policyName string) (*string, error) {
+		output, err := iamService.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+			RoleName:   roleName,
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.PolicyDocument, nil
+	})
+}
@@ -0,0 +1,95 @@
+package iam
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func iamRoleDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arn": {
+			Label:       "Role ARN",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The Amazon Resource Name (ARN) of the role.",
+			Nullable:    false,
+		},
+		"name": {
+			Label:       "Role Name",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The name of the role.",
+			Nullable:    false,
+		},
+		"roleId": {
+			Label:       "Role ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The stable and unique string identifying the role.",
+			Nullable:    false,
+		},
+		"path": {
+			Label:       "Path",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The path to the role.",
+			Nullable:    false,
+		},
+		"description": {
+			Label:       "Description",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "A description of the role.",
+			Nullable:    true,
+		},
+		"createDate": {
+			Label:       "Create Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the role was created.",
+			Nullable:    false,
+		},
+		"assumeRolePolicyDocument": {
+			Label:       "Assume Role Policy Document",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The trust policy that grants permission to assume the role, as a JSON string.",
+			Nullable:    false,
+		},
+		"maxSessionDuration": {
+			Label:       "Max Session Duration",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The maximum session duration (in seconds) for the role.",
+			Nullable:    false,
+		},
+		"permissionsBoundary": {
+			Label:       "Permissions Boundary",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The ARN of the policy used to set the permissions boundary for the role.",
+			Nullable:    true,
+		},
+		"managedPolicyArns": {
+			Label:       "Managed Policy ARNs",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The ARNs of the managed policies attached to the role.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyNames": {
+			Label:       "Inline Policy Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the inline policies embedded in the role.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyDocuments": {
+			Label:       "Inline Policy Documents",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The policy documents of the inline policies embedded in the role as JSON strings, in the same order as inlinePolicyNames.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"tags": {
+			Label:       "Tags",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The tags attached to the role.",
+			Nullable:    false,
+		},
+	}
+}
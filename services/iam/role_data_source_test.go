@@ -0,0 +1,371 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IamRoleDataSourceSuite struct {
+	suite.Suite
+}
+
+// Custom test case structure for IAM data source tests.
+type IamDataSourceFetchTestCase struct {
+	Name                 string
+	ServiceFactory       func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service
+	ConfigStore          pluginutils.ServiceConfigStore[*aws.Config]
+	Input                *provider.DataSourceFetchInput
+	ExpectedOutput       *provider.DataSourceFetchOutput
+	ExpectError          bool
+	ExpectedErrorMessage string
+}
+
+func (s *IamRoleDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []IamDataSourceFetchTestCase{
+		createRoleFetchByNameTestCase(providerCtx, loader),
+		createRoleFetchByARNWithFiltersTestCase(providerCtx, loader),
+		createRoleARNMismatchTestCase(providerCtx, loader),
+		createRolePathFilterMismatchTestCase(providerCtx, loader),
+		createRoleTagFilterMismatchTestCase(providerCtx, loader),
+		createRoleInvalidTagFilterTestCase(providerCtx, loader),
+		createRoleMissingNameAndARNTestCase(providerCtx, loader),
+		createRoleFetchErrorTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := RoleDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func TestIamRoleDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(IamRoleDataSourceSuite))
+}
+
+// Test case generator functions below.
+
+const (
+	testRoleARN          = "arn:aws:iam::123456789012:role/service-roles/test-role"
+	testAssumeRolePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	testInlinePolicy     = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+)
+
+var testDataSourceCreateDate = time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+func testRole() *types.Role {
+	return &types.Role{
+		Arn:                      aws.String(testRoleARN),
+		RoleName:                 aws.String("test-role"),
+		RoleId:                   aws.String("AROA123456789EXAMPLE"),
+		Path:                     aws.String("/service-roles/"),
+		CreateDate:               aws.Time(testDataSourceCreateDate),
+		AssumeRolePolicyDocument: aws.String(url.QueryEscape(testAssumeRolePolicy)),
+		MaxSessionDuration:       aws.Int32(3600),
+		Tags: []types.Tag{
+			{Key: aws.String("Environment"), Value: aws.String("production")},
+		},
+	}
+}
+
+func createRoleServiceMockFactory(
+	role *types.Role,
+) func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+	return iammock.CreateIamServiceMockFactory(
+		iammock.WithGetRoleOutput(&iam.GetRoleOutput{Role: role}),
+		iammock.WithListAttachedRolePoliciesOutput(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"),
+					PolicyName: aws.String("AWSLambdaBasicExecutionRole"),
+				},
+			},
+		}),
+		iammock.WithListRolePoliciesOutput(&iam.ListRolePoliciesOutput{
+			PolicyNames: []string{"s3-read"},
+		}),
+		iammock.WithGetRolePolicyOutput(&iam.GetRolePolicyOutput{
+			PolicyName:     aws.String("s3-read"),
+			PolicyDocument: aws.String(url.QueryEscape(testInlinePolicy)),
+		}),
+	)
+}
+
+func createTestConfigStore(loader *testutils.MockAWSConfigLoader) pluginutils.ServiceConfigStore[*aws.Config] {
+	return utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		loader,
+		utils.AWSConfigCacheKey,
+	)
+}
+
+func createFetchInput(
+	providerCtx provider.Context,
+	filters ...*provider.ResolvedDataSourceFilter,
+) *provider.DataSourceFetchInput {
+	return &provider.DataSourceFetchInput{
+		ProviderContext: providerCtx,
+		DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+			Filter: &provider.ResolvedDataSourceFilters{
+				Filters: filters,
+			},
+		},
+	}
+}
+
+func createFilter(
+	field string,
+	operator schema.DataSourceFilterOperator,
+	search string,
+) *provider.ResolvedDataSourceFilter {
+	return &provider.ResolvedDataSourceFilter{
+		Field: core.ScalarFromString(field),
+		Operator: &schema.DataSourceFilterOperatorWrapper{
+			Value: operator,
+		},
+		Search: &provider.ResolvedDataSourceFilterSearch{
+			Values: []*core.MappingNode{
+				core.MappingNodeFromString(search),
+			},
+		},
+	}
+}
+
+func expectedRoleData() map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"arn":                      core.MappingNodeFromString(testRoleARN),
+		"name":                     core.MappingNodeFromString("test-role"),
+		"roleId":                   core.MappingNodeFromString("AROA123456789EXAMPLE"),
+		"path":                     core.MappingNodeFromString("/service-roles/"),
+		"createDate":               core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+		"assumeRolePolicyDocument": core.MappingNodeFromString(testAssumeRolePolicy),
+		"maxSessionDuration":       core.MappingNodeFromInt(3600),
+		"managedPolicyArns": {
+			Items: []*core.MappingNode{
+				core.MappingNodeFromString("arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"),
+			},
+		},
+		"inlinePolicyNames": {
+			Items: []*core.MappingNode{
+				core.MappingNodeFromString("s3-read"),
+			},
+		},
+		"inlinePolicyDocuments": {
+			Items: []*core.MappingNode{
+				core.MappingNodeFromString(testInlinePolicy),
+			},
+		},
+		"tags": {
+			Items: []*core.MappingNode{
+				{
+					Fields: map[string]*core.MappingNode{
+						"key":   core.MappingNodeFromString("Environment"),
+						"value": core.MappingNodeFromString("production"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createRoleFetchByNameTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "successfully fetches role by name",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-role").Filters[0],
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: expectedRoleData(),
+		},
+	}
+}
+
+func createRoleFetchByARNWithFiltersTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	role := testRole()
+	role.Description = aws.String("Execution role for the orders service")
+	role.PermissionsBoundary = &types.AttachedPermissionsBoundary{
+		PermissionsBoundaryArn:  aws.String("arn:aws:iam::123456789012:policy/boundary"),
+		PermissionsBoundaryType: types.PermissionsBoundaryAttachmentTypePolicy,
+	}
+
+	expectedData := expectedRoleData()
+	expectedData["description"] = core.MappingNodeFromString("Execution role for the orders service")
+	expectedData["permissionsBoundary"] = core.MappingNodeFromString("arn:aws:iam::123456789012:policy/boundary")
+
+	return IamDataSourceFetchTestCase{
+		Name:           "successfully fetches role by ARN with path and tag filters",
+		ServiceFactory: createRoleServiceMockFactory(role),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", testRoleARN).Filters[0],
+			createFilter("path", schema.DataSourceFilterOperatorStartsWith, "/service-roles/"),
+			createFilter("tag", schema.DataSourceFilterOperatorEquals, "Environment=production"),
+			createFilter("tag", schema.DataSourceFilterOperatorHasKey, "Environment"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: expectedData,
+		},
+	}
+}
+
+func createRoleARNMismatchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "fails when the role found has a different ARN",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", "arn:aws:iam::123456789012:role/other/test-role").Filters[0],
+		),
+		ExpectError: true,
+		ExpectedErrorMessage: "IAM role with ARN \"arn:aws:iam::123456789012:role/other/test-role\" " +
+			"was not found",
+	}
+}
+
+func createRolePathFilterMismatchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "fails when the role does not match the path filter",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-role").Filters[0],
+			createFilter("path", schema.DataSourceFilterOperatorEquals, "/"),
+		),
+		ExpectError: true,
+		ExpectedErrorMessage: "IAM role \"" + testRoleARN + "\" with path \"/service-roles/\" " +
+			"does not match the path filter",
+	}
+}
+
+func createRoleTagFilterMismatchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "fails when the role does not match the tag filter",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-role").Filters[0],
+			createFilter("tag", schema.DataSourceFilterOperatorEquals, "Environment=staging"),
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "IAM role \"" + testRoleARN + "\" does not match the tag filter",
+	}
+}
+
+func createRoleInvalidTagFilterTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "fails when an equals tag filter is not in the key=value form",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-role").Filters[0],
+			createFilter("tag", schema.DataSourceFilterOperatorEquals, "Environment"),
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "tag filter search value \"Environment\" must be in the form \"key=value\"",
+	}
+}
+
+func createRoleMissingNameAndARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name:           "fails when neither a name nor an ARN filter is provided",
+		ServiceFactory: createRoleServiceMockFactory(testRole()),
+		ConfigStore:    createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			createFilter("path", schema.DataSourceFilterOperatorStartsWith, "/service-roles/"),
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "name or ARN filter is required for the IAM role data source",
+	}
+}
+
+func createRoleFetchErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "handles get role error",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetRoleError(errors.New("NoSuchEntity: role not found")),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-role").Filters[0],
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "failed to get IAM role",
+	}
+}
@@ -49,6 +49,7 @@ func RoleResource(
 		UpdateFunc:           iamRoleActions.Update,
 		DestroyFunc:          iamRoleActions.Destroy,
 		StabilisedFunc:       iamRoleActions.Stabilised,
+		CustomValidateFunc:   iamRoleActions.CustomValidate,
 	}
 }
 
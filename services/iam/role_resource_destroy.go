@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/smithy-go"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
@@ -70,6 +71,16 @@ func (i *iamRoleResourceActions) Destroy(
 		}
 	}
 
+	// When the role's policies are exclusively managed by the blueprint,
+	// policies added outside of the blueprint are also removed as IAM
+	// will not delete a role that has policies.
+	if exclusivePolicyManagementEnabled(input.ResourceState.SpecData) {
+		err = removeUndeclaredRolePolicies(ctx, iamService, roleName, input.ResourceState.SpecData)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Remove permissions boundary if it exists
 	if permissionsBoundaryNode, exists := pluginutils.GetValueByPath("$.permissionsBoundary", input.ResourceState.SpecData); exists && permissionsBoundaryNode != nil {
 		_, err := iamService.DeleteRolePermissionsBoundary(ctx, &iam.DeleteRolePermissionsBoundaryInput{
@@ -96,3 +107,42 @@ func (i *iamRoleResourceActions) Destroy(
 
 	return nil
 }
+
+func removeUndeclaredRolePolicies(
+	ctx context.Context,
+	iamService iamservice.Service,
+	roleName string,
+	specData *core.MappingNode,
+) error {
+	policyNames, err := listRolePolicyNames(ctx, iamService, roleName)
+	if err != nil {
+		return err
+	}
+
+	for _, policyName := range undeclaredInlinePolicies(policyNames, specData) {
+		_, err := iamService.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete undeclared inline policy %s: %w", policyName, err)
+		}
+	}
+
+	policyArns, err := listAttachedRolePolicyArns(ctx, iamService, roleName)
+	if err != nil {
+		return err
+	}
+
+	for _, policyArn := range undeclaredManagedPolicyArns(policyArns, specData) {
+		_, err := iamService.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(policyArn),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to detach undeclared managed policy %s: %w", policyArn, err)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
 	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
@@ -42,6 +43,7 @@ func (s *IamRoleResourceDestroySuite) Test_destroy_iam_role() {
 		destroyRoleWithInlinePolicyFailureTestCase(providerCtx, loader),
 		destroyRoleWithManagedPolicyFailureTestCase(providerCtx, loader),
 		destroyRoleWithPermissionsBoundaryTestCase(providerCtx, loader),
+		destroyRoleWithExclusivePolicyManagementTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceDestroyTestCases(
@@ -818,6 +820,86 @@ func destroyRoleWithPermissionsBoundaryTestCase(
 	}
 }
 
+func destroyRoleWithExclusivePolicyManagementTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, iamservice.Service] {
+	service := iammock.CreateIamServiceMock(
+		iammock.WithListRolePoliciesOutput(&iam.ListRolePoliciesOutput{
+			PolicyNames: []string{"ConsolePolicy"},
+		}),
+		iammock.WithListAttachedRolePoliciesOutput(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyName: aws.String("AWSLambdaBasicExecutionRole"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"),
+				},
+				{
+					PolicyName: aws.String("ReadOnlyAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+			},
+		}),
+		iammock.WithDeleteRolePolicyOutput(&iam.DeleteRolePolicyOutput{}),
+		iammock.WithDetachRolePolicyOutput(&iam.DetachRolePolicyOutput{}),
+		iammock.WithDeleteRoleOutput(&iam.DeleteRoleOutput{}),
+	)
+
+	// The inline policy and the ReadOnlyAccess attachment were added outside of the blueprint.
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":                       core.MappingNodeFromString("arn:aws:iam::123456789012:role/TestRole"),
+			"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+			"managedPolicyArns": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, iamservice.Service]{
+		Name: "destroy IAM role removes policies added outside of the blueprint with exclusive policy management",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "TestRole",
+			ResourceState: &state.ResourceState{
+				SpecData: specData,
+			},
+			ProviderContext: providerCtx,
+		},
+		DestroyActionsCalled: map[string]any{
+			"DeleteRolePolicy": &iam.DeleteRolePolicyInput{
+				RoleName:   aws.String("TestRole"),
+				PolicyName: aws.String("ConsolePolicy"),
+			},
+			"DetachRolePolicy": []any{
+				&iam.DetachRolePolicyInput{
+					RoleName:  aws.String("TestRole"),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"),
+				},
+				&iam.DetachRolePolicyInput{
+					RoleName:  aws.String("TestRole"),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+			},
+			"DeleteRole": &iam.DeleteRoleInput{
+				RoleName: aws.String("TestRole"),
+			},
+		},
+	}
+}
+
 func TestIamRoleResourceDestroy(t *testing.T) {
 	suite.Run(t, new(IamRoleResourceDestroySuite))
 }
@@ -2,14 +2,14 @@ package iam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/url"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
@@ -58,20 +58,12 @@ func (i *iamRoleResourceActions) GetExternalState(
 
 	role := roleOutput.Role
 
-	// URL decode the policy document
-	decodedPolicyDocument, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
-	if err != nil {
-		return nil, err
-	}
-
-	// Parse the JSON policy document into a structured format
-	var policyDocument interface{}
-	if err := json.Unmarshal([]byte(decodedPolicyDocument), &policyDocument); err != nil {
-		return nil, err
-	}
-
-	// Convert back to MappingNode structure
-	policyMappingNode, err := convertInterfaceToMappingNode(policyDocument)
+	// The policy document is returned URL-encoded and may be in a different
+	// but equivalent form to the policy document in the current resource spec.
+	policyMappingNode, err := externalPolicyDocument(
+		input.CurrentResourceSpec.Fields["assumeRolePolicyDocument"],
+		aws.ToString(role.AssumeRolePolicyDocument),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -111,15 +103,17 @@ func (i *iamRoleResourceActions) GetExternalState(
 	}
 
 	// Fetch and add inline policies as structured objects
-	listPoliciesOutput, err := iamService.ListRolePolicies(ctx, &iam.ListRolePoliciesInput{
-		RoleName: role.RoleName,
-	})
+	livePolicyNames, err := listRolePolicyNames(ctx, iamService, roleName)
 	if err != nil {
 		return nil, err
 	}
-	if len(listPoliciesOutput.PolicyNames) > 0 {
-		policies := make([]*core.MappingNode, 0, len(listPoliciesOutput.PolicyNames))
-		for _, policyName := range listPoliciesOutput.PolicyNames {
+	// Inline policies added by links are managed by the links
+	// and are not a part of the role's spec.
+	policyNames := slices.DeleteFunc(livePolicyNames, utils.IsLinkInlinePolicy)
+	if len(policyNames) > 0 {
+		declaredPolicyDocuments := declaredInlinePolicyDocuments(input.CurrentResourceSpec)
+		policies := make([]*core.MappingNode, 0, len(policyNames))
+		for _, policyName := range policyNames {
 			getPolicyOutput, err := iamService.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
 				RoleName:   role.RoleName,
 				PolicyName: aws.String(policyName),
@@ -127,11 +121,10 @@ func (i *iamRoleResourceActions) GetExternalState(
 			if err != nil {
 				return nil, err
 			}
-			var policyDoc interface{}
-			if err := json.Unmarshal([]byte(aws.ToString(getPolicyOutput.PolicyDocument)), &policyDoc); err != nil {
-				return nil, err
-			}
-			policyDocNode, err := convertInterfaceToMappingNode(policyDoc)
+			policyDocNode, err := externalPolicyDocument(
+				declaredPolicyDocuments[policyName],
+				aws.ToString(getPolicyOutput.PolicyDocument),
+			)
 			if err != nil {
 				return nil, err
 			}
@@ -145,6 +138,24 @@ func (i *iamRoleResourceActions) GetExternalState(
 		resourceSpecState.Fields["policies"] = &core.MappingNode{Items: policies}
 	}
 
+	// Managed policy attachments are only reported when the role's policies
+	// are exclusively managed by the blueprint, otherwise attachments made
+	// outside of the blueprint would be reported as drift that is never reconciled.
+	if exclusivePolicyManagementEnabled(input.CurrentResourceSpec) {
+		policyArns, err := listAttachedRolePolicyArns(ctx, iamService, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if len(policyArns) > 0 {
+			policyArnNodes := make([]*core.MappingNode, 0, len(policyArns))
+			for _, policyArn := range policyArns {
+				policyArnNodes = append(policyArnNodes, core.MappingNodeFromString(policyArn))
+			}
+			resourceSpecState.Fields["managedPolicyArns"] = &core.MappingNode{Items: policyArnNodes}
+		}
+	}
+	carryOverExclusivePolicyManagement(input.CurrentResourceSpec, resourceSpecState.Fields)
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -51,6 +52,8 @@ func (s *IamRoleResourceGetExternalStateSuite) Test_get_external_state_iam_role(
 		getExternalStateRoleNotFoundTestCase(providerCtx, loader),
 		getExternalStateCompleteRoleTestCase(providerCtx, loader),
 		createGetExternalStateWithInlinePoliciesTestCase(providerCtx, loader),
+		createGetExternalStateEquivalentPoliciesTestCase(providerCtx, loader),
+		createGetExternalStateExclusivePolicyManagementTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
@@ -403,6 +406,230 @@ func createGetExternalStateWithInlinePoliciesTestCase(
 	}
 }
 
+func createGetExternalStateEquivalentPoliciesTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	declaredAssumeRolePolicyDocument := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect": core.MappingNodeFromString("Allow"),
+							"Principal": {
+								Fields: map[string]*core.MappingNode{
+									"Service": core.MappingNodeFromString("lambda.amazonaws.com"),
+								},
+							},
+							"Action": core.MappingNodeFromString("sts:AssumeRole"),
+						},
+					},
+				},
+			},
+		},
+	}
+	declaredInlinePolicyDocument := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"Version": core.MappingNodeFromString("2012-10-17"),
+			"Statement": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"Effect": core.MappingNodeFromString("Allow"),
+							"Action": {
+								Items: []*core.MappingNode{
+									core.MappingNodeFromString("dynamodb:PutItem"),
+									core.MappingNodeFromString("dynamodb:GetItem"),
+								},
+							},
+							"Resource": core.MappingNodeFromString("arn:aws:dynamodb:*:*:table/MyTable"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// The IAM API returns URL-encoded policy documents in an equivalent
+	// but different form to the declared policy documents.
+	service := iammock.CreateIamServiceMock(
+		iammock.WithGetRoleOutput(&iam.GetRoleOutput{
+			Role: &types.Role{
+				RoleName: aws.String("TestRole"),
+				Arn:      aws.String("arn:aws:iam::123456789012:role/TestRole"),
+				RoleId:   aws.String("AROA1234567890123456"),
+				AssumeRolePolicyDocument: aws.String(url.PathEscape(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+						`"Principal":{"Service":["lambda.amazonaws.com"]},"Action":["sts:AssumeRole"]}]}`,
+				)),
+			},
+		}),
+		iammock.WithListRolePoliciesOutput(&iam.ListRolePoliciesOutput{
+			PolicyNames: []string{"DynamoDBAccess"},
+		}),
+		iammock.WithGetRolePolicyOutput(&iam.GetRolePolicyOutput{
+			RoleName:   aws.String("TestRole"),
+			PolicyName: aws.String("DynamoDBAccess"),
+			PolicyDocument: aws.String(url.PathEscape(
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+					`"Action":["dynamodb:GetItem","dynamodb:PutItem"],"Resource":["arn:aws:dynamodb:*:*:table/MyTable"]}]}`,
+			)),
+		}),
+	)
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name: "reports declared policy documents that are semantically equal to the policy documents in AWS",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":                      core.MappingNodeFromString("arn:aws:iam::123456789012:role/TestRole"),
+					"assumeRolePolicyDocument": declaredAssumeRolePolicyDocument,
+					"policies": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"policyName":     core.MappingNodeFromString("DynamoDBAccess"),
+									"policyDocument": declaredInlinePolicyDocument,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"roleName":                 core.MappingNodeFromString("TestRole"),
+					"arn":                      core.MappingNodeFromString("arn:aws:iam::123456789012:role/TestRole"),
+					"roleId":                   core.MappingNodeFromString("AROA1234567890123456"),
+					"assumeRolePolicyDocument": declaredAssumeRolePolicyDocument,
+					"policies": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"policyName":     core.MappingNodeFromString("DynamoDBAccess"),
+									"policyDocument": declaredInlinePolicyDocument,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createGetExternalStateExclusivePolicyManagementTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	service := iammock.CreateIamServiceMock(
+		iammock.WithGetRoleOutput(&iam.GetRoleOutput{
+			Role: &types.Role{
+				RoleName:                 aws.String("TestRole"),
+				Arn:                      aws.String("arn:aws:iam::123456789012:role/TestRole"),
+				RoleId:                   aws.String("AROA1234567890123456"),
+				AssumeRolePolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`),
+			},
+		}),
+		// Inline policies added by links are not a part of the role's spec
+		// and must not be reported as drift.
+		iammock.WithListRolePoliciesOutput(&iam.ListRolePoliciesOutput{
+			PolicyNames: []string{"bluelink-link-orders-invoke-log-order-events"},
+		}),
+		iammock.WithListAttachedRolePoliciesOutput(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyName: aws.String("ReadOnlyAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+				{
+					PolicyName: aws.String("AdministratorAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+				},
+			},
+		}),
+	)
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name: "successfully gets role state with all managed policy attachments for exclusive policy management",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":                       core.MappingNodeFromString("arn:aws:iam::123456789012:role/TestRole"),
+					"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+					"managedPolicyArns": {
+						Items: []*core.MappingNode{
+							core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"roleName":                  core.MappingNodeFromString("TestRole"),
+					"arn":                       core.MappingNodeFromString("arn:aws:iam::123456789012:role/TestRole"),
+					"roleId":                    core.MappingNodeFromString("AROA1234567890123456"),
+					"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+					"assumeRolePolicyDocument": {
+						Fields: map[string]*core.MappingNode{
+							"Version": core.MappingNodeFromString("2012-10-17"),
+							"Statement": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"Effect": core.MappingNodeFromString("Allow"),
+											"Principal": {
+												Fields: map[string]*core.MappingNode{
+													"Service": core.MappingNodeFromString("lambda.amazonaws.com"),
+												},
+											},
+											"Action": core.MappingNodeFromString("sts:AssumeRole"),
+										},
+									},
+								},
+							},
+						},
+					},
+					"managedPolicyArns": {
+						Items: []*core.MappingNode{
+							core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+							core.MappingNodeFromString("arn:aws:iam::aws:policy/AdministratorAccess"),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
 func TestIamRoleResourceGetExternalState(t *testing.T) {
 	suite.Run(t, new(IamRoleResourceGetExternalStateSuite))
 }
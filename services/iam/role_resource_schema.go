@@ -151,6 +151,21 @@ func iamRoleResourceSchema() *provider.ResourceDefinitionsSchema {
 				MaxLength:   1000,
 				Nullable:    true,
 			},
+			"exclusivePolicyManagement": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether the inline policies and managed policy attachments of the role are exclusively " +
+					"managed by the blueprint. When enabled, inline policies and managed policies attached to the role " +
+					"outside of the blueprint are reported as drift and are removed when the role is updated or destroyed. " +
+					"Inline policies with names that start with \"bluelink-link-\" are added by links to the role " +
+					"and are left in place.",
+				FormattedDescription: "Whether the inline policies and managed policy attachments of the role are exclusively " +
+					"managed by the blueprint. When enabled, inline policies and managed policies attached to the role " +
+					"outside of the blueprint are reported as drift and are removed when the role is updated or destroyed. " +
+					"Inline policies with names that start with `bluelink-link-` are added by links to the role " +
+					"and are left in place.",
+				Default:  core.MappingNodeFromBool(false),
+				Nullable: true,
+			},
 			"managedPolicyArns": {
 				Type:        provider.ResourceDefinitionsSchemaTypeArray,
 				Description: "A list of Amazon Resource Names (ARNs) of the IAM managed policies that you want to attach to the role.",
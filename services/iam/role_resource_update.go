@@ -48,6 +48,7 @@ func (i *iamRoleResourceActions) Update(
 		&roleUpdate{},
 		&roleInlinePoliciesUpdate{},
 		&roleManagedPoliciesUpdate{},
+		&roleExclusivePoliciesUpdate{},
 		&roleTagsUpdate{},
 		&rolePermissionsBoundaryUpdate{},
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	iampolicy "github.com/newstack-cloud/bluelink-provider-aws/services/iam/policy"
 	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
@@ -141,6 +142,17 @@ func changesToUpdateAssumeRolePolicyInput(
 		return nil, false, nil
 	}
 
+	// Skip the update when the new trust policy is only a different
+	// but equivalent form of the current trust policy.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	currentAssumeRolePolicyDocument, hasCurrent := pluginutils.GetValueByPath(
+		"$.assumeRolePolicyDocument",
+		currentStateSpecData,
+	)
+	if hasCurrent && iampolicy.Equal(currentAssumeRolePolicyDocument, assumeRolePolicyDocument) {
+		return nil, false, nil
+	}
+
 	// Convert the structured policy document to JSON string
 	policyJSON, err := json.Marshal(assumeRolePolicyDocument)
 	if err != nil {
@@ -336,6 +348,42 @@ func (r *roleManagedPoliciesUpdate) Execute(
 	return saveOpCtx, nil
 }
 
+// roleExclusivePoliciesUpdate removes the inline policies and managed policy attachments
+// that are not declared in the blueprint when exclusive policy management
+// is enabled for the role.
+type roleExclusivePoliciesUpdate struct {
+	specData *core.MappingNode
+}
+
+func (r *roleExclusivePoliciesUpdate) Name() string {
+	return "remove undeclared policies"
+}
+
+func (r *roleExclusivePoliciesUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	r.specData = specData
+	return exclusivePolicyManagementEnabled(specData), saveOpCtx, nil
+}
+
+func (r *roleExclusivePoliciesUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	iamService iamservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	// Policies are listed after the declared policies have been updated
+	// so that only policies added outside of the blueprint are removed.
+	err := removeUndeclaredRolePolicies(
+		ctx,
+		iamService,
+		saveOpCtx.ProviderUpstreamID,
+		r.specData,
+	)
+	return saveOpCtx, err
+}
+
 type roleTagsUpdate struct {
 	toAdd    []types.Tag
 	toRemove []string
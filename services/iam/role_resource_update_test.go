@@ -48,6 +48,7 @@ func (s *IamRoleResourceUpdateSuite) Test_update_iam_role() {
 		updateRoleDetachManagedPoliciesTestCase(providerCtx, loader),
 		updateRolePermissionsBoundaryTestCase(providerCtx, loader),
 		updateRoleRemovePermissionsBoundaryTestCase(providerCtx, loader),
+		updateRoleExclusivePolicyManagementTestCase(providerCtx, loader),
 		recreateRoleOnRoleNameChangeTestCase(providerCtx, loader),
 	}
 
@@ -1445,6 +1446,156 @@ func updateRoleRemovePermissionsBoundaryTestCase(
 	}
 }
 
+func updateRoleExclusivePolicyManagementTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service] {
+	service := iammock.CreateIamServiceMock(
+		iammock.WithListRolePoliciesOutput(&iam.ListRolePoliciesOutput{
+			PolicyNames: []string{
+				"DeclaredPolicy",
+				"ConsolePolicy",
+				"bluelink-link-orders-invoke-log-order-events",
+			},
+		}),
+		iammock.WithListAttachedRolePoliciesOutput(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyName: aws.String("ReadOnlyAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+				{
+					PolicyName: aws.String("AdministratorAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+				},
+			},
+		}),
+		iammock.WithDeleteRolePolicyOutput(&iam.DeleteRolePolicyOutput{}),
+		iammock.WithDetachRolePolicyOutput(&iam.DetachRolePolicyOutput{}),
+		iammock.WithGetRoleOutput(&iam.GetRoleOutput{
+			Role: &types.Role{
+				RoleName: aws.String("test-role"),
+				Arn:      aws.String("arn:aws:iam::123456789012:role/test-role"),
+				RoleId:   aws.String("AROA1234567890123456"),
+			},
+		}),
+	)
+
+	declaredPolicies := &core.MappingNode{
+		Items: []*core.MappingNode{
+			{
+				Fields: map[string]*core.MappingNode{
+					"policyName": core.MappingNodeFromString("DeclaredPolicy"),
+					"policyDocument": {
+						Fields: map[string]*core.MappingNode{
+							"Version": core.MappingNodeFromString("2012-10-17"),
+							"Statement": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"Effect":   core.MappingNodeFromString("Allow"),
+											"Action":   core.MappingNodeFromString("s3:GetObject"),
+											"Resource": core.MappingNodeFromString("*"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	declaredManagedPolicyArns := &core.MappingNode{
+		Items: []*core.MappingNode{
+			core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":               core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"roleName":          core.MappingNodeFromString("test-role"),
+			"policies":          declaredPolicies,
+			"managedPolicyArns": declaredManagedPolicyArns,
+		},
+	}
+
+	// ConsolePolicy and the AdministratorAccess attachment were added outside of the blueprint,
+	// the bluelink-link- prefixed policy was added by a link and must be left in place.
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"roleName":                  core.MappingNodeFromString("test-role"),
+			"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+			"policies":                  declaredPolicies,
+			"managedPolicyArns":         declaredManagedPolicyArns,
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
+		Name: "update role removes undeclared policies with exclusive policy management",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-role-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-role-id",
+					ResourceName: "TestRole",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-role-id",
+						Name:       "TestRole",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/iam/role",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.exclusivePolicyManagement",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		SaveActionsCalled: map[string]any{
+			"DeleteRolePolicy": &iam.DeleteRolePolicyInput{
+				RoleName:   aws.String("test-role"),
+				PolicyName: aws.String("ConsolePolicy"),
+			},
+			"DetachRolePolicy": &iam.DetachRolePolicyInput{
+				RoleName:  aws.String("test-role"),
+				PolicyArn: aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+			},
+			"GetRole": &iam.GetRoleInput{
+				RoleName: aws.String("test-role"),
+			},
+		},
+		SaveActionsNotCalled: []string{"PutRolePolicy", "AttachRolePolicy"},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":    core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+				"spec.roleId": core.MappingNodeFromString("AROA1234567890123456"),
+			},
+		},
+	}
+}
+
 func recreateRoleOnRoleNameChangeTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
@@ -489,6 +489,22 @@ type Service interface {
 		optFns ...func(*iam.Options),
 	) (*iam.ListPolicyVersionsOutput, error)
 
+	// GetPolicyVersion retrieves information about the specified version of the specified managed policy,
+	// including the policy document.
+	GetPolicyVersion(
+		ctx context.Context,
+		params *iam.GetPolicyVersionInput,
+		optFns ...func(*iam.Options),
+	) (*iam.GetPolicyVersionOutput, error)
+
+	// ListPolicies lists all the managed policies that are available in your AWS account,
+	// including your own customer-defined managed policies and all Amazon Web Services managed policies.
+	ListPolicies(
+		ctx context.Context,
+		params *iam.ListPoliciesInput,
+		optFns ...func(*iam.Options),
+	) (*iam.ListPoliciesOutput, error)
+
 	// TagPolicy adds one or more tags to an IAM managed policy.
 	TagPolicy(
 		ctx context.Context,
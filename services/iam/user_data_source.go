@@ -0,0 +1,179 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// UserDataSource returns a data source implementation for an AWS IAM User.
+func UserDataSource(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/iam_user_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/iam_user_jsonc.md")
+
+	iamUserFetcher := &iamUserDataSourceFetcher{
+		iamServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/iam/user",
+		Label:            "AWS IAM User",
+		PlainTextSummary: "A data source for retrieving an AWS IAM user.",
+		FormattedDescription: "The data source type used to define an [IAM user](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_users.html) " +
+			"managed externally in AWS.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields:       iamUserDataSourceSchema(),
+		FilterFields: entityFilterFields("user", true),
+		FetchFunc:    iamUserFetcher.Fetch,
+	}
+}
+
+type iamUserDataSourceFetcher struct {
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	awsConfigStore    pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (i *iamUserDataSourceFetcher) getIamService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (iamservice.Service, error) {
+	awsConfig, err := i.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.iamServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (i *iamUserDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	iamService, err := i.getIamService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM service: %w", err)
+	}
+
+	filters, err := extractEntityFilters(input.DataSourceWithResolvedSubs.Filter, "user")
+	if err != nil {
+		return nil, err
+	}
+
+	userOutput, err := iamService.GetUser(ctx, &iam.GetUserInput{
+		UserName: aws.String(filters.nameOrNameFromARN()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM user: %w", err)
+	}
+
+	user := userOutput.User
+	err = filters.check("user", aws.ToString(user.Arn), aws.ToString(user.Path), user.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]*core.MappingNode{
+		"arn":        core.MappingNodeFromString(aws.ToString(user.Arn)),
+		"name":       core.MappingNodeFromString(aws.ToString(user.UserName)),
+		"userId":     core.MappingNodeFromString(aws.ToString(user.UserId)),
+		"path":       core.MappingNodeFromString(aws.ToString(user.Path)),
+		"createDate": formatDataSourceDate(user.CreateDate),
+		"tags":       tagsToDataSourceValue(user.Tags),
+	}
+
+	if user.PermissionsBoundary != nil {
+		data["permissionsBoundary"] = core.MappingNodeFromString(
+			aws.ToString(user.PermissionsBoundary.PermissionsBoundaryArn),
+		)
+	}
+
+	err = i.addGroups(ctx, iamService, user.UserName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.addPolicies(ctx, iamService, user.UserName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: data,
+	}, nil
+}
+
+func (i *iamUserDataSourceFetcher) addGroups(
+	ctx context.Context,
+	iamService iamservice.Service,
+	userName *string,
+	data map[string]*core.MappingNode,
+) error {
+	groupNames := []string{}
+	var marker *string
+	for {
+		output, err := iamService.ListGroupsForUser(ctx, &iam.ListGroupsForUserInput{
+			UserName: userName,
+			Marker:   marker,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list groups for IAM user: %w", err)
+		}
+
+		for _, group := range output.Groups {
+			groupNames = append(groupNames, aws.ToString(group.GroupName))
+		}
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+	data["groups"] = stringsToDataSourceValue(groupNames)
+
+	return nil
+}
+
+func (i *iamUserDataSourceFetcher) addPolicies(
+	ctx context.Context,
+	iamService iamservice.Service,
+	userName *string,
+	data map[string]*core.MappingNode,
+) error {
+	managedPolicyARNs, err := listAttachedUserPolicyArns(ctx, iamService, aws.ToString(userName))
+	if err != nil {
+		return err
+	}
+	data["managedPolicyArns"] = stringsToDataSourceValue(managedPolicyARNs)
+
+	policyNames, err := listUserPolicyNames(ctx, iamService, aws.ToString(userName))
+	if err != nil {
+		return err
+	}
+
+	return inlinePolicyData(data, policyNames, func(policyName string) (*string, error) {
+		output, err := iamService.GetUserPolicy(ctx, &iam.GetUserPolicyInput{
+			UserName:   userName,
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.PolicyDocument, nil
+	})
+}
@@ -0,0 +1,86 @@
+package iam
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func iamUserDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arn": {
+			Label:       "User ARN",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The Amazon Resource Name (ARN) of the user.",
+			Nullable:    false,
+		},
+		"name": {
+			Label:       "User Name",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The name of the user.",
+			Nullable:    false,
+		},
+		"userId": {
+			Label:       "User ID",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The stable and unique string identifying the user.",
+			Nullable:    false,
+		},
+		"path": {
+			Label:       "Path",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The path to the user.",
+			Nullable:    false,
+		},
+		"createDate": {
+			Label:       "Create Date",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The date and time, in ISO 8601 date-time format, when the user was created.",
+			Nullable:    false,
+		},
+		"permissionsBoundary": {
+			Label:       "Permissions Boundary",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The ARN of the policy used to set the permissions boundary for the user.",
+			Nullable:    true,
+		},
+		"groups": {
+			Label:       "Groups",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the groups that the user belongs to.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"managedPolicyArns": {
+			Label:       "Managed Policy ARNs",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The ARNs of the managed policies attached to the user.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyNames": {
+			Label:       "Inline Policy Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the inline policies embedded in the user.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"inlinePolicyDocuments": {
+			Label:       "Inline Policy Documents",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The policy documents of the inline policies embedded in the user as JSON strings, in the same order as inlinePolicyNames.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"tags": {
+			Label:       "Tags",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The tags attached to the user.",
+			Nullable:    false,
+		},
+	}
+}
@@ -0,0 +1,214 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type IamUserDataSourceSuite struct {
+	suite.Suite
+}
+
+func (s *IamUserDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []IamDataSourceFetchTestCase{
+		createUserFetchByARNTestCase(providerCtx, loader),
+		createUserTagKeyFilterMismatchTestCase(providerCtx, loader),
+		createUserListGroupsErrorTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := UserDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func TestIamUserDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(IamUserDataSourceSuite))
+}
+
+// Test case generator functions below.
+
+const testUserARN = "arn:aws:iam::123456789012:user/deploy/test-user"
+
+func createUserFetchByARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "successfully fetches user by ARN",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetUserOutput(&iam.GetUserOutput{
+				User: &types.User{
+					Arn:        aws.String(testUserARN),
+					UserName:   aws.String("test-user"),
+					UserId:     aws.String("AIDA123456789EXAMPLE"),
+					Path:       aws.String("/deploy/"),
+					CreateDate: aws.Time(testDataSourceCreateDate),
+					PermissionsBoundary: &types.AttachedPermissionsBoundary{
+						PermissionsBoundaryArn: aws.String("arn:aws:iam::123456789012:policy/boundary"),
+					},
+					Tags: []types.Tag{
+						{Key: aws.String("Team"), Value: aws.String("platform")},
+					},
+				},
+			}),
+			iammock.WithListGroupsForUserOutput(&iam.ListGroupsForUserOutput{
+				Groups: []types.Group{
+					{GroupName: aws.String("deployers")},
+					{GroupName: aws.String("developers")},
+				},
+			}),
+			iammock.WithListAttachedUserPoliciesOutput(&iam.ListAttachedUserPoliciesOutput{
+				AttachedPolicies: []types.AttachedPolicy{
+					{PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")},
+				},
+			}),
+			iammock.WithListUserPoliciesOutput(&iam.ListUserPoliciesOutput{
+				PolicyNames: []string{"s3-read"},
+			}),
+			iammock.WithGetUserPolicyOutput(&iam.GetUserPolicyOutput{
+				PolicyName:     aws.String("s3-read"),
+				PolicyDocument: aws.String(url.QueryEscape(testInlinePolicy)),
+			}),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("arn", testUserARN).Filters[0],
+			createFilter("tag", schema.DataSourceFilterOperatorHasKey, "Team"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: map[string]*core.MappingNode{
+				"arn":                 core.MappingNodeFromString(testUserARN),
+				"name":                core.MappingNodeFromString("test-user"),
+				"userId":              core.MappingNodeFromString("AIDA123456789EXAMPLE"),
+				"path":                core.MappingNodeFromString("/deploy/"),
+				"createDate":          core.MappingNodeFromString("2025-01-15T10:30:00Z"),
+				"permissionsBoundary": core.MappingNodeFromString("arn:aws:iam::123456789012:policy/boundary"),
+				"groups": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("deployers"),
+						core.MappingNodeFromString("developers"),
+					},
+				},
+				"managedPolicyArns": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+					},
+				},
+				"inlinePolicyNames": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("s3-read"),
+					},
+				},
+				"inlinePolicyDocuments": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString(testInlinePolicy),
+					},
+				},
+				"tags": {
+					Items: []*core.MappingNode{
+						{
+							Fields: map[string]*core.MappingNode{
+								"key":   core.MappingNodeFromString("Team"),
+								"value": core.MappingNodeFromString("platform"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createUserTagKeyFilterMismatchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "fails when the user does not have the tag key",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetUserOutput(&iam.GetUserOutput{
+				User: &types.User{
+					Arn:      aws.String(testUserARN),
+					UserName: aws.String("test-user"),
+					Path:     aws.String("/deploy/"),
+				},
+			}),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-user").Filters[0],
+			createFilter("tag", schema.DataSourceFilterOperatorHasKey, "Team"),
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "IAM user \"" + testUserARN + "\" does not match the tag filter",
+	}
+}
+
+func createUserListGroupsErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) IamDataSourceFetchTestCase {
+	return IamDataSourceFetchTestCase{
+		Name: "handles list groups for user error",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetUserOutput(&iam.GetUserOutput{
+				User: &types.User{
+					Arn:      aws.String(testUserARN),
+					UserName: aws.String("test-user"),
+					Path:     aws.String("/deploy/"),
+				},
+			}),
+			iammock.WithListGroupsForUserError(errors.New("AccessDenied")),
+		),
+		ConfigStore: createTestConfigStore(loader),
+		Input: createFetchInput(
+			providerCtx,
+			pluginutils.CreateStringEqualsFilter("name", "test-user").Filters[0],
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: "failed to list groups for IAM user: AccessDenied",
+	}
+}
@@ -49,6 +49,7 @@ func UserResource(
 		UpdateFunc:           iamUserActions.Update,
 		DestroyFunc:          iamUserActions.Destroy,
 		StabilisedFunc:       iamUserActions.Stabilised,
+		CustomValidateFunc:   iamUserActions.CustomValidate,
 	}
 }
 
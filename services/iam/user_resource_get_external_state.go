@@ -2,7 +2,6 @@ package iam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -76,7 +75,7 @@ func (i *iamUserResourceActions) GetExternalState(
 	}
 
 	// Get inline policies
-	inlinePolicies, err := i.getInlinePolicies(ctx, iamService, userName)
+	inlinePolicies, err := i.getInlinePolicies(ctx, iamService, userName, input.CurrentResourceSpec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inline policies: %w", err)
 	}
@@ -128,6 +127,8 @@ func (i *iamUserResourceActions) GetExternalState(
 		}
 	}
 
+	carryOverExclusivePolicyManagement(input.CurrentResourceSpec, externalState)
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: &core.MappingNode{
 			Fields: externalState,
@@ -140,16 +141,14 @@ func (i *iamUserResourceActions) getManagedPolicies(
 	iamService iamservice.Service,
 	userName string,
 ) ([]*core.MappingNode, error) {
-	result, err := iamService.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{
-		UserName: aws.String(userName),
-	})
+	policyArns, err := listAttachedUserPolicyArns(ctx, iamService, userName)
 	if err != nil {
 		return nil, err
 	}
 
 	var policies []*core.MappingNode
-	for _, policy := range result.AttachedPolicies {
-		policies = append(policies, core.MappingNodeFromString(aws.ToString(policy.PolicyArn)))
+	for _, policyArn := range policyArns {
+		policies = append(policies, core.MappingNodeFromString(policyArn))
 	}
 
 	return policies, nil
@@ -159,17 +158,18 @@ func (i *iamUserResourceActions) getInlinePolicies(
 	ctx context.Context,
 	iamService iamservice.Service,
 	userName string,
+	currentResourceSpec *core.MappingNode,
 ) ([]*core.MappingNode, error) {
+	declaredPolicyDocuments := declaredInlinePolicyDocuments(currentResourceSpec)
+
 	// First, list all inline policy names
-	listResult, err := iamService.ListUserPolicies(ctx, &iam.ListUserPoliciesInput{
-		UserName: aws.String(userName),
-	})
+	policyNames, err := listUserPolicyNames(ctx, iamService, userName)
 	if err != nil {
 		return nil, err
 	}
 
 	var policies []*core.MappingNode
-	for _, policyName := range listResult.PolicyNames {
+	for _, policyName := range policyNames {
 		// Get the policy document for each policy
 		policyResult, err := iamService.GetUserPolicy(ctx, &iam.GetUserPolicyInput{
 			UserName:   aws.String(userName),
@@ -179,16 +179,12 @@ func (i *iamUserResourceActions) getInlinePolicies(
 			return nil, fmt.Errorf("failed to get policy %s: %w", policyName, err)
 		}
 
-		// Parse the policy document JSON
-		var policyDoc map[string]any
-		if err := json.Unmarshal([]byte(aws.ToString(policyResult.PolicyDocument)), &policyDoc); err != nil {
-			return nil, fmt.Errorf("failed to parse policy document for %s: %w", policyName, err)
-		}
-
-		// Convert to MappingNode
-		policyDocNode, err := convertInterfaceToMappingNode(policyDoc)
+		policyDocNode, err := externalPolicyDocument(
+			declaredPolicyDocuments[policyName],
+			aws.ToString(policyResult.PolicyDocument),
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert policy document to mapping node: %w", err)
+			return nil, fmt.Errorf("failed to parse policy document for %s: %w", policyName, err)
 		}
 
 		policyNode := &core.MappingNode{
@@ -42,6 +42,7 @@ func (s *IAMUserResourceGetExternalStateSuite) Test_get_external_state() {
 		createGetUserGroupsErrorTestCase(providerCtx, loader),
 		createUserWithLoginProfileStateTestCase(providerCtx, loader),
 		createUserWithPermissionsBoundaryStateTestCase(providerCtx, loader),
+		createUserWithPagedManagedPoliciesStateTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
@@ -636,6 +637,94 @@ func createUserWithPermissionsBoundaryStateTestCase(
 	}
 }
 
+func createUserWithPagedManagedPoliciesStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service] {
+	currentResourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":      core.MappingNodeFromString("arn:aws:iam::123456789012:user/test-user"),
+			"userName": core.MappingNodeFromString("test-user"),
+		},
+	}
+
+	expectedResourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":      core.MappingNodeFromString("arn:aws:iam::123456789012:user/test-user"),
+			"userId":   core.MappingNodeFromString("AIDA1234567890123456"),
+			"userName": core.MappingNodeFromString("test-user"),
+			"path":     core.MappingNodeFromString("/"),
+			"managedPolicyArns": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+					core.MappingNodeFromString("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, iamservice.Service]{
+		Name: "successfully gets user state with managed policies across multiple pages",
+		ServiceFactory: iammock.CreateIamServiceMockFactory(
+			iammock.WithGetUserOutput(&iam.GetUserOutput{
+				User: &types.User{
+					Arn:      aws.String("arn:aws:iam::123456789012:user/test-user"),
+					UserId:   aws.String("AIDA1234567890123456"),
+					UserName: aws.String("test-user"),
+					Path:     aws.String("/"),
+				},
+			}),
+			iammock.WithListGroupsForUserOutput(&iam.ListGroupsForUserOutput{
+				Groups: []types.Group{},
+			}),
+			iammock.WithListAttachedUserPoliciesOutputSequence(
+				&iam.ListAttachedUserPoliciesOutput{
+					AttachedPolicies: []types.AttachedPolicy{
+						{
+							PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+							PolicyName: aws.String("ReadOnlyAccess"),
+						},
+					},
+					IsTruncated: true,
+					Marker:      aws.String("page-2"),
+				},
+				&iam.ListAttachedUserPoliciesOutput{
+					AttachedPolicies: []types.AttachedPolicy{
+						{
+							PolicyArn:  aws.String("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"),
+							PolicyName: aws.String("AmazonS3ReadOnlyAccess"),
+						},
+					},
+				},
+			),
+			iammock.WithListUserPoliciesOutput(&iam.ListUserPoliciesOutput{
+				PolicyNames: []string{},
+			}),
+			iammock.WithListUserTagsOutput(&iam.ListUserTagsOutput{
+				Tags: []types.Tag{},
+			}),
+			iammock.WithGetLoginProfileError(&smithy.GenericAPIError{
+				Code: "NoSuchEntity",
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID:          "test-instance-id",
+			ResourceID:          "test-user",
+			CurrentResourceSpec: currentResourceSpec,
+			ProviderContext:     providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: expectedResourceSpecState,
+		},
+	}
+}
+
 func TestIAMUserResourceGetExternalState(t *testing.T) {
 	suite.Run(t, new(IAMUserResourceGetExternalStateSuite))
 }
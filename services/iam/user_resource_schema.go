@@ -54,6 +54,14 @@ func iamUserResourceSchema() *provider.ResourceDefinitionsSchema {
 				},
 				Nullable: true,
 			},
+			"exclusivePolicyManagement": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether the inline policies and managed policy attachments of the user are exclusively " +
+					"managed by the blueprint. When enabled, inline policies and managed policies attached to the user " +
+					"outside of the blueprint are reported as drift and are removed when the user is updated.",
+				Default:  core.MappingNodeFromBool(false),
+				Nullable: true,
+			},
 			"managedPolicyArns": {
 				Type:        provider.ResourceDefinitionsSchemaTypeArray,
 				Description: "A list of Amazon Resource Names (ARNs) of the IAM managed policies that you want to attach to the user.",
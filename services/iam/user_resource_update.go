@@ -48,6 +48,7 @@ func (i *iamUserResourceActions) Update(
 		&userLoginProfileUpdate{userName: userName},
 		&userInlinePoliciesUpdate{userName: userName},
 		&userManagedPoliciesUpdate{userName: userName},
+		&userExclusivePoliciesUpdate{userName: userName},
 		&userPermissionsBoundaryUpdate{userName: userName},
 		&userTagsUpdate{userName: userName},
 		&userGroupMembershipUpdate{userName: userName},
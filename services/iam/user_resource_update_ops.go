@@ -309,6 +309,67 @@ func (u *userManagedPoliciesUpdate) Execute(
 	return saveOpCtx, nil
 }
 
+// userExclusivePoliciesUpdate removes the inline policies and managed policy attachments
+// that are not declared in the blueprint when exclusive policy management
+// is enabled for the user.
+type userExclusivePoliciesUpdate struct {
+	userName string
+	specData *core.MappingNode
+}
+
+func (u *userExclusivePoliciesUpdate) Name() string {
+	return "remove undeclared policies"
+}
+
+func (u *userExclusivePoliciesUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	u.specData = specData
+	return exclusivePolicyManagementEnabled(specData), saveOpCtx, nil
+}
+
+func (u *userExclusivePoliciesUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	iamService iamservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	// Policies are listed after the declared policies have been updated
+	// so that only policies added outside of the blueprint are removed.
+	policyNames, err := listUserPolicyNames(ctx, iamService, u.userName)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	for _, policyName := range undeclaredInlinePolicies(policyNames, u.specData) {
+		_, err := iamService.DeleteUserPolicy(ctx, &iam.DeleteUserPolicyInput{
+			UserName:   aws.String(u.userName),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to delete undeclared inline policy %s: %w", policyName, err)
+		}
+	}
+
+	policyArns, err := listAttachedUserPolicyArns(ctx, iamService, u.userName)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	for _, policyArn := range undeclaredManagedPolicyArns(policyArns, u.specData) {
+		_, err := iamService.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{
+			UserName:  aws.String(u.userName),
+			PolicyArn: aws.String(policyArn),
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to detach undeclared managed policy %s: %w", policyArn, err)
+		}
+	}
+
+	return saveOpCtx, nil
+}
+
 type userPermissionsBoundaryUpdate struct {
 	userName  string
 	operation string // "set", "delete"
@@ -43,6 +43,7 @@ func (s *IAMUserResourceUpdateSuite) Test_update_iam_user() {
 		createUserPoliciesUpdateTestCase(providerCtx, loader),
 		createUserGroupsUpdateTestCase(providerCtx, loader),
 		createUserPermissionsBoundaryUpdateTestCase(providerCtx, loader),
+		createUserExclusivePolicyManagementUpdateTestCase(providerCtx, loader),
 		createUserUpdateFailureTestCase(providerCtx, loader),
 		recreateUserOnUserNameChangeTestCase(providerCtx, loader),
 	}
@@ -995,6 +996,158 @@ func createUserUpdateFailureTestCase(
 	}
 }
 
+func createUserExclusivePolicyManagementUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service] {
+	resourceARN := "arn:aws:iam::123456789012:user/test-user"
+	userId := "AIDA1234567890123456"
+
+	service := iammock.CreateIamServiceMock(
+		iammock.WithListUserPoliciesOutput(&iam.ListUserPoliciesOutput{
+			PolicyNames: []string{"DeclaredPolicy", "ConsolePolicy"},
+		}),
+		iammock.WithListAttachedUserPoliciesOutput(&iam.ListAttachedUserPoliciesOutput{
+			AttachedPolicies: []types.AttachedPolicy{
+				{
+					PolicyName: aws.String("ReadOnlyAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				},
+				{
+					PolicyName: aws.String("AdministratorAccess"),
+					PolicyArn:  aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+				},
+			},
+		}),
+		iammock.WithDeleteUserPolicyOutput(&iam.DeleteUserPolicyOutput{}),
+		iammock.WithDetachUserPolicyOutput(&iam.DetachUserPolicyOutput{}),
+		iammock.WithGetUserOutput(&iam.GetUserOutput{
+			User: &types.User{
+				Arn:      aws.String(resourceARN),
+				UserId:   aws.String(userId),
+				UserName: aws.String("test-user"),
+				Path:     aws.String("/"),
+			},
+		}),
+	)
+
+	declaredPolicies := &core.MappingNode{
+		Items: []*core.MappingNode{
+			{
+				Fields: map[string]*core.MappingNode{
+					"policyName": core.MappingNodeFromString("DeclaredPolicy"),
+					"policyDocument": {
+						Fields: map[string]*core.MappingNode{
+							"Version": core.MappingNodeFromString("2012-10-17"),
+							"Statement": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"Effect":   core.MappingNodeFromString("Allow"),
+											"Action":   core.MappingNodeFromString("s3:GetObject"),
+											"Resource": core.MappingNodeFromString("*"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	declaredManagedPolicyArns := &core.MappingNode{
+		Items: []*core.MappingNode{
+			core.MappingNodeFromString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":               core.MappingNodeFromString(resourceARN),
+			"userId":            core.MappingNodeFromString(userId),
+			"userName":          core.MappingNodeFromString("test-user"),
+			"path":              core.MappingNodeFromString("/"),
+			"policies":          declaredPolicies,
+			"managedPolicyArns": declaredManagedPolicyArns,
+		},
+	}
+
+	// ConsolePolicy and the AdministratorAccess attachment were added outside of the blueprint.
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"userName":                  core.MappingNodeFromString("test-user"),
+			"path":                      core.MappingNodeFromString("/"),
+			"exclusivePolicyManagement": core.MappingNodeFromBool(true),
+			"policies":                  declaredPolicies,
+			"managedPolicyArns":         declaredManagedPolicyArns,
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, iamservice.Service]{
+		Name: "update user removes undeclared policies with exclusive policy management",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-user-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-user-id",
+					ResourceName: "TestUser",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-user-id",
+						Name:       "TestUser",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/iam/user",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.exclusivePolicyManagement",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		SaveActionsCalled: map[string]any{
+			"DeleteUserPolicy": &iam.DeleteUserPolicyInput{
+				UserName:   aws.String("test-user"),
+				PolicyName: aws.String("ConsolePolicy"),
+			},
+			"DetachUserPolicy": &iam.DetachUserPolicyInput{
+				UserName:  aws.String("test-user"),
+				PolicyArn: aws.String("arn:aws:iam::aws:policy/AdministratorAccess"),
+			},
+			"GetUser": &iam.GetUserInput{
+				UserName: aws.String("test-user"),
+			},
+		},
+		SaveActionsNotCalled: []string{"PutUserPolicy", "AttachUserPolicy"},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":    core.MappingNodeFromString(resourceARN),
+				"spec.userId": core.MappingNodeFromString(userId),
+			},
+		},
+	}
+}
+
 func recreateUserOnUserNameChangeTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
@@ -0,0 +1,132 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// AccountSettingsDataSource returns a data source implementation for the
+// AWS Lambda limits and usage of an account in a region.
+func AccountSettingsDataSource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/lambda_account_settings_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/lambda_account_settings_jsonc.md")
+
+	lambdaAccountSettingsFetcher := &lambdaAccountSettingsDataSourceFetcher{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/lambda/accountSettings",
+		Label:            "AWS Lambda Account Settings",
+		PlainTextSummary: "A data source for retrieving the AWS Lambda limits and usage of an account in a region.",
+		FormattedDescription: "The data source type used to retrieve the [Lambda limits](https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html) " +
+			"and usage of the current AWS account in a region. " +
+			"This can be used to check how much concurrency is available before reserving concurrency for functions.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields: lambdaAccountSettingsDataSourceSchema(),
+		FilterFields: map[string]*provider.DataSourceFilterSchema{
+			"region": {
+				Type: provider.DataSourceFilterSearchValueTypeString,
+				Description: "The AWS region to retrieve account settings for, " +
+					"this can be set to the region configured for the provider to retrieve the settings for that region.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+				},
+			},
+		},
+		FetchFunc: lambdaAccountSettingsFetcher.Fetch,
+	}
+}
+
+type lambdaAccountSettingsDataSourceFetcher struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaAccountSettingsDataSourceFetcher) getLambdaService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+	region *core.MappingNode,
+) (lambdaservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		map[string]*core.MappingNode{
+			"region": region,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (l *lambdaAccountSettingsDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	region := pluginutils.ExtractMatchFromFilters(
+		input.DataSourceWithResolvedSubs.Filter,
+		"region",
+	)
+	if region == nil {
+		return nil, errors.New("region filter is required")
+	}
+
+	lambdaService, err := l.getLambdaService(ctx, input, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Lambda service: %w", err)
+	}
+
+	output, err := lambdaService.GetAccountSettings(ctx, &lambda.GetAccountSettingsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Lambda account settings: %w", err)
+	}
+
+	data := map[string]*core.MappingNode{
+		"region": core.MappingNodeFromString(core.StringValue(region)),
+		"accountLimit.minimumUnreservedConcurrentExecutions": core.MappingNodeFromInt(
+			minUnreservedConcurrentExecutions,
+		),
+	}
+
+	if output.AccountLimit != nil {
+		limit := output.AccountLimit
+		data["accountLimit.totalCodeSize"] = core.MappingNodeFromInt(int(limit.TotalCodeSize))
+		data["accountLimit.codeSizeUnzipped"] = core.MappingNodeFromInt(int(limit.CodeSizeUnzipped))
+		data["accountLimit.codeSizeZipped"] = core.MappingNodeFromInt(int(limit.CodeSizeZipped))
+		data["accountLimit.concurrentExecutions"] = core.MappingNodeFromInt(int(limit.ConcurrentExecutions))
+		data["accountLimit.unreservedConcurrentExecutions"] = core.MappingNodeFromInt(
+			int(aws.ToInt32(limit.UnreservedConcurrentExecutions)),
+		)
+		data["accountUsage.reservedConcurrentExecutions"] = core.MappingNodeFromInt(
+			int(limit.ConcurrentExecutions - aws.ToInt32(limit.UnreservedConcurrentExecutions)),
+		)
+	}
+
+	if output.AccountUsage != nil {
+		data["accountUsage.totalCodeSize"] = core.MappingNodeFromInt(int(output.AccountUsage.TotalCodeSize))
+		data["accountUsage.functionCount"] = core.MappingNodeFromInt(int(output.AccountUsage.FunctionCount))
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: data,
+	}, nil
+}
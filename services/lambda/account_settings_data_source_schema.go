@@ -0,0 +1,73 @@
+package lambda
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func lambdaAccountSettingsDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"region": {
+			Label:       "Region",
+			Type:        provider.DataSourceSpecTypeString,
+			Description: "The AWS region that the account settings apply to.",
+			Nullable:    false,
+		},
+		"accountLimit.totalCodeSize": {
+			Label:       "Total Code Size Limit",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The amount of storage space in bytes that you can use for all deployment packages and layer archives.",
+			Nullable:    false,
+		},
+		"accountLimit.codeSizeUnzipped": {
+			Label: "Unzipped Code Size Limit",
+			Type:  provider.DataSourceSpecTypeInteger,
+			Description: "The maximum size in bytes of a function's deployment package and layers " +
+				"when they're extracted.",
+			Nullable: false,
+		},
+		"accountLimit.codeSizeZipped": {
+			Label: "Zipped Code Size Limit",
+			Type:  provider.DataSourceSpecTypeInteger,
+			Description: "The maximum size in bytes of a deployment package when it's uploaded directly to Lambda. " +
+				"Use Amazon S3 for larger files.",
+			Nullable: false,
+		},
+		"accountLimit.concurrentExecutions": {
+			Label:       "Concurrent Executions Limit",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The maximum number of simultaneous function executions in the region.",
+			Nullable:    false,
+		},
+		"accountLimit.unreservedConcurrentExecutions": {
+			Label: "Unreserved Concurrent Executions",
+			Type:  provider.DataSourceSpecTypeInteger,
+			Description: "The maximum number of simultaneous function executions, minus the capacity " +
+				"that's reserved for individual functions with reserved concurrency.",
+			Nullable: false,
+		},
+		"accountLimit.minimumUnreservedConcurrentExecutions": {
+			Label: "Minimum Unreserved Concurrent Executions",
+			Type:  provider.DataSourceSpecTypeInteger,
+			Description: "The number of concurrent executions that Lambda keeps unreserved for functions " +
+				"that do not have reserved concurrency, reserved concurrency can not be set for a function " +
+				"when it would leave fewer unreserved concurrent executions than this.",
+			Nullable: false,
+		},
+		"accountUsage.totalCodeSize": {
+			Label:       "Total Code Size",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The amount of storage space in bytes used by deployment packages and layer archives.",
+			Nullable:    false,
+		},
+		"accountUsage.functionCount": {
+			Label:       "Function Count",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The number of Lambda functions in the region.",
+			Nullable:    false,
+		},
+		"accountUsage.reservedConcurrentExecutions": {
+			Label:       "Reserved Concurrent Executions",
+			Type:        provider.DataSourceSpecTypeInteger,
+			Description: "The number of concurrent executions that are reserved for individual functions in the region.",
+			Nullable:    false,
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaAccountSettingsDataSourceSuite struct {
+	suite.Suite
+}
+
+type AccountSettingsDataSourceFetchTestCase struct {
+	Name                 string
+	ServiceFactory       func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service
+	ConfigStore          pluginutils.ServiceConfigStore[*aws.Config]
+	Input                *provider.DataSourceFetchInput
+	ExpectedOutput       *provider.DataSourceFetchOutput
+	ExpectError          bool
+	ExpectedErrorMessage string
+}
+
+func (s *LambdaAccountSettingsDataSourceSuite) Test_fetch() {
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+	loader := &testutils.MockAWSConfigLoader{}
+
+	testCases := []AccountSettingsDataSourceFetchTestCase{
+		createAccountSettingsFetchTestCase(providerCtx, loader),
+		createAccountSettingsFetchErrorTestCase(providerCtx, loader),
+		createAccountSettingsMissingRegionFilterTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := AccountSettingsDataSource(tc.ServiceFactory, tc.ConfigStore)
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.Contains(err.Error(), tc.ExpectedErrorMessage)
+				}
+			} else {
+				s.NoError(err)
+				s.Equal(tc.ExpectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestLambdaAccountSettingsDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(LambdaAccountSettingsDataSourceSuite))
+}
+
+func createAccountSettingsFetchTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) AccountSettingsDataSourceFetchTestCase {
+	return AccountSettingsDataSourceFetchTestCase{
+		Name: "successfully fetches account settings",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsOutput(&lambda.GetAccountSettingsOutput{
+				AccountLimit: &types.AccountLimit{
+					CodeSizeUnzipped:               262144000,
+					CodeSizeZipped:                 52428800,
+					ConcurrentExecutions:           1000,
+					TotalCodeSize:                  80530636800,
+					UnreservedConcurrentExecutions: aws.Int32(850),
+				},
+				AccountUsage: &types.AccountUsage{
+					FunctionCount: 42,
+					TotalCodeSize: 1073741824,
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: pluginutils.CreateStringEqualsFilter("region", "eu-west-1"),
+			},
+		},
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: map[string]*core.MappingNode{
+				"region":                                             core.MappingNodeFromString("eu-west-1"),
+				"accountLimit.codeSizeUnzipped":                      core.MappingNodeFromInt(262144000),
+				"accountLimit.codeSizeZipped":                        core.MappingNodeFromInt(52428800),
+				"accountLimit.concurrentExecutions":                  core.MappingNodeFromInt(1000),
+				"accountLimit.totalCodeSize":                         core.MappingNodeFromInt(80530636800),
+				"accountLimit.unreservedConcurrentExecutions":        core.MappingNodeFromInt(850),
+				"accountLimit.minimumUnreservedConcurrentExecutions": core.MappingNodeFromInt(100),
+				"accountUsage.functionCount":                         core.MappingNodeFromInt(42),
+				"accountUsage.totalCodeSize":                         core.MappingNodeFromInt(1073741824),
+				"accountUsage.reservedConcurrentExecutions":          core.MappingNodeFromInt(150),
+			},
+		},
+	}
+}
+
+func createAccountSettingsFetchErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) AccountSettingsDataSourceFetchTestCase {
+	return AccountSettingsDataSourceFetchTestCase{
+		Name: "handles get account settings error",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsError(errors.New("access denied")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: pluginutils.CreateStringEqualsFilter("region", "us-west-2"),
+			},
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: "failed to get Lambda account settings",
+	}
+}
+
+func createAccountSettingsMissingRegionFilterTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) AccountSettingsDataSourceFetchTestCase {
+	return AccountSettingsDataSourceFetchTestCase{
+		Name:           "returns an error when the region filter is missing",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: pluginutils.CreateStringEqualsFilter("name", "test"),
+			},
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: "region filter is required",
+	}
+}
@@ -0,0 +1,493 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/smithy-go"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// aliasAutoScalingPolicyName is the name of the target tracking scaling policy
+// that is created for an alias.
+// Scaling policy names only need to be unique for a scalable target,
+// so the same name can be used for all aliases.
+const aliasAutoScalingPolicyName = "provisioned-concurrency-utilization"
+
+// aliasAutoScalingResourceID derives the Application Auto Scaling resource ID
+// for the provisioned concurrency of an alias from the alias ARN.
+// For example, "arn:aws:lambda:us-east-1:123456789012:function:my-function:live"
+// becomes "function:my-function:live".
+func aliasAutoScalingResourceID(aliasARN string) string {
+	parts := strings.SplitN(aliasARN, ":", 6)
+	if len(parts) < 6 {
+		return ""
+	}
+	return parts[5]
+}
+
+// aliasAutoScaling registers the provisioned concurrency of an alias as an
+// Application Auto Scaling scalable target and applies the target tracking policy
+// and scheduled actions defined in the alias spec.
+// When the autoScaling block is removed from the spec of an existing alias,
+// the scalable target is deregistered, which also deletes its scaling policy
+// and scheduled actions.
+type aliasAutoScaling struct {
+	autoScalingService applicationautoscalingservice.Service
+	deregister         bool
+	minCapacity        int32
+	maxCapacity        int32
+	targetTracking     *autoscalingtypes.TargetTrackingScalingPolicyConfiguration
+	removePolicy       bool
+	scheduledActions   []*applicationautoscaling.PutScheduledActionInput
+	removedActions     []string
+}
+
+func (u *aliasAutoScaling) Name() string {
+	return "alias provisioned concurrency auto scaling"
+}
+
+func (u *aliasAutoScaling) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	currentAutoScaling, hasCurrentAutoScaling := pluginutils.GetValueByPath(
+		"$.autoScaling",
+		currentStateSpecData,
+	)
+	autoScaling, hasAutoScaling := pluginutils.GetValueByPath("$.autoScaling", specData)
+
+	if !hasAutoScaling {
+		u.deregister = hasCurrentAutoScaling
+		return u.deregister, saveOpCtx, nil
+	}
+
+	if hasCurrentAutoScaling && core.MappingNodeEqual(currentAutoScaling, autoScaling) {
+		return false, saveOpCtx, nil
+	}
+
+	minCapacity, _ := pluginutils.GetValueByPath("$.minCapacity", autoScaling)
+	maxCapacity, _ := pluginutils.GetValueByPath("$.maxCapacity", autoScaling)
+	u.minCapacity = int32(core.IntValue(minCapacity))
+	u.maxCapacity = int32(core.IntValue(maxCapacity))
+
+	targetUtilization, hasTargetUtilization := pluginutils.GetValueByPath(
+		"$.targetUtilization",
+		autoScaling,
+	)
+	if hasTargetUtilization {
+		u.targetTracking = aliasTargetTrackingConfig(targetUtilization, autoScaling)
+	} else {
+		_, hadTargetUtilization := pluginutils.GetValueByPath(
+			"$.targetUtilization",
+			currentAutoScaling,
+		)
+		u.removePolicy = hadTargetUtilization
+	}
+
+	scheduledActions, err := aliasScheduledActionsInput(autoScaling)
+	if err != nil {
+		return false, saveOpCtx, err
+	}
+	u.scheduledActions = scheduledActions
+	u.removedActions = removedAliasScheduledActions(currentAutoScaling, scheduledActions)
+
+	return true, saveOpCtx, nil
+}
+
+func (u *aliasAutoScaling) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService lambdaservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	resourceID := aliasAutoScalingResourceID(extractAliasArn(saveOpCtx))
+
+	if u.deregister {
+		return saveOpCtx, deregisterAliasScalableTarget(ctx, u.autoScalingService, resourceID)
+	}
+
+	_, err := u.autoScalingService.RegisterScalableTarget(
+		ctx,
+		&applicationautoscaling.RegisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String(resourceID),
+			MinCapacity:       aws.Int32(u.minCapacity),
+			MaxCapacity:       aws.Int32(u.maxCapacity),
+		},
+	)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to register alias as a scalable target: %w", err)
+	}
+
+	err = u.saveScalingPolicy(ctx, resourceID)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	return saveOpCtx, u.saveScheduledActions(ctx, resourceID)
+}
+
+func (u *aliasAutoScaling) saveScalingPolicy(ctx context.Context, resourceID string) error {
+	if u.targetTracking != nil {
+		_, err := u.autoScalingService.PutScalingPolicy(
+			ctx,
+			&applicationautoscaling.PutScalingPolicyInput{
+				PolicyName:                               aws.String(aliasAutoScalingPolicyName),
+				PolicyType:                               autoscalingtypes.PolicyTypeTargetTrackingScaling,
+				ServiceNamespace:                         autoscalingtypes.ServiceNamespaceLambda,
+				ScalableDimension:                        autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+				ResourceId:                               aws.String(resourceID),
+				TargetTrackingScalingPolicyConfiguration: u.targetTracking,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save alias scaling policy: %w", err)
+		}
+		return nil
+	}
+
+	if u.removePolicy {
+		_, err := u.autoScalingService.DeleteScalingPolicy(
+			ctx,
+			&applicationautoscaling.DeleteScalingPolicyInput{
+				PolicyName:        aws.String(aliasAutoScalingPolicyName),
+				ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+				ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+				ResourceId:        aws.String(resourceID),
+			},
+		)
+		if err != nil && !isAutoScalingObjectNotFoundError(err) {
+			return fmt.Errorf("failed to delete alias scaling policy: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (u *aliasAutoScaling) saveScheduledActions(ctx context.Context, resourceID string) error {
+	for _, input := range u.scheduledActions {
+		input.ResourceId = aws.String(resourceID)
+		_, err := u.autoScalingService.PutScheduledAction(ctx, input)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to save alias scheduled action %q: %w",
+				aws.ToString(input.ScheduledActionName),
+				err,
+			)
+		}
+	}
+
+	for _, actionName := range u.removedActions {
+		_, err := u.autoScalingService.DeleteScheduledAction(
+			ctx,
+			&applicationautoscaling.DeleteScheduledActionInput{
+				ScheduledActionName: aws.String(actionName),
+				ServiceNamespace:    autoscalingtypes.ServiceNamespaceLambda,
+				ScalableDimension:   autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+				ResourceId:          aws.String(resourceID),
+			},
+		)
+		if err != nil && !isAutoScalingObjectNotFoundError(err) {
+			return fmt.Errorf("failed to delete alias scheduled action %q: %w", actionName, err)
+		}
+	}
+
+	return nil
+}
+
+func aliasTargetTrackingConfig(
+	targetUtilization *core.MappingNode,
+	autoScaling *core.MappingNode,
+) *autoscalingtypes.TargetTrackingScalingPolicyConfiguration {
+	config := &autoscalingtypes.TargetTrackingScalingPolicyConfiguration{
+		TargetValue: aws.Float64(core.FloatValue(targetUtilization)),
+		PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+			PredefinedMetricType: autoscalingtypes.MetricTypeLambdaProvisionedConcurrencyUtilization,
+		},
+	}
+
+	if scaleInCooldown, ok := pluginutils.GetValueByPath("$.scaleInCooldown", autoScaling); ok {
+		config.ScaleInCooldown = aws.Int32(int32(core.IntValue(scaleInCooldown)))
+	}
+
+	if scaleOutCooldown, ok := pluginutils.GetValueByPath("$.scaleOutCooldown", autoScaling); ok {
+		config.ScaleOutCooldown = aws.Int32(int32(core.IntValue(scaleOutCooldown)))
+	}
+
+	return config
+}
+
+func aliasScheduledActionsInput(
+	autoScaling *core.MappingNode,
+) ([]*applicationautoscaling.PutScheduledActionInput, error) {
+	scheduledActions, _ := pluginutils.GetValueByPath("$.scheduledActions", autoScaling)
+
+	inputs := []*applicationautoscaling.PutScheduledActionInput{}
+	for _, action := range getItems(scheduledActions) {
+		name, _ := pluginutils.GetValueByPath("$.name", action)
+		schedule, _ := pluginutils.GetValueByPath("$.schedule", action)
+		input := &applicationautoscaling.PutScheduledActionInput{
+			ScheduledActionName:  aws.String(core.StringValue(name)),
+			Schedule:             aws.String(core.StringValue(schedule)),
+			ServiceNamespace:     autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension:    autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ScalableTargetAction: &autoscalingtypes.ScalableTargetAction{},
+		}
+
+		if timezone, ok := pluginutils.GetValueByPath("$.timezone", action); ok {
+			input.Timezone = aws.String(core.StringValue(timezone))
+		}
+
+		if minCapacity, ok := pluginutils.GetValueByPath("$.minCapacity", action); ok {
+			input.ScalableTargetAction.MinCapacity = aws.Int32(int32(core.IntValue(minCapacity)))
+		}
+
+		if maxCapacity, ok := pluginutils.GetValueByPath("$.maxCapacity", action); ok {
+			input.ScalableTargetAction.MaxCapacity = aws.Int32(int32(core.IntValue(maxCapacity)))
+		}
+
+		startTime, err := scheduledActionTime(action, "startTime")
+		if err != nil {
+			return nil, err
+		}
+		input.StartTime = startTime
+
+		endTime, err := scheduledActionTime(action, "endTime")
+		if err != nil {
+			return nil, err
+		}
+		input.EndTime = endTime
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+func scheduledActionTime(action *core.MappingNode, field string) (*time.Time, error) {
+	value, ok := pluginutils.GetValueByPath(fmt.Sprintf("$.%s", field), action)
+	if !ok {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, core.StringValue(value))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"the %s of an alias scheduled action must be an RFC 3339 timestamp: %w",
+			field,
+			err,
+		)
+	}
+
+	return &parsed, nil
+}
+
+// removedAliasScheduledActions returns the names of scheduled actions in the current state
+// of an alias that are no longer in the spec.
+func removedAliasScheduledActions(
+	currentAutoScaling *core.MappingNode,
+	scheduledActions []*applicationautoscaling.PutScheduledActionInput,
+) []string {
+	currentActions, _ := pluginutils.GetValueByPath("$.scheduledActions", currentAutoScaling)
+
+	removed := []string{}
+	for _, action := range getItems(currentActions) {
+		name, _ := pluginutils.GetValueByPath("$.name", action)
+		nameString := core.StringValue(name)
+		isInSpec := slices.ContainsFunc(
+			scheduledActions,
+			func(input *applicationautoscaling.PutScheduledActionInput) bool {
+				return aws.ToString(input.ScheduledActionName) == nameString
+			},
+		)
+		if !isInSpec {
+			removed = append(removed, nameString)
+		}
+	}
+
+	return removed
+}
+
+func deregisterAliasScalableTarget(
+	ctx context.Context,
+	autoScalingService applicationautoscalingservice.Service,
+	resourceID string,
+) error {
+	_, err := autoScalingService.DeregisterScalableTarget(
+		ctx,
+		&applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String(resourceID),
+		},
+	)
+	if err != nil && !isAutoScalingObjectNotFoundError(err) {
+		return fmt.Errorf("failed to deregister alias scalable target: %w", err)
+	}
+	return nil
+}
+
+// getAliasAutoScalingState builds the autoScaling spec block for an alias
+// from the scalable target, scaling policy and scheduled actions
+// registered with Application Auto Scaling.
+// This returns nil when the alias is not registered as a scalable target.
+func getAliasAutoScalingState(
+	ctx context.Context,
+	autoScalingService applicationautoscalingservice.Service,
+	aliasARN string,
+) (*core.MappingNode, error) {
+	resourceID := aliasAutoScalingResourceID(aliasARN)
+	targetsOutput, err := autoScalingService.DescribeScalableTargets(
+		ctx,
+		&applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceIds:       []string{resourceID},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias scalable target: %w", err)
+	}
+
+	if len(targetsOutput.ScalableTargets) == 0 {
+		return nil, nil
+	}
+
+	target := targetsOutput.ScalableTargets[0]
+	autoScaling := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"minCapacity": core.MappingNodeFromInt(int(aws.ToInt32(target.MinCapacity))),
+			"maxCapacity": core.MappingNodeFromInt(int(aws.ToInt32(target.MaxCapacity))),
+		},
+	}
+
+	err = addAliasScalingPolicyToState(ctx, autoScalingService, resourceID, autoScaling)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addAliasScheduledActionsToState(ctx, autoScalingService, resourceID, autoScaling)
+	if err != nil {
+		return nil, err
+	}
+
+	return autoScaling, nil
+}
+
+func addAliasScalingPolicyToState(
+	ctx context.Context,
+	autoScalingService applicationautoscalingservice.Service,
+	resourceID string,
+	autoScaling *core.MappingNode,
+) error {
+	policiesOutput, err := autoScalingService.DescribeScalingPolicies(
+		ctx,
+		&applicationautoscaling.DescribeScalingPoliciesInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String(resourceID),
+			PolicyNames:       []string{aliasAutoScalingPolicyName},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get alias scaling policy: %w", err)
+	}
+
+	if len(policiesOutput.ScalingPolicies) == 0 ||
+		policiesOutput.ScalingPolicies[0].TargetTrackingScalingPolicyConfiguration == nil {
+		return nil
+	}
+
+	config := policiesOutput.ScalingPolicies[0].TargetTrackingScalingPolicyConfiguration
+	autoScaling.Fields["targetUtilization"] = core.MappingNodeFromFloat(aws.ToFloat64(config.TargetValue))
+	if config.ScaleInCooldown != nil {
+		autoScaling.Fields["scaleInCooldown"] = core.MappingNodeFromInt(int(*config.ScaleInCooldown))
+	}
+	if config.ScaleOutCooldown != nil {
+		autoScaling.Fields["scaleOutCooldown"] = core.MappingNodeFromInt(int(*config.ScaleOutCooldown))
+	}
+
+	return nil
+}
+
+func addAliasScheduledActionsToState(
+	ctx context.Context,
+	autoScalingService applicationautoscalingservice.Service,
+	resourceID string,
+	autoScaling *core.MappingNode,
+) error {
+	actionsOutput, err := autoScalingService.DescribeScheduledActions(
+		ctx,
+		&applicationautoscaling.DescribeScheduledActionsInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String(resourceID),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get alias scheduled actions: %w", err)
+	}
+
+	if len(actionsOutput.ScheduledActions) == 0 {
+		return nil
+	}
+
+	actions := []*core.MappingNode{}
+	for _, action := range actionsOutput.ScheduledActions {
+		actionNode := &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"name":     core.MappingNodeFromString(aws.ToString(action.ScheduledActionName)),
+				"schedule": core.MappingNodeFromString(aws.ToString(action.Schedule)),
+			},
+		}
+		if action.Timezone != nil {
+			actionNode.Fields["timezone"] = core.MappingNodeFromString(aws.ToString(action.Timezone))
+		}
+		if action.StartTime != nil {
+			actionNode.Fields["startTime"] = core.MappingNodeFromString(
+				action.StartTime.UTC().Format(time.RFC3339),
+			)
+		}
+		if action.EndTime != nil {
+			actionNode.Fields["endTime"] = core.MappingNodeFromString(
+				action.EndTime.UTC().Format(time.RFC3339),
+			)
+		}
+		if action.ScalableTargetAction != nil && action.ScalableTargetAction.MinCapacity != nil {
+			actionNode.Fields["minCapacity"] = core.MappingNodeFromInt(
+				int(*action.ScalableTargetAction.MinCapacity),
+			)
+		}
+		if action.ScalableTargetAction != nil && action.ScalableTargetAction.MaxCapacity != nil {
+			actionNode.Fields["maxCapacity"] = core.MappingNodeFromInt(
+				int(*action.ScalableTargetAction.MaxCapacity),
+			)
+		}
+		actions = append(actions, actionNode)
+	}
+	autoScaling.Fields["scheduledActions"] = &core.MappingNode{Items: actions}
+
+	return nil
+}
+
+func isAutoScalingObjectNotFoundError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ObjectNotFoundException"
+	}
+	return false
+}
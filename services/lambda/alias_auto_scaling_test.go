@@ -0,0 +1,555 @@
+package lambda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	applicationautoscalingmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/applicationautoscaling_mock"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const testAutoScalingAliasArn = "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+type LambdaAliasAutoScalingSuite struct {
+	suite.Suite
+	providerCtx provider.Context
+	loader      *testutils.MockAWSConfigLoader
+}
+
+func (s *LambdaAliasAutoScalingSuite) SetupTest() {
+	s.loader = &testutils.MockAWSConfigLoader{}
+	s.providerCtx = plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_create_alias_registers_scalable_target() {
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateAliasOutput(&lambda.CreateAliasOutput{
+			AliasArn: aws.String(testAutoScalingAliasArn),
+		}),
+		lambdamock.WithPutProvisionedConcurrencyConfigOutput(
+			&lambda.PutProvisionedConcurrencyConfigOutput{},
+		),
+	)
+
+	testCase := s.autoScalingDeployTestCase(
+		"create alias with auto scaling",
+		service,
+		&service.MockCalls,
+		createTestAutoScalingAliasSpec(createTestAutoScalingSpec()),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.SaveActionsCalled = map[string]any{
+		"PutProvisionedConcurrencyConfig": &lambda.PutProvisionedConcurrencyConfigInput{
+			FunctionName:                    aws.String(testAutoScalingAliasArn),
+			ProvisionedConcurrentExecutions: aws.Int32(5),
+		},
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"RegisterScalableTarget",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.RegisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+			MinCapacity:       aws.Int32(5),
+			MaxCapacity:       aws.Int32(100),
+		},
+	)
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"PutScalingPolicy",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.PutScalingPolicyInput{
+			PolicyName:        aws.String(aliasAutoScalingPolicyName),
+			PolicyType:        autoscalingtypes.PolicyTypeTargetTrackingScaling,
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+			TargetTrackingScalingPolicyConfiguration: &autoscalingtypes.TargetTrackingScalingPolicyConfiguration{
+				TargetValue: aws.Float64(0.7),
+				PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+					PredefinedMetricType: autoscalingtypes.MetricTypeLambdaProvisionedConcurrencyUtilization,
+				},
+				ScaleInCooldown: aws.Int32(300),
+			},
+		},
+	)
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"PutScheduledAction",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.PutScheduledActionInput{
+			ScheduledActionName: aws.String("scale-down-overnight"),
+			Schedule:            aws.String("cron(0 22 * * ? *)"),
+			Timezone:            aws.String("Europe/London"),
+			StartTime:           aws.Time(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			ServiceNamespace:    autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension:   autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:          aws.String("function:test-function:live"),
+			ScalableTargetAction: &autoscalingtypes.ScalableTargetAction{
+				MinCapacity: aws.Int32(1),
+				MaxCapacity: aws.Int32(10),
+			},
+		},
+	)
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_update_alias_removes_policy_and_scheduled_actions() {
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn: aws.String(testAutoScalingAliasArn),
+		}),
+	)
+
+	autoScaling := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"minCapacity": core.MappingNodeFromInt(1),
+			"maxCapacity": core.MappingNodeFromInt(20),
+		},
+	}
+	specData := createTestAutoScalingAliasSpec(autoScaling)
+	delete(specData.Fields, "provisionedConcurrencyConfig")
+	currentStateSpecData := createTestAutoScalingAliasSpec(createTestAutoScalingSpec())
+
+	testCase := s.autoScalingDeployTestCase(
+		"update alias auto scaling",
+		service,
+		&service.MockCalls,
+		specData,
+		currentStateSpecData,
+	)
+
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"RegisterScalableTarget",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.RegisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+			MinCapacity:       aws.Int32(1),
+			MaxCapacity:       aws.Int32(20),
+		},
+	)
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"DeleteScalingPolicy",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.DeleteScalingPolicyInput{
+			PolicyName:        aws.String(aliasAutoScalingPolicyName),
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+		},
+	)
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"DeleteScheduledAction",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.DeleteScheduledActionInput{
+			ScheduledActionName: aws.String("scale-down-overnight"),
+			ServiceNamespace:    autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension:   autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:          aws.String("function:test-function:live"),
+		},
+	)
+	autoScalingService.AssertNotCalled(&s.Suite, "PutScalingPolicy")
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_update_alias_without_auto_scaling_changes_keeps_scaled_concurrency() {
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn: aws.String(testAutoScalingAliasArn),
+		}),
+	)
+
+	specData := createTestAutoScalingAliasSpec(createTestAutoScalingSpec())
+	specData.Fields["description"] = core.MappingNodeFromString("Updated")
+	currentStateSpecData := createTestAutoScalingAliasSpec(createTestAutoScalingSpec())
+
+	testCase := s.autoScalingDeployTestCase(
+		"update alias without auto scaling changes",
+		service,
+		&service.MockCalls,
+		specData,
+		currentStateSpecData,
+	)
+
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+
+	service.AssertNotCalled(&s.Suite, "PutProvisionedConcurrencyConfig")
+	autoScalingService.AssertNotCalled(&s.Suite, "RegisterScalableTarget")
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_update_alias_removing_auto_scaling_deregisters_scalable_target() {
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn: aws.String(testAutoScalingAliasArn),
+		}),
+		lambdamock.WithPutProvisionedConcurrencyConfigOutput(
+			&lambda.PutProvisionedConcurrencyConfigOutput{},
+		),
+	)
+
+	specData := createTestAutoScalingAliasSpec(nil)
+	currentStateSpecData := createTestAutoScalingAliasSpec(createTestAutoScalingSpec())
+
+	testCase := s.autoScalingDeployTestCase(
+		"update alias removing auto scaling",
+		service,
+		&service.MockCalls,
+		specData,
+		currentStateSpecData,
+	)
+
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"DeregisterScalableTarget",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+		},
+	)
+	autoScalingService.AssertNotCalled(&s.Suite, "RegisterScalableTarget")
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_destroy_alias_deregisters_scalable_target() {
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithDeleteAliasOutput(&lambda.DeleteAliasOutput{}),
+	)
+
+	specData := createTestAutoScalingAliasSpec(createTestAutoScalingSpec())
+	specData.Fields["aliasArn"] = core.MappingNodeFromString(testAutoScalingAliasArn)
+
+	plugintestutils.RunResourceDestroyTestCases(
+		[]plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service]{
+			{
+				Name: "destroy alias with auto scaling",
+				ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+					return service
+				},
+				ServiceMockCalls: &service.MockCalls,
+				ConfigStore:      s.configStore(),
+				Input: &provider.ResourceDestroyInput{
+					ProviderContext: s.providerCtx,
+					ResourceState: &state.ResourceState{
+						SpecData: specData,
+					},
+				},
+				DestroyActionsCalled: map[string]any{
+					"DeleteAlias": &lambda.DeleteAliasInput{
+						FunctionName: aws.String("test-function"),
+						Name:         aws.String("live"),
+					},
+				},
+			},
+		},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+
+	autoScalingService.AssertCalledWith(
+		&s.Suite,
+		"DeregisterScalableTarget",
+		0,
+		plugintestutils.Any,
+		&applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  autoscalingtypes.ServiceNamespaceLambda,
+			ScalableDimension: autoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+			ResourceId:        aws.String("function:test-function:live"),
+		},
+	)
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_get_external_state_includes_auto_scaling() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetAliasOutput(&lambda.GetAliasOutput{
+			AliasArn:        aws.String(testAutoScalingAliasArn),
+			Name:            aws.String("live"),
+			FunctionVersion: aws.String("1"),
+		}),
+	)
+	autoScalingService := applicationautoscalingmock.CreateApplicationAutoScalingServiceMock(
+		applicationautoscalingmock.WithDescribeScalableTargetsOutput(
+			&applicationautoscaling.DescribeScalableTargetsOutput{
+				ScalableTargets: []autoscalingtypes.ScalableTarget{
+					{
+						MinCapacity: aws.Int32(5),
+						MaxCapacity: aws.Int32(100),
+					},
+				},
+			},
+		),
+		applicationautoscalingmock.WithDescribeScalingPoliciesOutput(
+			&applicationautoscaling.DescribeScalingPoliciesOutput{
+				ScalingPolicies: []autoscalingtypes.ScalingPolicy{
+					{
+						TargetTrackingScalingPolicyConfiguration: &autoscalingtypes.TargetTrackingScalingPolicyConfiguration{
+							TargetValue:     aws.Float64(0.7),
+							ScaleInCooldown: aws.Int32(300),
+						},
+					},
+				},
+			},
+		),
+		applicationautoscalingmock.WithDescribeScheduledActionsOutput(
+			&applicationautoscaling.DescribeScheduledActionsOutput{
+				ScheduledActions: []autoscalingtypes.ScheduledAction{
+					{
+						ScheduledActionName: aws.String("scale-down-overnight"),
+						Schedule:            aws.String("cron(0 22 * * ? *)"),
+						Timezone:            aws.String("Europe/London"),
+						StartTime:           aws.Time(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+						ScalableTargetAction: &autoscalingtypes.ScalableTargetAction{
+							MinCapacity: aws.Int32(1),
+							MaxCapacity: aws.Int32(10),
+						},
+					},
+				},
+			},
+		),
+	)
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		[]plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+			{
+				Name: "get alias external state with auto scaling",
+				ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+					return service
+				},
+				ConfigStore: s.configStore(),
+				Input: &provider.ResourceGetExternalStateInput{
+					ProviderContext: s.providerCtx,
+					CurrentResourceSpec: createTestAutoScalingAliasSpec(
+						createTestAutoScalingSpec(),
+					),
+				},
+				ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+					ResourceSpecState: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"functionName":    core.MappingNodeFromString("test-function"),
+							"name":            core.MappingNodeFromString("live"),
+							"functionVersion": core.MappingNodeFromString("1"),
+							"aliasArn":        core.MappingNodeFromString(testAutoScalingAliasArn),
+							"autoScaling":     createTestAutoScalingSpec(),
+						},
+					},
+				},
+			},
+		},
+		newTestAliasResourceWithAutoScaling(
+			func(*aws.Config, provider.Context) applicationautoscalingservice.Service {
+				return autoScalingService
+			},
+		),
+		&s.Suite,
+	)
+}
+
+func (s *LambdaAliasAutoScalingSuite) Test_resource_id_is_derived_from_alias_arn() {
+	s.Assert().Equal(
+		"function:test-function:live",
+		aliasAutoScalingResourceID(testAutoScalingAliasArn),
+	)
+	s.Assert().Equal("", aliasAutoScalingResourceID("test-function"))
+}
+
+func (s *LambdaAliasAutoScalingSuite) autoScalingDeployTestCase(
+	name string,
+	service lambdaservice.Service,
+	serviceMockCalls *plugintestutils.MockCalls,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	changes := &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceID:   "test-alias-id",
+			ResourceName: "TestAlias",
+			InstanceID:   "test-instance-id",
+			ResourceWithResolvedSubs: &provider.ResolvedResource{
+				Type: &schema.ResourceTypeWrapper{
+					Value: "aws/lambda/alias",
+				},
+				Spec: specData,
+			},
+		},
+	}
+
+	if currentStateSpecData != nil {
+		currentStateSpecData.Fields["aliasArn"] = core.MappingNodeFromString(testAutoScalingAliasArn)
+		changes.AppliedResourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-alias-id",
+			Name:       "TestAlias",
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+		changes.ModifiedFields = []provider.FieldChange{
+			{
+				FieldPath: "spec.autoScaling",
+			},
+		}
+	} else {
+		changes.NewFields = []provider.FieldChange{
+			{
+				FieldPath: "spec.autoScaling",
+			},
+		}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: serviceMockCalls,
+		ConfigStore:      s.configStore(),
+		Input: &provider.ResourceDeployInput{
+			InstanceID:      "test-instance-id",
+			ResourceID:      "test-alias-id",
+			Changes:         changes,
+			ProviderContext: s.providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(testAutoScalingAliasArn),
+			},
+		},
+	}
+}
+
+func (s *LambdaAliasAutoScalingSuite) configStore() *utils.AWSConfigStore {
+	return utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		s.loader,
+		utils.AWSConfigCacheKey,
+	)
+}
+
+func createTestAutoScalingAliasSpec(autoScaling *core.MappingNode) *core.MappingNode {
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("1"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+	if autoScaling != nil {
+		specData.Fields["autoScaling"] = autoScaling
+	}
+	return specData
+}
+
+func createTestAutoScalingSpec() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"minCapacity":       core.MappingNodeFromInt(5),
+			"maxCapacity":       core.MappingNodeFromInt(100),
+			"targetUtilization": core.MappingNodeFromFloat(0.7),
+			"scaleInCooldown":   core.MappingNodeFromInt(300),
+			"scheduledActions": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"name":        core.MappingNodeFromString("scale-down-overnight"),
+							"schedule":    core.MappingNodeFromString("cron(0 22 * * ? *)"),
+							"timezone":    core.MappingNodeFromString("Europe/London"),
+							"startTime":   core.MappingNodeFromString("2025-01-01T00:00:00Z"),
+							"minCapacity": core.MappingNodeFromInt(1),
+							"maxCapacity": core.MappingNodeFromInt(10),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestLambdaAliasAutoScalingSuite(t *testing.T) {
+	suite.Run(t, new(LambdaAliasAutoScalingSuite))
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
@@ -14,17 +16,40 @@ import (
 // AliasResource returns a resource implementation for an AWS Lambda Alias.
 func AliasResource(
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	cloudwatchServiceFactory pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service],
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service],
 	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newAliasResource(
+		lambdaServiceFactory,
+		cloudwatchServiceFactory,
+		autoScalingServiceFactory,
+		awsConfigStore,
+		waitForDuration,
+	)
+}
+
+func newAliasResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	cloudwatchServiceFactory pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service],
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	wait waitFunc,
 ) provider.Resource {
 	yamlExample, _ := examples.ReadFile("examples/resources/lambda_alias_basic.md")
 	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_alias_jsonc.md")
 	trafficRoutingExample, _ := examples.ReadFile("examples/resources/lambda_alias_traffic_routing.md")
 	provisionedConcurrencyExample, _ := examples.ReadFile("examples/resources/lambda_alias_provisioned_concurrency.md")
 	completeExample, _ := examples.ReadFile("examples/resources/lambda_alias_complete.md")
+	progressiveDeploymentExample, _ := examples.ReadFile("examples/resources/lambda_alias_progressive_deployment.md")
+	autoScalingExample, _ := examples.ReadFile("examples/resources/lambda_alias_auto_scaling.md")
 
 	lambdaAliasActions := &lambdaAliasResourceActions{
 		lambdaServiceFactory,
+		cloudwatchServiceFactory,
+		autoScalingServiceFactory,
 		awsConfigStore,
+		wait,
 	}
 	return &providerv1.ResourceDefinition{
 		Type:             "aws/lambda/alias",
@@ -46,6 +71,8 @@ func AliasResource(
 			string(trafficRoutingExample),
 			string(provisionedConcurrencyExample),
 			string(completeExample),
+			string(progressiveDeploymentExample),
+			string(autoScalingExample),
 		},
 		GetExternalStateFunc: lambdaAliasActions.GetExternalState,
 		CreateFunc:           lambdaAliasActions.Create,
@@ -56,8 +83,12 @@ func AliasResource(
 }
 
 type lambdaAliasResourceActions struct {
-	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
-	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	lambdaServiceFactory      pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	cloudwatchServiceFactory  pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service]
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service]
+	awsConfigStore            pluginutils.ServiceConfigStore[*aws.Config]
+	// wait is used to wait between the steps of a progressive deployment.
+	wait waitFunc
 }
 
 func (l *lambdaAliasResourceActions) getLambdaService(
@@ -75,3 +106,35 @@ func (l *lambdaAliasResourceActions) getLambdaService(
 
 	return l.lambdaServiceFactory(awsConfig, providerContext), nil
 }
+
+func (l *lambdaAliasResourceActions) getCloudWatchService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (cloudwatchservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	return l.cloudwatchServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaAliasResourceActions) getAutoScalingService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (applicationautoscalingservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	return l.autoScalingServiceFactory(awsConfig, providerContext), nil
+}
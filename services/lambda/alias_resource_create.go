@@ -21,9 +21,17 @@ func (l *lambdaAliasResourceActions) Create(
 		return nil, err
 	}
 
+	autoScalingService, err := l.getAutoScalingService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
 	createOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
 		&aliasCreate{},
 		&aliasPutProvisionedConcurrencyConfig{},
+		&aliasAutoScaling{
+			autoScalingService: autoScalingService,
+		},
 	}
 
 	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
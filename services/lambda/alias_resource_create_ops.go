@@ -75,6 +75,19 @@ func (u *aliasPutProvisionedConcurrencyConfig) Prepare(
 		return false, saveOpCtx, nil
 	}
 
+	// When auto scaling is configured, the provisioned concurrency config only sets
+	// the initial allocation, after that, Application Auto Scaling adjusts it.
+	// Putting an unchanged config again would reset the scaled allocation.
+	_, hasAutoScaling := pluginutils.GetValueByPath("$.autoScaling", specData)
+	currentConfig, hasCurrentConfig := pluginutils.GetValueByPath(
+		"$.provisionedConcurrencyConfig",
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+	)
+	if hasAutoScaling && hasCurrentConfig &&
+		core.MappingNodeEqual(currentConfig, provisionedConcurrencyConfigData) {
+		return false, saveOpCtx, nil
+	}
+
 	aliasArn := extractAliasArn(saveOpCtx)
 	input, hasUpdates := changesToAliasProvisionedConcurrencyConfigInput(
 		aliasArn,
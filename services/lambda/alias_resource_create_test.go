@@ -45,7 +45,7 @@ func (s *LambdaAliasResourceCreateSuite) Test_create_lambda_alias() {
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		AliasResource,
+		newTestAliasResource,
 		&s.Suite,
 	)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 )
 
 func (l *lambdaAliasResourceActions) Destroy(
@@ -23,6 +24,28 @@ func (l *lambdaAliasResourceActions) Destroy(
 	functionName := core.StringValue(input.ResourceState.SpecData.Fields["functionName"])
 	aliasName := core.StringValue(input.ResourceState.SpecData.Fields["name"])
 
+	// The scalable target for the provisioned concurrency of the alias is not removed
+	// when the alias is deleted, so it must be deregistered first.
+	if _, hasAutoScaling := pluginutils.GetValueByPath(
+		"$.autoScaling",
+		input.ResourceState.SpecData,
+	); hasAutoScaling {
+		autoScalingService, err := l.getAutoScalingService(ctx, input.ProviderContext)
+		if err != nil {
+			return fmt.Errorf("failed to get Application Auto Scaling service: %w", err)
+		}
+
+		aliasArn := core.StringValue(input.ResourceState.SpecData.Fields["aliasArn"])
+		err = deregisterAliasScalableTarget(
+			ctx,
+			autoScalingService,
+			aliasAutoScalingResourceID(aliasArn),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	deleteAliasInput := &lambda.DeleteAliasInput{
 		FunctionName: aws.String(functionName),
 		Name:         aws.String(aliasName),
@@ -41,7 +41,7 @@ func (s *LambdaAliasResourceDestroySuite) Test_destroy_lambda_alias() {
 
 	plugintestutils.RunResourceDestroyTestCases(
 		testCases,
-		AliasResource,
+		newTestAliasResource,
 		&s.Suite,
 	)
 }
@@ -43,6 +43,40 @@ func (l *lambdaAliasResourceActions) GetExternalState(
 		return nil, err
 	}
 
+	// The deployment preference only controls how the provider shifts traffic
+	// during updates, it is not stored in AWS so it is carried over from the current spec.
+	if deploymentPreference, ok := pluginutils.GetValueByPath(
+		"$.deploymentPreference",
+		input.CurrentResourceSpec,
+	); ok {
+		resourceSpecState.Fields["deploymentPreference"] = deploymentPreference
+	}
+
+	// Auto scaling is only checked for aliases that declare it to avoid requiring
+	// Application Auto Scaling permissions for aliases that do not use it.
+	if _, hasAutoScaling := pluginutils.GetValueByPath(
+		"$.autoScaling",
+		input.CurrentResourceSpec,
+	); hasAutoScaling {
+		autoScalingService, err := l.getAutoScalingService(ctx, input.ProviderContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Application Auto Scaling service: %w", err)
+		}
+
+		autoScaling, err := getAliasAutoScalingState(
+			ctx,
+			autoScalingService,
+			aws.ToString(result.AliasArn),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if autoScaling != nil {
+			resourceSpecState.Fields["autoScaling"] = autoScaling
+		}
+	}
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
@@ -43,7 +43,7 @@ func (s *LambdaAliasResourceGetExternalStateSuite) Test_get_external_state_lambd
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
 		testCases,
-		AliasResource,
+		newTestAliasResource,
 		&s.Suite,
 	)
 }
@@ -69,6 +69,139 @@ func lambdaAliasResourceSchema() *provider.ResourceDefinitionsSchema {
 					},
 				},
 			},
+			"autoScaling": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "AliasAutoScaling",
+				Description: "Scales the provisioned concurrency of the alias with Application Auto Scaling. " +
+					"The alias is registered as a scalable target, provisioned concurrency can then follow " +
+					"utilisation with a target tracking policy, a schedule with scheduled actions, or both. " +
+					"When set, provisionedConcurrencyConfig only sets the initial allocation.",
+				FormattedDescription: "Scales the provisioned concurrency of the alias with " +
+					"[Application Auto Scaling](https://docs.aws.amazon.com/lambda/latest/dg/provisioned-concurrency.html#managing-provisioned-concurency). " +
+					"The alias is registered as a scalable target, provisioned concurrency can then follow " +
+					"utilisation with a target tracking policy, a schedule with scheduled actions, or both.\n\n" +
+					"When set, `provisionedConcurrencyConfig` only sets the initial allocation.",
+				Required: []string{"minCapacity", "maxCapacity"},
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"minCapacity": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The minimum amount of provisioned concurrency to scale in to.",
+						Minimum:     core.ScalarFromInt(0),
+					},
+					"maxCapacity": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The maximum amount of provisioned concurrency to scale out to.",
+						Minimum:     core.ScalarFromInt(1),
+					},
+					"targetUtilization": {
+						Type: provider.ResourceDefinitionsSchemaTypeFloat,
+						Description: "The target value for the LambdaProvisionedConcurrencyUtilization metric, " +
+							"the fraction of allocated provisioned concurrency in use. " +
+							"When set, a target tracking scaling policy is created for the alias.",
+						FormattedDescription: "The target value for the `LambdaProvisionedConcurrencyUtilization` metric, " +
+							"the fraction of allocated provisioned concurrency in use. " +
+							"When set, a target tracking scaling policy is created for the alias.",
+						Minimum: core.ScalarFromFloat(0.1),
+						Maximum: core.ScalarFromFloat(0.9),
+					},
+					"scaleInCooldown": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The amount of time, in seconds, after a scale-in activity completes before another scale-in activity can start.",
+						Minimum:     core.ScalarFromInt(0),
+					},
+					"scaleOutCooldown": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The amount of time, in seconds, to wait for a previous scale-out activity to take effect.",
+						Minimum:     core.ScalarFromInt(0),
+					},
+					"scheduledActions": {
+						Type:        provider.ResourceDefinitionsSchemaTypeArray,
+						Description: "Scheduled actions that change the capacity range of the alias at specific times.",
+						Items: &provider.ResourceDefinitionsSchema{
+							Type:     provider.ResourceDefinitionsSchemaTypeObject,
+							Label:    "AliasScheduledAction",
+							Required: []string{"name", "schedule"},
+							Attributes: map[string]*provider.ResourceDefinitionsSchema{
+								"name": {
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									Description: "The name of the scheduled action, unique for the alias.",
+									Pattern:     "[^:/]+",
+									MinLength:   1,
+									MaxLength:   256,
+								},
+								"schedule": {
+									Type: provider.ResourceDefinitionsSchemaTypeString,
+									Description: "The schedule for the action, an at expression, a rate expression " +
+										"or a cron expression.",
+									FormattedDescription: "The schedule for the action, one of `at(yyyy-mm-ddThh:mm:ss)`, " +
+										"`rate(value unit)` or `cron(fields)`.",
+									MinLength: 1,
+									MaxLength: 1600,
+								},
+								"timezone": {
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									Description: "The time zone used when evaluating the schedule, an IANA time zone name such as Europe/London.",
+								},
+								"startTime": {
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									Description: "The date and time, as an RFC 3339 timestamp, for the recurring schedule to start.",
+								},
+								"endTime": {
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									Description: "The date and time, as an RFC 3339 timestamp, for the recurring schedule to end.",
+								},
+								"minCapacity": {
+									Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+									Description: "The minimum provisioned concurrency to set when the action runs.",
+									Minimum:     core.ScalarFromInt(0),
+								},
+								"maxCapacity": {
+									Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+									Description: "The maximum provisioned concurrency to set when the action runs.",
+									Minimum:     core.ScalarFromInt(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			"deploymentPreference": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "AliasDeploymentPreference",
+				Description: "Configures how traffic is shifted to a new function version when the version " +
+					"that the alias points to is updated. Traffic is shifted in steps by updating the weight " +
+					"of the new version in the routing configuration of the alias, once the new version " +
+					"receives all traffic, the alias is updated to point to the new version.",
+				FormattedDescription: "Configures how traffic is shifted to a new function version when `functionVersion` " +
+					"is updated. Traffic is shifted in steps by updating the weight of the new version in the routing " +
+					"configuration of the alias, once the new version receives all traffic, the alias is updated " +
+					"to point to the new version.\n\n" +
+					"This only applies to updates of an existing alias, a new alias will point to `functionVersion` immediately.",
+				Required: []string{"type"},
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"type": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The traffic shifting strategy to use. Canary strategies shift 10 percent of " +
+							"traffic to the new version and the remaining traffic after the specified interval. " +
+							"Linear strategies shift an additional 10 percent of traffic at each interval. " +
+							"allAtOnce shifts all traffic immediately.",
+						AllowedValues: deploymentPreferenceTypeAllowedValues(),
+					},
+					"alarms": {
+						Type: provider.ResourceDefinitionsSchemaTypeArray,
+						Items: &provider.ResourceDefinitionsSchema{
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+						Description: "A list of CloudWatch alarm names that are checked after each traffic shifting step. " +
+							"If any of the alarms are in the ALARM state, all traffic is routed back to the previous " +
+							"version and the deployment fails.",
+						FormattedDescription: "A list of CloudWatch alarm names that are checked after each traffic shifting step. " +
+							"If any of the alarms are in the `ALARM` state, all traffic is routed back to the previous " +
+							"version and the deployment fails.",
+						MaxLength: 10,
+					},
+				},
+			},
 			// Computed fields returned by AWS
 			"aliasArn": {
 				Type:        provider.ResourceDefinitionsSchemaTypeString,
@@ -2,17 +2,91 @@ package lambda
 
 import (
 	"context"
+	"slices"
 
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 )
 
 func (l *lambdaAliasResourceActions) Stabilised(
 	ctx context.Context,
 	input *provider.ResourceHasStabilisedInput,
 ) (*provider.ResourceHasStabilisedOutput, error) {
-	// Lambda aliases are generally stable immediately after creation/update
-	// since they're just pointers to function versions
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(
+		input.ResourceSpec.Fields["functionName"],
+	)
+	aliasName := core.StringValue(
+		input.ResourceSpec.Fields["name"],
+	)
+
+	// An alias is a pointer to one or more published versions,
+	// the alias is only stable once every version it routes traffic to is.
+	for _, version := range aliasRoutedVersions(input.ResourceSpec) {
+		hasStabilised, err := checkQualifiedFunctionStabilised(
+			ctx,
+			lambdaService,
+			functionName,
+			version,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasStabilised {
+			return &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			}, nil
+		}
+	}
+
+	_, hasProvisionedConcurrency := input.ResourceSpec.Fields["provisionedConcurrencyConfig"]
+	if hasProvisionedConcurrency {
+		hasStabilised, err := checkProvisionedConcurrencyStabilised(
+			ctx,
+			lambdaService,
+			functionName,
+			aliasName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: hasStabilised,
+		}, nil
+	}
+
 	return &provider.ResourceHasStabilisedOutput{
 		Stabilised: true,
 	}, nil
 }
+
+func aliasRoutedVersions(aliasSpec *core.MappingNode) []string {
+	versions := []string{
+		core.StringValue(aliasSpec.Fields["functionVersion"]),
+	}
+
+	additionalVersionWeights, hasAdditionalVersions := pluginutils.GetValueByPath(
+		"$.routingConfig.additionalVersionWeights",
+		aliasSpec,
+	)
+	if !hasAdditionalVersions || additionalVersionWeights == nil {
+		return versions
+	}
+
+	// Sort the additional versions to make the order in which versions
+	// are checked deterministic.
+	additionalVersions := []string{}
+	for version := range additionalVersionWeights.Fields {
+		additionalVersions = append(additionalVersions, version)
+	}
+	slices.Sort(additionalVersions)
+
+	return append(versions, additionalVersions...)
+}
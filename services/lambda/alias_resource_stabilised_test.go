@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
 	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
@@ -32,11 +34,14 @@ func (s *LambdaAliasResourceStabilisedSuite) Test_stabilised_lambda_alias() {
 
 	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
 		stabilisedBasicAliasTestCase(providerCtx, loader),
+		stabilisedAliasAdditionalVersionUpdatingTestCase(providerCtx, loader),
+		stabilisedAliasFailedVersionTestCase(providerCtx, loader),
+		stabilisedAliasProvisionedConcurrencyReadyTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
 		testCases,
-		AliasResource,
+		newTestAliasResource,
 		&s.Suite,
 	)
 }
@@ -45,7 +50,13 @@ func stabilisedBasicAliasTestCase(
 	providerCtx provider.Context,
 	loader *testutils.MockAWSConfigLoader,
 ) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
-	service := lambdamock.CreateLambdaServiceMock()
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State: types.StateActive,
+			},
+		}),
+	)
 
 	resourceSpecState := &core.MappingNode{
 		Fields: map[string]*core.MappingNode{
@@ -77,6 +88,148 @@ func stabilisedBasicAliasTestCase(
 	}
 }
 
+func stabilisedAliasAdditionalVersionUpdatingTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusInProgress,
+			},
+		}),
+	)
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("PROD"),
+			"functionVersion": core.MappingNodeFromString("1"),
+			"routingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"additionalVersionWeights": {
+						Fields: map[string]*core.MappingNode{
+							"2": core.MappingNodeFromFloat(0.1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "alias routing traffic to a version that is still updating is not stabilised",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    resourceSpecState,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		},
+	}
+}
+
+func stabilisedAliasFailedVersionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:           types.StateFailed,
+				StateReasonCode: types.StateReasonCodeSubnetOutOfIPAddresses,
+				StateReason:     aws.String("All subnets are out of IP addresses."),
+			},
+		}),
+	)
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("PROD"),
+			"functionVersion": core.MappingNodeFromString("1"),
+		},
+	}
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "alias pointing to a failed version reports an error",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    resourceSpecState,
+		},
+		ExpectError: true,
+	}
+}
+
+func stabilisedAliasProvisionedConcurrencyReadyTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusSuccessful,
+			},
+		}),
+		lambdamock.WithGetProvisionedConcurrencyOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+			Status: types.ProvisionedConcurrencyStatusEnumReady,
+		}),
+	)
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("PROD"),
+			"functionVersion": core.MappingNodeFromString("1"),
+			"provisionedConcurrencyConfig": {
+				Fields: map[string]*core.MappingNode{
+					"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "alias with provisioned concurrency is stabilised once allocation is ready",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    resourceSpecState,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: true,
+		},
+	}
+}
+
 func TestLambdaAliasResourceStabilised(t *testing.T) {
 	suite.Run(t, new(LambdaAliasResourceStabilisedSuite))
 }
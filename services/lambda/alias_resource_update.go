@@ -22,9 +22,26 @@ func (l *lambdaAliasResourceActions) Update(
 		return nil, err
 	}
 
+	cloudwatchService, err := l.getCloudWatchService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	autoScalingService, err := l.getAutoScalingService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
 	updateOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
+		&aliasTrafficShift{
+			cloudwatchService: cloudwatchService,
+			wait:              l.wait,
+		},
 		&aliasUpdate{},
 		&aliasPutProvisionedConcurrencyConfig{},
+		&aliasAutoScaling{
+			autoScalingService: autoScalingService,
+		},
 	}
 
 	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
@@ -91,6 +108,19 @@ func (u *aliasUpdate) Execute(
 		Data: saveOpCtx.Data,
 	}
 
+	if _, trafficShifted := saveOpCtx.Data["trafficShiftedToVersion"]; trafficShifted {
+		// After traffic shifting, the alias has an additional weight for the version
+		// that is being promoted, leaving the routing config unchanged would cause
+		// the alias update to be rejected as the primary version can not also be
+		// an additional version.
+		if u.input.RoutingConfig == nil {
+			u.input.RoutingConfig = &types.AliasRoutingConfiguration{}
+		}
+		if u.input.RoutingConfig.AdditionalVersionWeights == nil {
+			u.input.RoutingConfig.AdditionalVersionWeights = map[string]float64{}
+		}
+	}
+
 	updateAliasOutput, err := lambdaService.UpdateAlias(ctx, u.input)
 	if err != nil {
 		return saveOpCtx, err
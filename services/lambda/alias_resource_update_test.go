@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	cloudwatchmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/cloudwatch_mock"
 	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
@@ -47,7 +50,7 @@ func (s *LambdaAliasResourceUpdateSuite) Test_update_lambda_alias() {
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		AliasResource,
+		newTestAliasResource,
 		&s.Suite,
 	)
 }
@@ -776,6 +779,276 @@ func recreateAliasOnNameOrFunctionNameChangeTestCase(
 	}
 }
 
+func (s *LambdaAliasResourceUpdateSuite) Test_update_lambda_alias_progressive_deployment() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	healthyTestCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		updateAliasCanaryDeploymentTestCase(providerCtx, loader),
+		updateAliasLinearDeploymentTestCase(providerCtx, loader),
+		updateAliasDeploymentPreferenceWithoutVersionChangeTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		healthyTestCases,
+		newTestAliasResourceWithCloudWatch(
+			cloudwatchmock.CreateCloudWatchServiceMockFactory(
+				cloudwatchmock.WithDescribeAlarmsOutput(&cloudwatch.DescribeAlarmsOutput{}),
+			),
+		),
+		&s.Suite,
+	)
+
+	alarmingTestCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		updateAliasDeploymentRollbackTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		alarmingTestCases,
+		newTestAliasResourceWithCloudWatch(
+			cloudwatchmock.CreateCloudWatchServiceMockFactory(
+				cloudwatchmock.WithDescribeAlarmsOutput(&cloudwatch.DescribeAlarmsOutput{
+					MetricAlarms: []cloudwatchtypes.MetricAlarm{
+						{
+							AlarmName:  aws.String("orders-api-errors"),
+							StateValue: cloudwatchtypes.StateValueAlarm,
+						},
+					},
+				}),
+			),
+		),
+		&s.Suite,
+	)
+}
+
+func updateAliasCanaryDeploymentTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	return createAliasDeploymentTestCase(
+		"update alias version with a canary deployment",
+		providerCtx,
+		loader,
+		"canary10Percent5Minutes",
+		/* expectError */ false,
+		[]any{
+			createTrafficShiftUpdateAliasInput(0.1),
+			createPromoteVersionUpdateAliasInput(),
+		},
+	)
+}
+
+func updateAliasLinearDeploymentTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	expectedUpdateAliasCalls := []any{}
+	for _, weight := range []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9} {
+		expectedUpdateAliasCalls = append(
+			expectedUpdateAliasCalls,
+			createTrafficShiftUpdateAliasInput(weight),
+		)
+	}
+	expectedUpdateAliasCalls = append(
+		expectedUpdateAliasCalls,
+		createPromoteVersionUpdateAliasInput(),
+	)
+
+	return createAliasDeploymentTestCase(
+		"update alias version with a linear deployment",
+		providerCtx,
+		loader,
+		"linear10PercentEvery1Minute",
+		/* expectError */ false,
+		expectedUpdateAliasCalls,
+	)
+}
+
+func updateAliasDeploymentRollbackTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	return createAliasDeploymentTestCase(
+		"update alias version rolls back when a deployment alarm is in the ALARM state",
+		providerCtx,
+		loader,
+		"canary10Percent5Minutes",
+		/* expectError */ true,
+		[]any{
+			createTrafficShiftUpdateAliasInput(0.1),
+			&lambda.UpdateAliasInput{
+				FunctionName:    aws.String("test-function"),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("1"),
+				RoutingConfig: &types.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{},
+				},
+			},
+		},
+	)
+}
+
+func updateAliasDeploymentPreferenceWithoutVersionChangeTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	testCase := createAliasDeploymentTestCase(
+		"update alias with a deployment preference without changing the version",
+		providerCtx,
+		loader,
+		"canary10Percent5Minutes",
+		/* expectError */ false,
+		[]any{
+			&lambda.UpdateAliasInput{
+				FunctionName:    aws.String("test-function"),
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("1"),
+			},
+		},
+	)
+	specData := testCase.Input.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec
+	specData.Fields["functionVersion"] = core.MappingNodeFromString("1")
+	return testCase
+}
+
+func createTrafficShiftUpdateAliasInput(weight float64) *lambda.UpdateAliasInput {
+	return &lambda.UpdateAliasInput{
+		FunctionName:    aws.String("test-function"),
+		Name:            aws.String("live"),
+		FunctionVersion: aws.String("1"),
+		RoutingConfig: &types.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{
+				"2": weight,
+			},
+		},
+	}
+}
+
+// createPromoteVersionUpdateAliasInput creates the input for the alias update
+// that follows traffic shifting, the additional weight for the new version
+// must be cleared when it becomes the primary version of the alias.
+func createPromoteVersionUpdateAliasInput() *lambda.UpdateAliasInput {
+	return &lambda.UpdateAliasInput{
+		FunctionName:    aws.String("test-function"),
+		Name:            aws.String("live"),
+		FunctionVersion: aws.String("2"),
+		RoutingConfig: &types.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{},
+		},
+	}
+}
+
+func createAliasDeploymentTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	deploymentPreferenceType string,
+	expectError bool,
+	expectedUpdateAliasCalls []any,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	aliasArn := "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateAliasOutput(&lambda.UpdateAliasOutput{
+			AliasArn:        aws.String(aliasArn),
+			Name:            aws.String("live"),
+			FunctionVersion: aws.String("2"),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("2"),
+			"deploymentPreference": {
+				Fields: map[string]*core.MappingNode{
+					"type": core.MappingNodeFromString(deploymentPreferenceType),
+					"alarms": {
+						Items: []*core.MappingNode{
+							core.MappingNodeFromString("orders-api-errors"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":    core.MappingNodeFromString("test-function"),
+			"name":            core.MappingNodeFromString("live"),
+			"functionVersion": core.MappingNodeFromString("1"),
+			"aliasArn":        core.MappingNodeFromString(aliasArn),
+		},
+	}
+
+	testCase := plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-alias-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-alias-id",
+					ResourceName: "TestAlias",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-alias-id",
+						Name:       "TestAlias",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/alias",
+						},
+						Spec: specData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionVersion",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: expectError,
+		SaveActionsCalled: map[string]any{
+			"UpdateAlias": expectedUpdateAliasCalls,
+		},
+	}
+
+	if !expectError {
+		testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.aliasArn": core.MappingNodeFromString(aliasArn),
+			},
+		}
+	}
+
+	return testCase
+}
+
 func TestLambdaAliasResourceUpdate(t *testing.T) {
 	suite.Run(t, new(LambdaAliasResourceUpdateSuite))
 }
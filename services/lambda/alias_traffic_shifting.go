@@ -0,0 +1,282 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// trafficShiftingStrategy describes the weights of traffic that are routed
+// to a new function version in each step of a progressive deployment
+// and the time to wait after each step before moving on to the next one.
+type trafficShiftingStrategy struct {
+	weights  []float64
+	interval time.Duration
+}
+
+const allAtOnceDeploymentPreference = "allAtOnce"
+
+var trafficShiftingStrategies = map[string]trafficShiftingStrategy{
+	"canary10Percent5Minutes":       canaryStrategy(0.1, 5*time.Minute),
+	"canary10Percent10Minutes":      canaryStrategy(0.1, 10*time.Minute),
+	"canary10Percent15Minutes":      canaryStrategy(0.1, 15*time.Minute),
+	"canary10Percent30Minutes":      canaryStrategy(0.1, 30*time.Minute),
+	"linear10PercentEvery1Minute":   linearStrategy(0.1, 1*time.Minute),
+	"linear10PercentEvery2Minutes":  linearStrategy(0.1, 2*time.Minute),
+	"linear10PercentEvery3Minutes":  linearStrategy(0.1, 3*time.Minute),
+	"linear10PercentEvery10Minutes": linearStrategy(0.1, 10*time.Minute),
+	allAtOnceDeploymentPreference:   {},
+}
+
+func canaryStrategy(weight float64, interval time.Duration) trafficShiftingStrategy {
+	return trafficShiftingStrategy{
+		weights:  []float64{weight},
+		interval: interval,
+	}
+}
+
+func linearStrategy(increment float64, interval time.Duration) trafficShiftingStrategy {
+	weights := []float64{}
+	// Integer steps are used to avoid floating point accumulation errors,
+	// e.g. 0.1 * 3 should be 0.3 and not 0.30000000000000004.
+	steps := int(1 / increment)
+	for step := 1; step < steps; step += 1 {
+		weights = append(weights, float64(step)/float64(steps))
+	}
+	return trafficShiftingStrategy{
+		weights:  weights,
+		interval: interval,
+	}
+}
+
+func deploymentPreferenceTypeAllowedValues() []*core.MappingNode {
+	strategyTypes := []string{}
+	for strategyType := range trafficShiftingStrategies {
+		strategyTypes = append(strategyTypes, strategyType)
+	}
+	slices.Sort(strategyTypes)
+
+	allowedValues := []*core.MappingNode{}
+	for _, strategyType := range strategyTypes {
+		allowedValues = append(allowedValues, core.MappingNodeFromString(strategyType))
+	}
+	return allowedValues
+}
+
+// waitFunc waits for the provided duration,
+// returning early with an error if the context is cancelled.
+type waitFunc func(ctx context.Context, duration time.Duration) error
+
+func waitForDuration(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// aliasTrafficShift progressively shifts traffic from the version that an alias
+// currently points to, to the new version in the alias spec.
+// This runs before the alias update, which points the alias to the new version
+// once all steps of the deployment have completed.
+type aliasTrafficShift struct {
+	cloudwatchService cloudwatchservice.Service
+	wait              waitFunc
+	functionName      string
+	aliasName         string
+	fromVersion       string
+	toVersion         string
+	strategy          trafficShiftingStrategy
+	alarms            []string
+}
+
+func (u *aliasTrafficShift) Name() string {
+	return "alias traffic shifting"
+}
+
+func (u *aliasTrafficShift) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	preferenceType, hasPreference := pluginutils.GetValueByPath(
+		"$.deploymentPreference.type",
+		specData,
+	)
+	if !hasPreference {
+		return false, saveOpCtx, nil
+	}
+
+	strategy, ok := trafficShiftingStrategies[core.StringValue(preferenceType)]
+	if !ok {
+		return false, saveOpCtx, fmt.Errorf(
+			"unsupported deployment preference type %q",
+			core.StringValue(preferenceType),
+		)
+	}
+
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	fromVersion, hasFromVersion := pluginutils.GetValueByPath(
+		"$.functionVersion",
+		currentStateSpecData,
+	)
+	toVersion, _ := pluginutils.GetValueByPath("$.functionVersion", specData)
+	if !hasFromVersion ||
+		core.StringValue(fromVersion) == core.StringValue(toVersion) ||
+		len(strategy.weights) == 0 {
+		return false, saveOpCtx, nil
+	}
+
+	functionName, _ := pluginutils.GetValueByPath("$.functionName", specData)
+	aliasName, _ := pluginutils.GetValueByPath("$.name", specData)
+	u.functionName = core.StringValue(functionName)
+	u.aliasName = core.StringValue(aliasName)
+	u.fromVersion = core.StringValue(fromVersion)
+	u.toVersion = core.StringValue(toVersion)
+	u.strategy = strategy
+
+	u.alarms = []string{}
+	alarms, _ := pluginutils.GetValueByPath("$.deploymentPreference.alarms", specData)
+	for _, alarm := range getItems(alarms) {
+		u.alarms = append(u.alarms, core.StringValue(alarm))
+	}
+
+	return true, saveOpCtx, nil
+}
+
+func (u *aliasTrafficShift) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService lambdaservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	for _, weight := range u.strategy.weights {
+		_, err := lambdaService.UpdateAlias(ctx, u.routeTraffic(weight))
+		if err != nil {
+			return saveOpCtx, err
+		}
+
+		err = u.wait(ctx, u.strategy.interval)
+		if err != nil {
+			return saveOpCtx, u.rollback(ctx, lambdaService, err)
+		}
+
+		alarmsFiring, err := u.alarmsInAlarmState(ctx)
+		if err != nil {
+			return saveOpCtx, u.rollback(ctx, lambdaService, err)
+		}
+
+		if len(alarmsFiring) > 0 {
+			return saveOpCtx, u.rollback(
+				ctx,
+				lambdaService,
+				fmt.Errorf(
+					"deployment of version %s to alias %q was rolled back "+
+						"as the following alarms are in the ALARM state: %s",
+					u.toVersion,
+					u.aliasName,
+					strings.Join(alarmsFiring, ", "),
+				),
+			)
+		}
+	}
+
+	// The alias update that follows promotes the new version to be the primary
+	// version of the alias, the additional weight for the new version
+	// must be removed as part of that update.
+	saveOpCtx.Data["trafficShiftedToVersion"] = u.toVersion
+	return saveOpCtx, nil
+}
+
+func (u *aliasTrafficShift) routeTraffic(weight float64) *lambda.UpdateAliasInput {
+	return &lambda.UpdateAliasInput{
+		FunctionName:    aws.String(u.functionName),
+		Name:            aws.String(u.aliasName),
+		FunctionVersion: aws.String(u.fromVersion),
+		RoutingConfig: &types.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{
+				u.toVersion: weight,
+			},
+		},
+	}
+}
+
+// rollback routes all traffic back to the version that the alias pointed to
+// before the deployment started.
+func (u *aliasTrafficShift) rollback(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	cause error,
+) error {
+	// The rollback should still be attempted when the deployment
+	// was stopped due to the context being cancelled.
+	_, err := lambdaService.UpdateAlias(
+		context.WithoutCancel(ctx),
+		&lambda.UpdateAliasInput{
+			FunctionName:    aws.String(u.functionName),
+			Name:            aws.String(u.aliasName),
+			FunctionVersion: aws.String(u.fromVersion),
+			RoutingConfig: &types.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]float64{},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"%w, failed to roll back alias %q to version %s: %w",
+			cause,
+			u.aliasName,
+			u.fromVersion,
+			err,
+		)
+	}
+
+	return cause
+}
+
+func (u *aliasTrafficShift) alarmsInAlarmState(ctx context.Context) ([]string, error) {
+	if len(u.alarms) == 0 {
+		return nil, nil
+	}
+
+	output, err := u.cloudwatchService.DescribeAlarms(
+		ctx,
+		&cloudwatch.DescribeAlarmsInput{
+			AlarmNames: u.alarms,
+			AlarmTypes: []cloudwatchtypes.AlarmType{
+				cloudwatchtypes.AlarmTypeMetricAlarm,
+				cloudwatchtypes.AlarmTypeCompositeAlarm,
+			},
+			StateValue: cloudwatchtypes.StateValueAlarm,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the state of deployment alarms: %w", err)
+	}
+
+	alarmsFiring := []string{}
+	for _, alarm := range output.MetricAlarms {
+		alarmsFiring = append(alarmsFiring, aws.ToString(alarm.AlarmName))
+	}
+	for _, alarm := range output.CompositeAlarms {
+		alarmsFiring = append(alarmsFiring, aws.ToString(alarm.AlarmName))
+	}
+
+	return alarmsFiring, nil
+}
@@ -0,0 +1,176 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// packagedCode holds the location of a deployment package that has been
+// built by the provider from a local code source.
+// Exactly one of zipFile or the s3Bucket/s3Key pair will be set.
+type packagedCode struct {
+	zipFile  []byte
+	s3Bucket string
+	s3Key    string
+	// sha256 is the base64-encoded SHA-256 hash of the deployment package,
+	// this matches the CodeSha256 value reported by Lambda for the package.
+	sha256 string
+}
+
+// packageCodeSource builds a deterministic zip archive from a local code source.
+// Archives that exceed the size limit for direct uploads to Lambda
+// are staged in the S3 bucket configured for the source.
+// An archive that matches the deployed code is not uploaded again,
+// the S3 key is derived from the content hash so the archive is already stored
+// under the same key from when the code was deployed.
+func packageCodeSource(
+	ctx context.Context,
+	sourceData *core.MappingNode,
+	deployedSHA256 string,
+	s3Service s3service.Service,
+) (*packagedCode, error) {
+	archive, err := utils.ZipFromSource(codeSourceToZipSource(sourceData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to package code source: %w", err)
+	}
+
+	if len(archive.Content) <= utils.MaxDirectUploadZipFileSize {
+		return &packagedCode{
+			zipFile: archive.Content,
+			sha256:  archive.SHA256,
+		}, nil
+	}
+
+	s3Bucket, hasS3Bucket := pluginutils.GetValueByPath("$.s3Bucket", sourceData)
+	if !hasS3Bucket {
+		return nil, fmt.Errorf(
+			"the packaged code archive is %d bytes which exceeds the %d MB limit for "+
+				"direct uploads, an s3Bucket must be set for the code source "+
+				"so the archive can be uploaded to Amazon S3",
+			len(archive.Content),
+			utils.MaxDirectUploadZipFileSize/(1024*1024),
+		)
+	}
+
+	s3Key, err := codeSourceS3Key(sourceData, archive.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	if archive.SHA256 != deployedSHA256 {
+		_, err = s3Service.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:         aws.String(core.StringValue(s3Bucket)),
+			Key:            aws.String(s3Key),
+			Body:           bytes.NewReader(archive.Content),
+			ChecksumSHA256: aws.String(archive.SHA256),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload packaged code archive to Amazon S3: %w", err)
+		}
+	}
+
+	return &packagedCode{
+		s3Bucket: core.StringValue(s3Bucket),
+		s3Key:    s3Key,
+		sha256:   archive.SHA256,
+	}, nil
+}
+
+// codeSourceMatchesDeployedCode determines whether packaging a local code source
+// produces the same archive as the deployed code.
+// Changes to the files in a code source are not visible in the resource spec,
+// so this is used to report a code source that no longer matches the deployed code as drift.
+// When the source can not be packaged, for example when drift is checked from an
+// environment without the build output, the source is assumed to match the deployed code.
+func codeSourceMatchesDeployedCode(sourceData *core.MappingNode, deployedSHA256 string) bool {
+	archive, err := utils.ZipFromSource(codeSourceToZipSource(sourceData))
+	if err != nil {
+		return true
+	}
+
+	return archive.SHA256 == deployedSHA256
+}
+
+func codeSourceToZipSource(sourceData *core.MappingNode) *utils.ZipSource {
+	zipSource := &utils.ZipSource{}
+	if path, ok := pluginutils.GetValueByPath("$.path", sourceData); ok {
+		zipSource.Path = core.StringValue(path)
+	}
+	if include, ok := pluginutils.GetValueByPath("$.include", sourceData); ok {
+		zipSource.Include = core.StringSliceValue(include)
+	}
+	if exclude, ok := pluginutils.GetValueByPath("$.exclude", sourceData); ok {
+		zipSource.Exclude = core.StringSliceValue(exclude)
+	}
+	return zipSource
+}
+
+// codeSourceS3Key derives the S3 object key for a packaged archive
+// from its content hash so that unchanged archives are always
+// stored under the same key.
+func codeSourceS3Key(sourceData *core.MappingNode, archiveSHA256 string) (string, error) {
+	hash, err := base64.StdEncoding.DecodeString(archiveSHA256)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := ""
+	if s3KeyPrefix, ok := pluginutils.GetValueByPath("$.s3KeyPrefix", sourceData); ok {
+		prefix = core.StringValue(s3KeyPrefix)
+	}
+
+	return fmt.Sprintf("%s%s.zip", prefix, hex.EncodeToString(hash)), nil
+}
+
+func getPackagedCode(saveOpCtx pluginutils.SaveOperationContext) (*packagedCode, bool) {
+	packaged, ok := saveOpCtx.Data["packagedCode"].(*packagedCode)
+	return packaged, ok && packaged != nil
+}
+
+func setFunctionCodeFromPackagedCode(code *types.FunctionCode, packaged *packagedCode) {
+	if packaged.zipFile != nil {
+		code.ZipFile = packaged.zipFile
+		return
+	}
+
+	code.S3Bucket = aws.String(packaged.s3Bucket)
+	code.S3Key = aws.String(packaged.s3Key)
+}
+
+func setUpdateFunctionCodeFromPackagedCode(
+	input *lambda.UpdateFunctionCodeInput,
+	packaged *packagedCode,
+) {
+	if packaged.zipFile != nil {
+		input.ZipFile = packaged.zipFile
+		return
+	}
+
+	input.S3Bucket = aws.String(packaged.s3Bucket)
+	input.S3Key = aws.String(packaged.s3Key)
+}
+
+func setLayerContentFromPackagedCode(
+	content *types.LayerVersionContentInput,
+	packaged *packagedCode,
+) {
+	if packaged.zipFile != nil {
+		content.ZipFile = packaged.zipFile
+		return
+	}
+
+	content.S3Bucket = aws.String(packaged.s3Bucket)
+	content.S3Key = aws.String(packaged.s3Key)
+}
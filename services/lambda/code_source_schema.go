@@ -0,0 +1,78 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// lambdaCodeSourceSchema provides the schema for a local code source
+// that is packaged by the provider, this is shared between functions
+// and layer versions.
+func lambdaCodeSourceSchema(mustRecreate bool) *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:  provider.ResourceDefinitionsSchemaTypeObject,
+		Label: "CodeSource",
+		Description: "A local directory or glob pattern for files that will be packaged into a zip archive " +
+			"by the provider. The archive is built deterministically with sorted entries and fixed timestamps " +
+			"so that the same set of files always produces the same archive and SHA-256 hash. " +
+			"Archives larger than 50MB are uploaded to the configured S3 bucket before being deployed. " +
+			"Changes to the files are reported as drift when the archive no longer matches the deployed code. " +
+			"This can not be used together with the other code location fields.",
+		FormattedDescription: "A local directory or glob pattern for files that will be packaged into a zip archive " +
+			"by the provider. The archive is built deterministically with sorted entries and fixed timestamps " +
+			"so that the same set of files always produces the same archive and SHA-256 hash. " +
+			"Archives larger than 50MB are uploaded to the configured `s3Bucket` before being deployed. " +
+			"Changes to the files are reported as drift when the archive no longer matches the deployed code. " +
+			"This can not be used together with the other code location fields.",
+		Required:     []string{"path"},
+		MustRecreate: mustRecreate,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"path": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The path to a local directory, file or a glob pattern such as \"dist/**/*.js\". " +
+					"When a glob pattern is used, the directory before the first glob segment " +
+					"is used as the root of the archive.",
+				FormattedDescription: "The path to a local directory, file or a glob pattern such as `dist/**/*.js`. " +
+					"When a glob pattern is used, the directory before the first glob segment " +
+					"is used as the root of the archive.",
+				MinLength:    1,
+				MustRecreate: mustRecreate,
+			},
+			"include": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "Glob patterns relative to the root of the archive for files to include. " +
+					"A \"**\" segment matches zero or more directories. When omitted, all files are included.",
+				FormattedDescription: "Glob patterns relative to the root of the archive for files to include. " +
+					"A `**` segment matches zero or more directories. When omitted, all files are included.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+				},
+				MustRecreate: mustRecreate,
+			},
+			"exclude": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "Glob patterns relative to the root of the archive for files to exclude. " +
+					"Exclude patterns take precedence over include patterns.",
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+				},
+				MustRecreate: mustRecreate,
+			},
+			"s3Bucket": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "An Amazon S3 bucket in the same AWS Region that packaged archives larger than " +
+					"the 50MB direct upload limit will be uploaded to.",
+				Pattern:      "^[0-9A-Za-z\\-_][0-9A-Za-z\\.\\-_]+$",
+				MinLength:    3,
+				MaxLength:    63,
+				MustRecreate: mustRecreate,
+			},
+			"s3KeyPrefix": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "A prefix for the S3 key of uploaded archives. " +
+					"The key is made up of the prefix followed by the hex-encoded SHA-256 hash of the archive.",
+				MaxLength:    512,
+				MustRecreate: mustRecreate,
+			},
+		},
+	}
+}
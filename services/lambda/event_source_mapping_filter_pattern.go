@@ -0,0 +1,487 @@
+package lambda
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// maxEventSourceMappingFilters is the default quota for the number of
+// filters that can be defined for an event source mapping.
+const maxEventSourceMappingFilters = 5
+
+// filterPattern is a parsed Lambda event filter pattern,
+// see: https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html#filtering-syntax
+//
+// Lambda event filtering uses a subset of the EventBridge pattern syntax,
+// a field is either a nested object of fields or a list of values and operators
+// where the field matches when any of the values or operators match.
+type filterPattern struct {
+	fields map[string]*filterPatternNode
+}
+
+type filterPatternNode struct {
+	// fields holds the nested fields when the node is an object.
+	fields map[string]*filterPatternNode
+	// matchers holds the values and operators when the node is
+	// a list of values to match.
+	matchers []filterPatternMatcher
+}
+
+type filterPatternMatcher interface {
+	matches(value any, exists bool) bool
+}
+
+// filterPatternError is returned when a filter pattern is not valid,
+// the path is the location of the invalid field in the pattern.
+type filterPatternError struct {
+	path    string
+	message string
+}
+
+func (e *filterPatternError) Error() string {
+	if e.path == "" {
+		return e.message
+	}
+	return fmt.Sprintf("%s: %s", e.path, e.message)
+}
+
+// parseFilterPattern parses and validates a Lambda event filter pattern.
+func parseFilterPattern(pattern string) (*filterPattern, error) {
+	decoder := json.NewDecoder(strings.NewReader(pattern))
+	var decoded any
+	err := decoder.Decode(&decoded)
+	if err != nil {
+		return nil, &filterPatternError{
+			message: fmt.Sprintf("the filter pattern is not valid JSON: %s", err),
+		}
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, &filterPatternError{
+			message: "the filter pattern must contain a single JSON object",
+		}
+	}
+
+	patternObject, isObject := decoded.(map[string]any)
+	if !isObject {
+		return nil, &filterPatternError{
+			message: "the filter pattern must be a JSON object",
+		}
+	}
+
+	fields, err := parseFilterPatternFields(patternObject, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &filterPattern{fields: fields}, nil
+}
+
+func parseFilterPatternFields(
+	patternObject map[string]any,
+	path string,
+) (map[string]*filterPatternNode, error) {
+	if len(patternObject) == 0 {
+		return nil, &filterPatternError{
+			path:    path,
+			message: "an object in a filter pattern must contain at least one field",
+		}
+	}
+
+	fields := make(map[string]*filterPatternNode, len(patternObject))
+	for key, value := range patternObject {
+		node, err := parseFilterPatternNode(value, joinFilterPatternPath(path, key))
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = node
+	}
+
+	return fields, nil
+}
+
+func parseFilterPatternNode(value any, path string) (*filterPatternNode, error) {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		fields, err := parseFilterPatternFields(typedValue, path)
+		if err != nil {
+			return nil, err
+		}
+		return &filterPatternNode{fields: fields}, nil
+	case []any:
+		if len(typedValue) == 0 {
+			return nil, &filterPatternError{
+				path:    path,
+				message: "the list of values to match must not be empty",
+			}
+		}
+		matchers := make([]filterPatternMatcher, 0, len(typedValue))
+		for i, item := range typedValue {
+			matcher, err := parseFilterPatternMatcher(item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, matcher)
+		}
+		return &filterPatternNode{matchers: matchers}, nil
+	default:
+		return nil, &filterPatternError{
+			path: path,
+			message: "the value of a field must be an object or a list of values to match, " +
+				"for example [\"value\"]",
+		}
+	}
+}
+
+func parseFilterPatternMatcher(value any, path string) (filterPatternMatcher, error) {
+	switch typedValue := value.(type) {
+	case nil, string, float64, bool:
+		return &exactMatcher{value: typedValue}, nil
+	case map[string]any:
+		return parseFilterPatternOperator(typedValue, path)
+	default:
+		return nil, &filterPatternError{
+			path:    path,
+			message: "a value to match must be a string, number, boolean, null or an operator object",
+		}
+	}
+}
+
+func parseFilterPatternOperator(
+	operatorObject map[string]any,
+	path string,
+) (filterPatternMatcher, error) {
+	if len(operatorObject) != 1 {
+		return nil, &filterPatternError{
+			path:    path,
+			message: "an operator object must contain exactly one operator",
+		}
+	}
+
+	for operator, operand := range operatorObject {
+		operatorPath := joinFilterPatternPath(path, operator)
+		switch operator {
+		case "prefix", "suffix", "equals-ignore-case":
+			operandString, isString := operand.(string)
+			if !isString {
+				return nil, &filterPatternError{
+					path:    operatorPath,
+					message: fmt.Sprintf("the %q operator must be a string", operator),
+				}
+			}
+			return &stringOperatorMatcher{operator: operator, operand: operandString}, nil
+		case "exists":
+			operandBool, isBool := operand.(bool)
+			if !isBool {
+				return nil, &filterPatternError{
+					path:    operatorPath,
+					message: "the \"exists\" operator must be true or false",
+				}
+			}
+			return &existsMatcher{exists: operandBool}, nil
+		case "anything-but":
+			return parseAnythingButOperator(operand, operatorPath)
+		case "numeric":
+			return parseNumericOperator(operand, operatorPath)
+		default:
+			return nil, &filterPatternError{
+				path:    operatorPath,
+				message: fmt.Sprintf("the %q operator is not supported in Lambda event filter patterns", operator),
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func parseAnythingButOperator(operand any, path string) (filterPatternMatcher, error) {
+	values, isList := operand.([]any)
+	if !isList {
+		values = []any{operand}
+	}
+
+	if len(values) == 0 {
+		return nil, &filterPatternError{
+			path:    path,
+			message: "the \"anything-but\" operator must contain at least one value",
+		}
+	}
+
+	for _, value := range values {
+		switch value.(type) {
+		case string, float64:
+		default:
+			return nil, &filterPatternError{
+				path:    path,
+				message: "the \"anything-but\" operator only supports strings and numbers",
+			}
+		}
+	}
+
+	return &anythingButMatcher{values: values}, nil
+}
+
+func parseNumericOperator(operand any, path string) (filterPatternMatcher, error) {
+	items, isList := operand.([]any)
+	if !isList || (len(items) != 2 && len(items) != 4) {
+		return nil, &filterPatternError{
+			path: path,
+			message: "the \"numeric\" operator must be a list of one or two comparisons, " +
+				"for example [\">\", 0, \"<=\", 10]",
+		}
+	}
+
+	matcher := &numericMatcher{}
+	for i := 0; i < len(items); i += 2 {
+		comparison, isString := items[i].(string)
+		if !isString || !slices.Contains(numericComparisons, comparison) {
+			return nil, &filterPatternError{
+				path: fmt.Sprintf("%s[%d]", path, i),
+				message: fmt.Sprintf(
+					"the \"numeric\" operator comparison must be one of %s",
+					strings.Join(numericComparisons, ", "),
+				),
+			}
+		}
+		value, isNumber := items[i+1].(float64)
+		if !isNumber {
+			return nil, &filterPatternError{
+				path:    fmt.Sprintf("%s[%d]", path, i+1),
+				message: fmt.Sprintf("the value to compare with %q must be a number", comparison),
+			}
+		}
+		matcher.conditions = append(
+			matcher.conditions,
+			numericCondition{comparison: comparison, value: value},
+		)
+	}
+
+	if len(matcher.conditions) == 2 && !matcher.isRange() {
+		return nil, &filterPatternError{
+			path: path,
+			message: "two \"numeric\" comparisons must define a range with a lower bound " +
+				"(\">\" or \">=\") followed by a greater upper bound (\"<\" or \"<=\")",
+		}
+	}
+
+	return matcher, nil
+}
+
+func joinFilterPatternPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+// matches determines whether the provided event matches the filter pattern.
+// The event is expected to be decoded from JSON.
+func (p *filterPattern) matches(event any) bool {
+	eventObject, _ := event.(map[string]any)
+	return matchFilterPatternFields(p.fields, eventObject)
+}
+
+func matchFilterPatternFields(
+	fields map[string]*filterPatternNode,
+	eventObject map[string]any,
+) bool {
+	for key, node := range fields {
+		value, exists := eventObject[key]
+		if !node.matches(value, exists) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *filterPatternNode) matches(value any, exists bool) bool {
+	if n.fields != nil {
+		return n.matchesFields(value)
+	}
+
+	// A list in the event matches when any of the items in the list
+	// match the values in the pattern.
+	values := []any{value}
+	if list, isList := value.([]any); isList {
+		values = list
+	}
+
+	for _, matcher := range n.matchers {
+		if _, isExistsMatcher := matcher.(*existsMatcher); isExistsMatcher {
+			if matcher.matches(value, exists) {
+				return true
+			}
+			continue
+		}
+
+		for _, item := range values {
+			if matcher.matches(item, exists) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (n *filterPatternNode) matchesFields(value any) bool {
+	if list, isList := value.([]any); isList {
+		for _, item := range list {
+			itemObject, isObject := item.(map[string]any)
+			if isObject && matchFilterPatternFields(n.fields, itemObject) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// When the value is missing or is not an object, the nested fields
+	// are treated as missing so only "exists": false can match.
+	valueObject, _ := value.(map[string]any)
+	return matchFilterPatternFields(n.fields, valueObject)
+}
+
+type exactMatcher struct {
+	value any
+}
+
+func (m *exactMatcher) matches(value any, exists bool) bool {
+	return exists && value == m.value
+}
+
+type stringOperatorMatcher struct {
+	operator string
+	operand  string
+}
+
+func (m *stringOperatorMatcher) matches(value any, exists bool) bool {
+	valueString, isString := value.(string)
+	if !exists || !isString {
+		return false
+	}
+
+	switch m.operator {
+	case "prefix":
+		return strings.HasPrefix(valueString, m.operand)
+	case "suffix":
+		return strings.HasSuffix(valueString, m.operand)
+	default:
+		return strings.EqualFold(valueString, m.operand)
+	}
+}
+
+type existsMatcher struct {
+	exists bool
+}
+
+func (m *existsMatcher) matches(value any, exists bool) bool {
+	return exists == m.exists
+}
+
+type anythingButMatcher struct {
+	values []any
+}
+
+func (m *anythingButMatcher) matches(value any, exists bool) bool {
+	switch value.(type) {
+	case string, float64:
+		return exists && !slices.Contains(m.values, value)
+	default:
+		return false
+	}
+}
+
+var numericComparisons = []string{"=", "<", "<=", ">", ">="}
+
+type numericCondition struct {
+	comparison string
+	value      float64
+}
+
+type numericMatcher struct {
+	conditions []numericCondition
+}
+
+func (m *numericMatcher) isRange() bool {
+	lower := m.conditions[0]
+	upper := m.conditions[1]
+	return (lower.comparison == ">" || lower.comparison == ">=") &&
+		(upper.comparison == "<" || upper.comparison == "<=") &&
+		lower.value < upper.value
+}
+
+func (m *numericMatcher) matches(value any, exists bool) bool {
+	number, isNumber := value.(float64)
+	if !exists || !isNumber {
+		return false
+	}
+
+	for _, condition := range m.conditions {
+		if !condition.matches(number) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c numericCondition) matches(number float64) bool {
+	switch c.comparison {
+	case "=":
+		return number == c.value
+	case "<":
+		return number < c.value
+	case "<=":
+		return number <= c.value
+	case ">":
+		return number > c.value
+	default:
+		return number >= c.value
+	}
+}
+
+// decodeFilterEventPayloads prepares an event record for filtering in the same way
+// as Lambda, the SQS message body and the base64 encoded Kinesis record data
+// are decoded so that their fields can be matched when they contain JSON.
+func decodeFilterEventPayloads(event any) any {
+	eventObject, isObject := event.(map[string]any)
+	if !isObject {
+		return event
+	}
+
+	decoded := make(map[string]any, len(eventObject))
+	for key, value := range eventObject {
+		decoded[key] = value
+	}
+
+	if body, isString := eventObject["body"].(string); isString {
+		if payload, isJSON := decodeJSONObject([]byte(body)); isJSON {
+			decoded["body"] = payload
+		}
+	}
+
+	if data, isString := eventObject["data"].(string); isString {
+		rawData, err := base64.StdEncoding.DecodeString(data)
+		if err == nil {
+			if payload, isJSON := decodeJSONObject(rawData); isJSON {
+				decoded["data"] = payload
+			}
+		}
+	}
+
+	return decoded
+}
+
+func decodeJSONObject(data []byte) (map[string]any, bool) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, false
+	}
+
+	var payload map[string]any
+	err := json.Unmarshal(trimmed, &payload)
+	return payload, err == nil
+}
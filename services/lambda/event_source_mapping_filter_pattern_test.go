@@ -0,0 +1,237 @@
+package lambda
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterPatternSuite struct {
+	suite.Suite
+}
+
+func (s *FilterPatternSuite) Test_parse_reports_invalid_patterns() {
+	testCases := []struct {
+		name            string
+		pattern         string
+		expectedMessage string
+	}{
+		{
+			name:            "invalid JSON",
+			pattern:         `{"body": ["active"]`,
+			expectedMessage: "the filter pattern is not valid JSON: unexpected EOF",
+		},
+		{
+			name:            "not an object",
+			pattern:         `["active"]`,
+			expectedMessage: "the filter pattern must be a JSON object",
+		},
+		{
+			name:            "trailing content",
+			pattern:         `{"body": ["active"]} {}`,
+			expectedMessage: "the filter pattern must contain a single JSON object",
+		},
+		{
+			name:            "scalar field value",
+			pattern:         `{"body": {"status": "active"}}`,
+			expectedMessage: "body.status: the value of a field must be an object or a list of values to match, for example [\"value\"]",
+		},
+		{
+			name:            "empty list of values",
+			pattern:         `{"body": {"status": []}}`,
+			expectedMessage: "body.status: the list of values to match must not be empty",
+		},
+		{
+			name:            "empty object",
+			pattern:         `{"body": {}}`,
+			expectedMessage: "body: an object in a filter pattern must contain at least one field",
+		},
+		{
+			name:            "unsupported operator",
+			pattern:         `{"body": {"ip": [{"cidr": "10.0.0.0/24"}]}}`,
+			expectedMessage: "body.ip[0].cidr: the \"cidr\" operator is not supported in Lambda event filter patterns",
+		},
+		{
+			name:            "prefix operator that is not a string",
+			pattern:         `{"body": {"id": [{"prefix": 10}]}}`,
+			expectedMessage: "body.id[0].prefix: the \"prefix\" operator must be a string",
+		},
+		{
+			name:            "numeric operator with an invalid comparison",
+			pattern:         `{"body": {"price": [{"numeric": ["!=", 10]}]}}`,
+			expectedMessage: "body.price[0].numeric[0]: the \"numeric\" operator comparison must be one of =, <, <=, >, >=",
+		},
+		{
+			name:            "numeric operator with an invalid range",
+			pattern:         `{"body": {"price": [{"numeric": ["<", 10, ">", 0]}]}}`,
+			expectedMessage: "body.price[0].numeric: two \"numeric\" comparisons must define a range with a lower bound (\">\" or \">=\") followed by a greater upper bound (\"<\" or \"<=\")",
+		},
+		{
+			name:            "anything-but operator with a boolean",
+			pattern:         `{"body": {"enabled": [{"anything-but": [true]}]}}`,
+			expectedMessage: "body.enabled[0].anything-but: the \"anything-but\" operator only supports strings and numbers",
+		},
+		{
+			name:            "operator object with multiple operators",
+			pattern:         `{"body": {"id": [{"prefix": "a", "suffix": "b"}]}}`,
+			expectedMessage: "body.id[0]: an operator object must contain exactly one operator",
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := parseFilterPattern(testCase.pattern)
+			s.Require().Error(err)
+			s.Equal(testCase.expectedMessage, err.Error())
+		})
+	}
+}
+
+func (s *FilterPatternSuite) Test_matches_events() {
+	testCases := []struct {
+		name          string
+		pattern       string
+		event         string
+		expectMatches bool
+	}{
+		{
+			name:          "exact string value",
+			pattern:       `{"body": {"status": ["active"]}}`,
+			event:         `{"body": {"status": "active"}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "any of multiple values",
+			pattern:       `{"eventName": ["INSERT", "MODIFY"]}`,
+			event:         `{"eventName": "REMOVE"}`,
+			expectMatches: false,
+		},
+		{
+			name:          "all fields must match",
+			pattern:       `{"body": {"status": ["active"], "type": ["order"]}}`,
+			event:         `{"body": {"status": "active", "type": "refund"}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "null value",
+			pattern:       `{"body": {"deletedAt": [null]}}`,
+			event:         `{"body": {"deletedAt": null}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "null value does not match a missing field",
+			pattern:       `{"body": {"deletedAt": [null]}}`,
+			event:         `{"body": {}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "prefix",
+			pattern:       `{"partitionKey": [{"prefix": "orders-"}]}`,
+			event:         `{"partitionKey": "orders-123"}`,
+			expectMatches: true,
+		},
+		{
+			name:          "suffix",
+			pattern:       `{"body": {"file": [{"suffix": ".png"}]}}`,
+			event:         `{"body": {"file": "image.jpg"}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "equals ignore case",
+			pattern:       `{"body": {"region": [{"equals-ignore-case": "EU"}]}}`,
+			event:         `{"body": {"region": "eu"}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "anything but",
+			pattern:       `{"body": {"status": [{"anything-but": ["deleted", "archived"]}]}}`,
+			event:         `{"body": {"status": "archived"}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "anything but does not match a missing field",
+			pattern:       `{"body": {"status": [{"anything-but": "deleted"}]}}`,
+			event:         `{"body": {}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "numeric range",
+			pattern:       `{"body": {"price": [{"numeric": [">", 10, "<=", 20]}]}}`,
+			event:         `{"body": {"price": 20}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "numeric value outside of the range",
+			pattern:       `{"body": {"price": [{"numeric": [">", 10, "<=", 20]}]}}`,
+			event:         `{"body": {"price": 10}}`,
+			expectMatches: false,
+		},
+		{
+			name:          "field exists",
+			pattern:       `{"body": {"customerId": [{"exists": true}]}}`,
+			event:         `{"body": {"customerId": "c-1"}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "field does not exist",
+			pattern:       `{"body": {"customerId": [{"exists": false}]}}`,
+			event:         `{"body": {"orderId": "o-1"}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "any item in a list",
+			pattern:       `{"body": {"tags": ["urgent"]}}`,
+			event:         `{"body": {"tags": ["new", "urgent"]}}`,
+			expectMatches: true,
+		},
+		{
+			name:          "nested fields of a value that is not an object",
+			pattern:       `{"body": {"status": ["active"]}}`,
+			event:         `{"body": "active"}`,
+			expectMatches: false,
+		},
+		{
+			name:          "DynamoDB new image",
+			pattern:       `{"dynamodb": {"NewImage": {"status": {"S": ["active"]}}}}`,
+			event:         `{"dynamodb": {"NewImage": {"status": {"S": "active"}}}}`,
+			expectMatches: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			pattern, err := parseFilterPattern(testCase.pattern)
+			s.Require().NoError(err)
+
+			var event any
+			s.Require().NoError(json.Unmarshal([]byte(testCase.event), &event))
+			s.Equal(testCase.expectMatches, pattern.matches(event))
+		})
+	}
+}
+
+func (s *FilterPatternSuite) Test_decodes_event_payloads() {
+	event := map[string]any{
+		"body": `{"status": "active"}`,
+		// {"temperature": 75}
+		"data":         "eyJ0ZW1wZXJhdHVyZSI6IDc1fQ==",
+		"partitionKey": "sensor-1",
+	}
+
+	decoded := decodeFilterEventPayloads(event)
+	s.Equal(map[string]any{
+		"body":         map[string]any{"status": "active"},
+		"data":         map[string]any{"temperature": float64(75)},
+		"partitionKey": "sensor-1",
+	}, decoded)
+	// The original event should not be modified.
+	s.Equal(`{"status": "active"}`, event["body"])
+
+	plainText := map[string]any{"body": "active"}
+	s.Equal(plainText, decodeFilterEventPayloads(plainText))
+}
+
+func TestFilterPatternSuite(t *testing.T) {
+	suite.Run(t, new(FilterPatternSuite))
+}
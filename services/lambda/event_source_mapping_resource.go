@@ -24,6 +24,7 @@ func EventSourceMappingResource(
 	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_jsonc.md")
 	documentdbExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_documentdb.md")
 	mqExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_mq.md")
+	filteringExample, _ := examples.ReadFile("examples/resources/lambda_event_source_mapping_filtering.md")
 
 	lambdaEventSourceMappingActions := &lambdaEventSourceMappingResourceActions{
 		lambdaServiceFactory,
@@ -34,7 +35,11 @@ func EventSourceMappingResource(
 		Label:            "AWS Lambda Event Source Mapping",
 		PlainTextSummary: "A resource for managing an AWS Lambda event source mapping.",
 		FormattedDescription: "The resource type used to define a [Lambda event source mapping](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventsourcemapping.html) " +
-			"that is deployed to AWS.",
+			"that is deployed to AWS.\n\n" +
+			"A deployment fails when Lambda disables the event source mapping or can not read from the event source, " +
+			"errors caused by missing permissions name the IAM actions that the execution role of the function needs. " +
+			"Problems reading from the event source are reported once Lambda has had the chance to poll the event source " +
+			"with the deployed configuration, which can take up to a minute after the mapping was last modified.",
 		Schema:         lambdaEventSourceMappingResourceSchema(),
 		IDField:        "id",
 		CommonTerminal: true,
@@ -47,6 +52,7 @@ func EventSourceMappingResource(
 			string(jsoncExample),
 			string(documentdbExample),
 			string(mqExample),
+			string(filteringExample),
 		},
 		ResourceCanLinkTo:    []string{},
 		GetExternalStateFunc: lambdaEventSourceMappingActions.GetExternalState,
@@ -122,13 +122,19 @@ func lambdaEventSourceMappingResourceSchema() *provider.ResourceDefinitionsSchem
 				Attributes: map[string]*provider.ResourceDefinitionsSchema{
 					"filters": {
 						Type:        provider.ResourceDefinitionsSchemaTypeArray,
-						Description: "A list of filters.",
+						Description: "A list of filters, an event source mapping can have at most 5 filters.",
+						MaxLength:   maxEventSourceMappingFilters,
 						Items: &provider.ResourceDefinitionsSchema{
 							Type: provider.ResourceDefinitionsSchemaTypeObject,
 							Attributes: map[string]*provider.ResourceDefinitionsSchema{
 								"pattern": {
-									Type:        provider.ResourceDefinitionsSchemaTypeString,
-									Description: "A filter pattern.",
+									Type: provider.ResourceDefinitionsSchemaTypeString,
+									Description: "A filter pattern, for SQS, DynamoDB Streams and Kinesis event sources " +
+										"the fields in the pattern are checked against the fields of the records sent by the event source.",
+									FormattedDescription: "A filter pattern using the [Lambda event filtering syntax](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html#filtering-syntax). " +
+										"For SQS, DynamoDB Streams and Kinesis event sources the fields in the pattern are checked against " +
+										"the fields of the records sent by the event source, such as `body`, `dynamodb.NewImage` and `data`.",
+									ValidateFunc: validateFilterPattern,
 								},
 							},
 						},
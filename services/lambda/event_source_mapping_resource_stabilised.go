@@ -2,6 +2,11 @@ package lambda
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -32,9 +37,208 @@ func (l *lambdaEventSourceMappingResourceActions) Stabilised(
 	}
 
 	state := aws.ToString(getEventSourceMappingOutput.State)
+	// When an event source mapping has finished being created or updated,
+	// it will be in either the "Enabled" or "Disabled" state.
+	stabilised := state == "Enabled" || state == "Disabled"
+	if !stabilised {
+		return &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		}, nil
+	}
+
+	stabilised, err = checkEventSourceMappingState(
+		getEventSourceMappingOutput,
+		core.SystemClock{}.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &provider.ResourceHasStabilisedOutput{
-		// When an event source mapping has finished being created or updated,
-		// it will be in either the "Enabled" or "Disabled" state.
-		Stabilised: state == "Enabled" || state == "Disabled",
+		Stabilised: stabilised,
 	}, nil
 }
+
+// processingResultGracePeriod is how long after an event source mapping
+// was last modified that the last processing result may still have been
+// reported for the previous configuration of the mapping.
+// Lambda does not reset the last processing result when a mapping is updated,
+// a problem reported within this period is not treated as a failure until
+// Lambda has had the chance to poll the event source with the new configuration.
+const processingResultGracePeriod = time.Minute
+
+// eventSourceActions holds the IAM actions that the execution role of a function
+// needs to be able to read from each type of event source that is polled by Lambda,
+// keyed by the service in the event source ARN.
+var eventSourceActions = map[string][]string{
+	"sqs": {
+		"sqs:ReceiveMessage",
+		"sqs:DeleteMessage",
+		"sqs:GetQueueAttributes",
+	},
+	"kinesis": {
+		"kinesis:GetRecords",
+		"kinesis:GetShardIterator",
+		"kinesis:DescribeStream",
+		"kinesis:DescribeStreamSummary",
+		"kinesis:ListShards",
+		"kinesis:ListStreams",
+	},
+	"dynamodb": {
+		"dynamodb:GetRecords",
+		"dynamodb:GetShardIterator",
+		"dynamodb:DescribeStream",
+		"dynamodb:ListStreams",
+	},
+	"kafka": {
+		"kafka:DescribeClusterV2",
+		"kafka:GetBootstrapBrokers",
+		"ec2:CreateNetworkInterface",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeVpcs",
+		"ec2:DeleteNetworkInterface",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeSecurityGroups",
+	},
+	"mq": {
+		"mq:DescribeBroker",
+		"secretsmanager:GetSecretValue",
+		"ec2:CreateNetworkInterface",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeVpcs",
+		"ec2:DeleteNetworkInterface",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeSecurityGroups",
+	},
+	"rds": {
+		"rds:DescribeDBClusterParameters",
+		"rds:DescribeDBSubnetGroups",
+		"ec2:CreateNetworkInterface",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeVpcs",
+		"ec2:DeleteNetworkInterface",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeSecurityGroups",
+		"secretsmanager:GetSecretValue",
+	},
+}
+
+// userInitiatedStateTransitionReasons are the state transition reasons
+// that Lambda reports when a user enabled or disabled an event source mapping.
+var userInitiatedStateTransitionReasons = []string{
+	"USER_INITIATED",
+	"User action",
+}
+
+var permissionProblemPattern = regexp.MustCompile(
+	`(?i)permission|not authorized|access ?denied|cannot access|ensure the role can perform`,
+)
+
+// eventSourceActionPatterns holds patterns that match the name of each event source
+// action without the service prefix as a whole word, e.g. "ReceiveMessage" for
+// "sqs:ReceiveMessage", as problems reported by Lambda do not always include the prefix.
+var eventSourceActionPatterns = compileEventSourceActionPatterns()
+
+func compileEventSourceActionPatterns() map[string]*regexp.Regexp {
+	patterns := map[string]*regexp.Regexp{}
+	for _, actions := range eventSourceActions {
+		for _, action := range actions {
+			actionName := action[strings.Index(action, ":")+1:]
+			patterns[action] = regexp.MustCompile(`\b` + regexp.QuoteMeta(actionName) + `\b`)
+		}
+	}
+	return patterns
+}
+
+// checkEventSourceMappingState checks a stable event source mapping for problems
+// that mean that Lambda can not read records from the event source.
+// An event source mapping that Lambda has disabled due to an error or that
+// can not read from the event source due to missing permissions will remain
+// in a stable state, so these are reported as errors to fail the deployment
+// instead of leaving a mapping that silently does not process records.
+// Problems with invoking the function, such as function errors or timeouts,
+// are not related to the deployment of the event source mapping and are ignored.
+// A permission problem in the last processing result of a mapping that was modified
+// within the grace period may predate the modification, the mapping is reported as not
+// yet stabilised in this case so the problem is checked again once Lambda has polled
+// the event source with the current configuration.
+func checkEventSourceMappingState(
+	output *lambda.GetEventSourceMappingOutput,
+	now time.Time,
+) (bool, error) {
+	state := aws.ToString(output.State)
+	stateTransitionReason := aws.ToString(output.StateTransitionReason)
+	lastProcessingResult := aws.ToString(output.LastProcessingResult)
+	mappingID := aws.ToString(output.UUID)
+
+	isPermissionProblem := strings.HasPrefix(lastProcessingResult, "PROBLEM:") &&
+		permissionProblemPattern.MatchString(lastProcessingResult)
+	disabledByLambda := state == "Disabled" &&
+		stateTransitionReason != "" &&
+		!isUserInitiatedStateTransition(stateTransitionReason)
+
+	if !isPermissionProblem && !disabledByLambda {
+		return true, nil
+	}
+
+	if !disabledByLambda && output.LastModified != nil &&
+		now.Sub(*output.LastModified) < processingResultGracePeriod {
+		return false, nil
+	}
+
+	reasons := []string{}
+	if disabledByLambda {
+		reasons = append(reasons, stateTransitionReason)
+	}
+	if strings.HasPrefix(lastProcessingResult, "PROBLEM:") {
+		reasons = append(reasons, lastProcessingResult)
+	}
+	problem := strings.Join(reasons, "; ")
+
+	message := fmt.Sprintf("event source mapping %s can not read from the event source: %s", mappingID, problem)
+	if disabledByLambda {
+		message = fmt.Sprintf("event source mapping %s was disabled by Lambda: %s", mappingID, problem)
+	}
+
+	missingActions := missingEventSourceActions(aws.ToString(output.EventSourceArn), problem)
+	if permissionProblemPattern.MatchString(problem) && len(missingActions) > 0 {
+		message = fmt.Sprintf(
+			"%s, make sure that the execution role of the function allows the following actions "+
+				"on the event source: %s",
+			message,
+			strings.Join(missingActions, ", "),
+		)
+	}
+
+	return false, errors.New(message)
+}
+
+// missingEventSourceActions determines the IAM actions that are missing from
+// the execution role of a function based on the actions named in a problem
+// reported by Lambda.
+// When the problem does not name any of the actions that are required for the
+// event source, all of the required actions are returned.
+func missingEventSourceActions(eventSourceARN string, problem string) []string {
+	requiredActions := eventSourceActions[eventSourceService(eventSourceARN)]
+
+	namedActions := []string{}
+	for _, action := range requiredActions {
+		if strings.Contains(problem, action) || eventSourceActionPatterns[action].MatchString(problem) {
+			namedActions = append(namedActions, action)
+		}
+	}
+
+	if len(namedActions) > 0 {
+		return namedActions
+	}
+	return requiredActions
+}
+
+func isUserInitiatedStateTransition(reason string) bool {
+	for _, userInitiatedReason := range userInitiatedStateTransitionReasons {
+		if strings.EqualFold(reason, userInitiatedReason) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package lambda
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -36,6 +37,8 @@ func (s *LambdaEventSourceMappingResourceStabilisedSuite) Test_stabilised_lambda
 		stabilisedBasicEventSourceMappingEnabledTestCase(providerCtx, loader),
 		stabilisedBasicEventSourceMappingDisabledTestCase(providerCtx, loader),
 		stabilisedBasicEventSourceMappingErrorTestCase(providerCtx, loader),
+		stabilisedEventSourceMappingFunctionProblemTestCase(providerCtx, loader),
+		stabilisedEventSourceMappingDisabledByLambdaTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
@@ -152,6 +155,203 @@ func stabilisedBasicEventSourceMappingErrorTestCase(
 	}
 }
 
+func (s *LambdaEventSourceMappingResourceStabilisedSuite) Test_check_event_source_mapping_state() {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		output        *lambda.GetEventSourceMappingOutput
+		expectPending bool
+		expectedError string
+	}{
+		{
+			name: "ignores a mapping that was disabled by a user",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                  aws.String("123"),
+				State:                 aws.String("Disabled"),
+				StateTransitionReason: aws.String("USER_INITIATED"),
+			},
+		},
+		{
+			name: "ignores problems with invoking the function",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                 aws.String("123"),
+				State:                aws.String("Enabled"),
+				LastProcessingResult: aws.String("PROBLEM: Function call failed"),
+			},
+		},
+		{
+			name: "names the action that is missing for an SQS queue",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                 aws.String("123"),
+				State:                aws.String("Enabled"),
+				EventSourceArn:       aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+				LastProcessingResult: aws.String("PROBLEM: The provided execution role does not have permissions to call ReceiveMessage on SQS"),
+			},
+			expectedError: "event source mapping 123 can not read from the event source: " +
+				"PROBLEM: The provided execution role does not have permissions to call ReceiveMessage on SQS, " +
+				"make sure that the execution role of the function allows the following actions on the event source: " +
+				"sqs:ReceiveMessage",
+		},
+		{
+			name: "waits for a permission problem that may predate the last modification",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                 aws.String("123"),
+				State:                aws.String("Enabled"),
+				EventSourceArn:       aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+				LastModified:         aws.Time(now.Add(-10 * time.Second)),
+				LastProcessingResult: aws.String("PROBLEM: The provided execution role does not have permissions to call ReceiveMessage on SQS"),
+			},
+			expectPending: true,
+		},
+		{
+			name: "reports a permission problem once the grace period after the last modification has passed",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                 aws.String("123"),
+				State:                aws.String("Enabled"),
+				EventSourceArn:       aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+				LastModified:         aws.Time(now.Add(-5 * time.Minute)),
+				LastProcessingResult: aws.String("PROBLEM: The provided execution role does not have permissions to call DeleteMessage on SQS"),
+			},
+			expectedError: "event source mapping 123 can not read from the event source: " +
+				"PROBLEM: The provided execution role does not have permissions to call DeleteMessage on SQS, " +
+				"make sure that the execution role of the function allows the following actions on the event source: " +
+				"sqs:DeleteMessage",
+		},
+		{
+			name: "names the actions that are missing for a Kinesis stream disabled by Lambda",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:           aws.String("123"),
+				State:          aws.String("Disabled"),
+				EventSourceArn: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/orders"),
+				StateTransitionReason: aws.String(
+					"Cannot access stream arn:aws:kinesis:us-east-1:123456789012:stream/orders. " +
+						"Please ensure the role can perform the GetRecords, GetShardIterator, DescribeStream, " +
+						"and ListShards Actions on your stream in IAM.",
+				),
+			},
+			expectedError: "event source mapping 123 was disabled by Lambda: " +
+				"Cannot access stream arn:aws:kinesis:us-east-1:123456789012:stream/orders. " +
+				"Please ensure the role can perform the GetRecords, GetShardIterator, DescribeStream, " +
+				"and ListShards Actions on your stream in IAM., " +
+				"make sure that the execution role of the function allows the following actions on the event source: " +
+				"kinesis:GetRecords, kinesis:GetShardIterator, kinesis:DescribeStream, kinesis:ListShards",
+		},
+		{
+			name: "lists all of the required actions when the problem does not name an action",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                 aws.String("123"),
+				State:                aws.String("Enabled"),
+				EventSourceArn:       aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/users/stream/2024-01-01T00:00:00.000"),
+				LastProcessingResult: aws.String("PROBLEM: Lambda is not authorized to read from the stream"),
+			},
+			expectedError: "event source mapping 123 can not read from the event source: " +
+				"PROBLEM: Lambda is not authorized to read from the stream, " +
+				"make sure that the execution role of the function allows the following actions on the event source: " +
+				"dynamodb:GetRecords, dynamodb:GetShardIterator, dynamodb:DescribeStream, dynamodb:ListStreams",
+		},
+		{
+			name: "reports a mapping disabled by Lambda for a reason other than permissions",
+			output: &lambda.GetEventSourceMappingOutput{
+				UUID:                  aws.String("123"),
+				State:                 aws.String("Disabled"),
+				EventSourceArn:        aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+				StateTransitionReason: aws.String("The event source queue has been deleted"),
+			},
+			expectedError: "event source mapping 123 was disabled by Lambda: The event source queue has been deleted",
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			stabilised, err := checkEventSourceMappingState(testCase.output, now)
+			if testCase.expectedError == "" {
+				s.NoError(err)
+				s.Equal(!testCase.expectPending, stabilised)
+				return
+			}
+			s.EqualError(err, testCase.expectedError)
+		})
+	}
+}
+
+func stabilisedEventSourceMappingFunctionProblemTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+			UUID:                 aws.String("123"),
+			State:                aws.String("Enabled"),
+			EventSourceArn:       aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+			LastProcessingResult: aws.String("PROBLEM: Function timeout"),
+		}),
+	)
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id": core.MappingNodeFromString("123"),
+		},
+	}
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "event source mapping is stabilised when the last problem was with invoking the function",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    resourceSpecState,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: true,
+		},
+	}
+}
+
+func stabilisedEventSourceMappingDisabledByLambdaTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetEventSourceMappingOutput(&lambda.GetEventSourceMappingOutput{
+			UUID:                  aws.String("123"),
+			State:                 aws.String("Disabled"),
+			EventSourceArn:        aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+			StateTransitionReason: aws.String("Lambda does not have permission to call ReceiveMessage on the queue"),
+		}),
+	)
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id": core.MappingNodeFromString("123"),
+		},
+	}
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "event source mapping fails to stabilise when disabled by Lambda",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec:    resourceSpecState,
+		},
+		ExpectError: true,
+	}
+}
+
 func TestLambdaEventSourceMappingResourceStabilised(t *testing.T) {
 	suite.Run(t, new(LambdaEventSourceMappingResourceStabilisedSuite))
 }
@@ -0,0 +1,214 @@
+package lambda
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// filterEventSource holds the fields of the event records for a type of
+// event source that can be matched by a filter pattern.
+type filterEventSource struct {
+	label string
+	// fields holds the top-level fields of an event record.
+	fields []string
+	// payloadField is the field that holds the record payload,
+	// this is used as a hint when a pattern uses an unknown field.
+	payloadField string
+}
+
+// filterEventSources maps the service in an event source ARN
+// to the fields of the records that the service sends to Lambda,
+// see: https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html
+var filterEventSources = map[string]filterEventSource{
+	"sqs": {
+		label: "Amazon SQS",
+		fields: []string{
+			"messageId", "receiptHandle", "body", "attributes", "messageAttributes",
+			"md5OfBody", "md5OfMessageAttributes", "eventSource", "eventSourceARN", "awsRegion",
+		},
+		payloadField: "body",
+	},
+	"dynamodb": {
+		label: "DynamoDB Streams",
+		fields: []string{
+			"eventID", "eventName", "eventVersion", "eventSource", "awsRegion",
+			"dynamodb", "userIdentity", "eventSourceARN",
+		},
+		payloadField: "dynamodb.NewImage",
+	},
+	"kinesis": {
+		label: "Kinesis",
+		fields: []string{
+			"kinesisSchemaVersion", "partitionKey", "sequenceNumber", "data",
+			"approximateArrivalTimestamp", "encryptionType", "eventSource", "eventVersion",
+			"eventID", "eventName", "invokeIdentityArn", "awsRegion", "eventSourceARN",
+		},
+		payloadField: "data",
+	},
+}
+
+var dynamoDBStreamRecordFields = []string{
+	"ApproximateCreationDateTime", "Keys", "NewImage", "OldImage",
+	"SequenceNumber", "SizeBytes", "StreamViewType",
+}
+
+var dynamoDBAttributeValueTypes = []string{
+	"S", "N", "B", "BOOL", "NULL", "M", "L", "SS", "NS", "BS",
+}
+
+// validateFilterPattern parses a filter pattern and checks the fields used in the pattern
+// against the records sent by the event source of the event source mapping.
+// Patterns that fail to parse will fail to deploy and patterns that use fields
+// that are not in the records will never match, causing all records to be dropped.
+func validateFilterPattern(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	pattern, err := parseFilterPattern(core.StringValue(value))
+	if err != nil {
+		return []*core.Diagnostic{
+			{
+				Level:   core.DiagnosticLevelError,
+				Message: fmt.Sprintf("The %s field contains an invalid filter pattern: %s.", path, err),
+				Range:   core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+			},
+		}
+	}
+
+	eventSourceARN, hasEventSourceARN := pluginutils.GetValueByPath("$.eventSourceArn", resource.Spec)
+	if !hasEventSourceARN || eventSourceARN.StringWithSubstitutions != nil {
+		// The event source can only be determined when the ARN is known
+		// at the validation stage.
+		return []*core.Diagnostic{}
+	}
+
+	eventSource, isFilterableSource := filterEventSources[eventSourceService(
+		core.StringValue(eventSourceARN),
+	)]
+	if !isFilterableSource {
+		return []*core.Diagnostic{}
+	}
+
+	return checkFilterPatternFields(path, value, pattern, eventSource)
+}
+
+// checkFilterPatternFields produces warnings for top-level fields in a filter
+// pattern that are not present in records from the event source and for fields
+// under "dynamodb" that are not present in DynamoDB stream records,
+// records will never match a pattern that uses these fields.
+func checkFilterPatternFields(
+	path string,
+	value *core.MappingNode,
+	pattern *filterPattern,
+	eventSource filterEventSource,
+) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	warn := func(message string) {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level:   core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf("The filter pattern in %s %s", path, message),
+			Range:   core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		})
+	}
+
+	for _, field := range sortedFilterPatternFields(pattern.fields) {
+		if slices.Contains(eventSource.fields, field) {
+			continue
+		}
+
+		warn(fmt.Sprintf(
+			"uses the %q field which is not in %s records, records will never match this pattern. "+
+				"Fields in the record payload must be nested under %q.",
+			field,
+			eventSource.label,
+			eventSource.payloadField,
+		))
+	}
+
+	dynamoDBRecord, hasDynamoDBRecord := pattern.fields["dynamodb"]
+	if eventSource.payloadField == "dynamodb.NewImage" && hasDynamoDBRecord {
+		checkDynamoDBRecordPattern(dynamoDBRecord, warn)
+	}
+
+	return diagnostics
+}
+
+func checkDynamoDBRecordPattern(
+	dynamoDBRecord *filterPatternNode,
+	warn func(message string),
+) {
+	for _, field := range sortedFilterPatternFields(dynamoDBRecord.fields) {
+		if !slices.Contains(dynamoDBStreamRecordFields, field) {
+			warn(fmt.Sprintf(
+				"uses the \"dynamodb.%s\" field which is not in DynamoDB stream records, "+
+					"records will never match this pattern.",
+				field,
+			))
+			continue
+		}
+
+		if field != "Keys" && field != "NewImage" && field != "OldImage" {
+			continue
+		}
+
+		image := dynamoDBRecord.fields[field]
+		for _, attribute := range sortedFilterPatternFields(image.fields) {
+			if isDynamoDBAttributeValuePattern(image.fields[attribute]) {
+				continue
+			}
+
+			warn(fmt.Sprintf(
+				"matches the \"dynamodb.%s.%s\" attribute without a DynamoDB type descriptor, "+
+					"records will never match this pattern. Attribute values must be nested "+
+					"under a type such as {\"%s\": {\"S\": [\"value\"]}}.",
+				field,
+				attribute,
+				attribute,
+			))
+		}
+	}
+}
+
+func isDynamoDBAttributeValuePattern(node *filterPatternNode) bool {
+	if node.fields == nil {
+		// Only checking for the existence of an attribute
+		// does not require a type descriptor.
+		return slices.ContainsFunc(node.matchers, func(matcher filterPatternMatcher) bool {
+			_, isExistsMatcher := matcher.(*existsMatcher)
+			return isExistsMatcher
+		})
+	}
+
+	for field := range node.fields {
+		if !slices.Contains(dynamoDBAttributeValueTypes, field) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedFilterPatternFields(fields map[string]*filterPatternNode) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// eventSourceService extracts the service from an event source ARN
+// in the form arn:{partition}:{service}:{region}:{account}:{resource}.
+func eventSourceService(eventSourceARN string) string {
+	parts := strings.SplitN(eventSourceARN, ":", 4)
+	if len(parts) < 4 || parts[0] != "arn" {
+		return ""
+	}
+	return parts[2]
+}
@@ -0,0 +1,175 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventSourceMappingValidationSuite struct {
+	suite.Suite
+}
+
+func (s *EventSourceMappingValidationSuite) Test_validate_filter_pattern() {
+	testCases := []struct {
+		name             string
+		eventSourceARN   *core.MappingNode
+		pattern          string
+		expectedLevel    core.DiagnosticLevel
+		expectedMessages []string
+	}{
+		{
+			name:           "reports an error for an invalid pattern",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:sqs:us-east-1:123456789012:orders"),
+			pattern:        `{"body": {"status": "active"}}`,
+			expectedLevel:  core.DiagnosticLevelError,
+			expectedMessages: []string{
+				"The $.filterCriteria.filters[0].pattern field contains an invalid filter pattern: " +
+					"body.status: the value of a field must be an object or a list of values to match, for example [\"value\"].",
+			},
+		},
+		{
+			name:           "accepts a pattern for the body of SQS messages",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:sqs:us-east-1:123456789012:orders"),
+			pattern:        `{"body": {"status": ["active"]}, "attributes": {"MessageGroupId": ["orders"]}}`,
+		},
+		{
+			name:           "warns about fields that are not in SQS messages",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:sqs:us-east-1:123456789012:orders"),
+			pattern:        `{"status": ["active"]}`,
+			expectedLevel:  core.DiagnosticLevelWarning,
+			expectedMessages: []string{
+				"The filter pattern in $.filterCriteria.filters[0].pattern uses the \"status\" field which is not in " +
+					"Amazon SQS records, records will never match this pattern. Fields in the record payload must be nested under \"body\".",
+			},
+		},
+		{
+			name:           "accepts a pattern for the data of Kinesis records",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:kinesis:us-east-1:123456789012:stream/orders"),
+			pattern:        `{"data": {"temperature": [{"numeric": [">", 70]}]}, "partitionKey": ["sensor-1"]}`,
+		},
+		{
+			name:           "warns about fields that are not in Kinesis records",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:kinesis:us-east-1:123456789012:stream/orders"),
+			pattern:        `{"source": ["aws.kinesis"]}`,
+			expectedLevel:  core.DiagnosticLevelWarning,
+			expectedMessages: []string{
+				"The filter pattern in $.filterCriteria.filters[0].pattern uses the \"source\" field which is not in " +
+					"Kinesis records, records will never match this pattern. Fields in the record payload must be nested under \"data\".",
+			},
+		},
+		{
+			name: "accepts a pattern for the new image of DynamoDB stream records",
+			eventSourceARN: core.MappingNodeFromString(
+				"arn:aws:dynamodb:us-east-1:123456789012:table/users/stream/2024-01-01T00:00:00.000",
+			),
+			pattern: `{"eventName": ["INSERT"], "dynamodb": {"NewImage": {"status": {"S": ["active"]}, "email": [{"exists": true}]}}}`,
+		},
+		{
+			name: "warns about DynamoDB attributes without a type descriptor",
+			eventSourceARN: core.MappingNodeFromString(
+				"arn:aws:dynamodb:us-east-1:123456789012:table/users/stream/2024-01-01T00:00:00.000",
+			),
+			pattern:       `{"NewImage": {"status": {"S": ["active"]}}, "dynamodb": {"NewImage": {"status": ["active"]}, "Image": {"id": {"S": ["1"]}}}}`,
+			expectedLevel: core.DiagnosticLevelWarning,
+			expectedMessages: []string{
+				"The filter pattern in $.filterCriteria.filters[0].pattern uses the \"NewImage\" field which is not in " +
+					"DynamoDB Streams records, records will never match this pattern. Fields in the record payload must be nested under \"dynamodb.NewImage\".",
+				"The filter pattern in $.filterCriteria.filters[0].pattern uses the \"dynamodb.Image\" field which is not in " +
+					"DynamoDB stream records, records will never match this pattern.",
+				"The filter pattern in $.filterCriteria.filters[0].pattern matches the \"dynamodb.NewImage.status\" attribute " +
+					"without a DynamoDB type descriptor, records will never match this pattern. Attribute values must be nested " +
+					"under a type such as {\"status\": {\"S\": [\"value\"]}}.",
+			},
+		},
+		{
+			name:           "skips event source checks for other event sources",
+			eventSourceARN: core.MappingNodeFromString("arn:aws:kafka:us-east-1:123456789012:cluster/orders/1"),
+			pattern:        `{"value": {"status": ["active"]}}`,
+		},
+		{
+			name: "skips event source checks when the event source ARN is not resolved",
+			eventSourceARN: &core.MappingNode{
+				StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+					Values: []*substitutions.StringOrSubstitution{
+						{
+							SubstitutionValue: &substitutions.Substitution{
+								ResourceProperty: &substitutions.SubstitutionResourceProperty{
+									ResourceName: "ordersQueue",
+									Path: []*substitutions.SubstitutionPathItem{
+										{FieldName: "spec"},
+										{FieldName: "arn"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pattern: `{"status": ["active"]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			pattern := core.MappingNodeFromString(testCase.pattern)
+			pattern.SourceMeta = &source.Meta{Position: source.Position{Line: 12, Column: 22}}
+			specData := &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"eventSourceArn": testCase.eventSourceARN,
+					"filterCriteria": {
+						Fields: map[string]*core.MappingNode{
+							"filters": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"pattern": pattern,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			diagnostics := validateFilterPattern(
+				"$.filterCriteria.filters[0].pattern",
+				pattern,
+				&schema.Resource{Spec: specData},
+			)
+
+			messages := []string{}
+			for _, diagnostic := range diagnostics {
+				s.Equal(testCase.expectedLevel, diagnostic.Level)
+				s.Equal(12, diagnostic.Range.Start.Line)
+				messages = append(messages, diagnostic.Message)
+			}
+			s.Equal(testCase.expectedMessages, emptyToNil(messages))
+		})
+	}
+}
+
+func (s *EventSourceMappingValidationSuite) Test_filters_limit_is_enforced_by_schema() {
+	// Validation functions are only called for scalar values, so the number
+	// of filters is limited with the max length of the filters array.
+	filterCriteria := lambdaEventSourceMappingResourceSchema().Attributes["filterCriteria"]
+	filters := filterCriteria.Attributes["filters"]
+	s.Equal(maxEventSourceMappingFilters, filters.MaxLength)
+	s.Nil(filters.ValidateFunc)
+}
+
+func emptyToNil(messages []string) []string {
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
+}
+
+func TestEventSourceMappingValidationSuite(t *testing.T) {
+	suite.Run(t, new(EventSourceMappingValidationSuite))
+}
@@ -0,0 +1,90 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// FilterPatternMatchesFunction returns a function that evaluates
+// an event source mapping filter pattern against a sample event record.
+func FilterPatternMatchesFunction() provider.Function {
+	return &providerv1.FunctionDefinition{
+		Definition: &function.Definition{
+			Name:        "lambda_filter_pattern_matches",
+			Summary:     "Evaluates a Lambda event filter pattern against a sample event record.",
+			Description: "Evaluates a Lambda event filter pattern against a sample event record, returning true when Lambda would process the record.",
+			FormattedDescription: "Evaluates a [Lambda event filter pattern](https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html) " +
+				"against a sample event record, returning `true` when Lambda would process the record.\n\n" +
+				"The `body` of an SQS message and the base64 encoded `data` of a Kinesis record are decoded " +
+				"before matching when they contain JSON, in the same way as Lambda.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${lambda_filter_pattern_matches(variables.orderFilter, variables.sampleOrderMessage)}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "pattern",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The filter pattern to evaluate, as used in the filterCriteria of an event source mapping.",
+				},
+				&function.ScalarParameter{
+					Label: "event",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "A JSON string containing the sample event record to match against the pattern.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "boolean",
+					Type:  function.ValueTypeBool,
+				},
+				Description: "True when the event record matches the filter pattern, false otherwise.",
+			},
+		},
+		CallFunc: filterPatternMatches,
+	}
+}
+
+func filterPatternMatches(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var patternString string
+	var eventString string
+	err := input.Arguments.GetMultipleVars(ctx, &patternString, &eventString)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := parseFilterPattern(patternString)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("invalid filter pattern: %s", err),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	var event any
+	err = json.Unmarshal([]byte(eventString), &event)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("unable to decode event JSON: %s", err),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: pattern.matches(decodeFilterEventPayloads(event)),
+	}, nil
+}
@@ -0,0 +1,99 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterPatternFunctionSuite struct {
+	suite.Suite
+	callStack   function.Stack
+	callContext provider.FunctionCallContext
+}
+
+func (s *FilterPatternFunctionSuite) SetupTest() {
+	s.callStack = function.NewStack()
+	s.callStack.Push(&function.Call{
+		FunctionName: "lambda_filter_pattern_matches",
+	})
+	s.callContext = subengine.NewFunctionCallContext(
+		s.callStack,
+		nil,
+		&core.ParamsImpl{},
+		nil,
+	)
+}
+
+func (s *FilterPatternFunctionSuite) Test_matches_sqs_message_body() {
+	output, err := s.callFunction(
+		`{"body": {"status": ["active"]}}`,
+		`{"messageId": "1", "body": "{\"status\": \"active\"}"}`,
+	)
+	s.Require().NoError(err)
+	s.Equal(true, output.ResponseData)
+}
+
+func (s *FilterPatternFunctionSuite) Test_matches_kinesis_record_data() {
+	output, err := s.callFunction(
+		`{"data": {"temperature": [{"numeric": [">", 80]}]}}`,
+		// {"temperature": 75}
+		`{"partitionKey": "sensor-1", "data": "eyJ0ZW1wZXJhdHVyZSI6IDc1fQ=="}`,
+	)
+	s.Require().NoError(err)
+	s.Equal(false, output.ResponseData)
+}
+
+func (s *FilterPatternFunctionSuite) Test_returns_func_error_for_invalid_pattern() {
+	_, err := s.callFunction(
+		`{"body": {"status": "active"}}`,
+		`{"body": "{\"status\": \"active\"}"}`,
+	)
+	s.Require().Error(err)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	s.Require().True(isFuncErr)
+	s.Equal(function.FuncCallErrorCodeInvalidInput, funcErr.Code)
+	s.Equal(
+		"invalid filter pattern: body.status: the value of a field must be an object "+
+			"or a list of values to match, for example [\"value\"]",
+		funcErr.Message,
+	)
+	s.Equal(
+		[]*function.Call{{FunctionName: "lambda_filter_pattern_matches"}},
+		funcErr.CallStack,
+	)
+}
+
+func (s *FilterPatternFunctionSuite) Test_returns_func_error_for_invalid_event() {
+	_, err := s.callFunction(
+		`{"body": {"status": ["active"]}}`,
+		`{"body":`,
+	)
+	s.Require().Error(err)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	s.Require().True(isFuncErr)
+	s.Equal(function.FuncCallErrorCodeInvalidInput, funcErr.Code)
+	s.Equal("unable to decode event JSON: unexpected end of JSON input", funcErr.Message)
+}
+
+func (s *FilterPatternFunctionSuite) callFunction(
+	pattern string,
+	event string,
+) (*provider.FunctionCallOutput, error) {
+	return FilterPatternMatchesFunction().Call(
+		context.Background(),
+		&provider.FunctionCallInput{
+			Arguments:   s.callContext.NewCallArgs(pattern, event),
+			CallContext: s.callContext,
+		},
+	)
+}
+
+func TestFilterPatternFunctionSuite(t *testing.T) {
+	suite.Run(t, new(FilterPatternFunctionSuite))
+}
@@ -0,0 +1,320 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// minUnreservedConcurrentExecutions is the number of concurrent executions
+// that Lambda keeps unreserved for functions without reserved concurrency,
+// PutFunctionConcurrency fails when a reservation would leave fewer unreserved
+// concurrent executions in the account.
+const minUnreservedConcurrentExecutions = 100
+
+const (
+	// concurrencyBudgetSessionTTL is how long the budget for a session is kept
+	// after a function was last validated in the session, sessions are scoped
+	// to a single command run by a client so budgets are not needed for long.
+	concurrencyBudgetSessionTTL = 30 * time.Minute
+	// maxConcurrencyBudgetSessions bounds the number of sessions that budgets
+	// are kept for, the least recently used budget is evicted first.
+	maxConcurrencyBudgetSessions = 64
+)
+
+// concurrencyBudget keeps track of the reserved concurrency of the functions
+// that have been validated in a session so the total reserved concurrency
+// across all of the functions in a blueprint can be checked against the
+// unreserved concurrency that is available in the account.
+// Resources are validated individually, so the budget is built up as each
+// function is validated and the function that would push the unreserved
+// concurrency below the minimum is the one that is reported.
+//
+// Resource definitions do not have a hook into change staging,
+// so the check is carried out when a function is validated. The account settings
+// and the reserved concurrency currently applied to each function are retrieved
+// once per session so that validating a blueprint does not call Lambda
+// more than once for the same information.
+type concurrencyBudget struct {
+	mu       sync.Mutex
+	sessions map[string]*sessionConcurrencyBudget
+	now      func() time.Time
+}
+
+type sessionConcurrencyBudget struct {
+	concurrentExecutions int
+	// unreservedConcurrentExecutions is the unreserved concurrency
+	// in the account before any changes are made by the blueprint.
+	unreservedConcurrentExecutions int
+	// reservationChanges holds the difference between the reserved concurrency
+	// in the spec and the reserved concurrency currently applied in the account
+	// for each function, keyed by function name or position in the blueprint.
+	reservationChanges map[string]int
+	// currentReservations caches the reserved concurrency currently applied
+	// in the account for each function, keyed by function name.
+	currentReservations map[string]int
+	lastUsed            time.Time
+}
+
+func newConcurrencyBudget() *concurrencyBudget {
+	return &concurrencyBudget{
+		sessions: map[string]*sessionConcurrencyBudget{},
+		now:      time.Now,
+	}
+}
+
+func (b *sessionConcurrencyBudget) projectedUnreserved() int {
+	projected := b.unreservedConcurrentExecutions
+	for _, change := range b.reservationChanges {
+		projected -= change
+	}
+	return projected
+}
+
+// validateReservedConcurrency checks the reserved concurrency of a function against the unreserved
+// concurrency available in the account and reports a warning when the reserved concurrency
+// of the functions in the blueprint would leave less than the minimum unreserved concurrency.
+// This is called from the CustomValidate method of the function resource
+// alongside the other checks that can not be expressed in the schema.
+// Validation can run without access to AWS, so no diagnostics are reported
+// when the account settings can not be retrieved.
+// Provisioned concurrency configured in the specs of function alias and version
+// resources is not included in the budget, as validating a function does not
+// have access to the specs of other resources in the blueprint.
+func (l *lambdaFunctionResourceActions) validateReservedConcurrency(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return diagnostics
+	}
+
+	reserved, hasReserved := pluginutils.GetValueByPath(
+		"$.reservedConcurrentExecutions",
+		input.SchemaResource.Spec,
+	)
+	if !hasReserved || reserved.Scalar == nil || reserved.Scalar.IntValue == nil {
+		// Reserved concurrency that is not set or depends on values
+		// that are only known at deploy time can not be checked.
+		return diagnostics
+	}
+
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return diagnostics
+	}
+
+	sessionID, hasSessionID := utils.SessionID(ctx, input.ProviderContext)
+	budget, err := l.concurrencyBudget.forSession(ctx, sessionID, hasSessionID, lambdaService)
+	if err != nil || budget == nil {
+		return diagnostics
+	}
+
+	functionName, hasFunctionName := pluginutils.GetValueByPath(
+		"$.functionName",
+		input.SchemaResource.Spec,
+	)
+	currentReserved := 0
+	if hasFunctionName && functionName.Scalar != nil && functionName.Scalar.StringValue != nil {
+		currentReserved = l.concurrencyBudget.currentReservation(
+			ctx,
+			budget,
+			lambdaService,
+			core.StringValue(functionName),
+		)
+	}
+
+	reservedValue := int(core.IntValue(reserved))
+	key := concurrencyBudgetKey(functionName, input.SchemaResource)
+	projected := l.concurrencyBudget.reserve(budget, key, reservedValue-currentReserved)
+	if projected < minUnreservedConcurrentExecutions {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"Reserving %d concurrent executions in $.reservedConcurrentExecutions would leave %d "+
+					"unreserved concurrent executions in the account when combined with the reserved "+
+					"concurrency of the other functions in the blueprint. Lambda requires at least %d "+
+					"unreserved concurrent executions out of the account limit of %d, "+
+					"deploying this function will fail unless the concurrency limit of the account is increased.",
+				reservedValue,
+				projected,
+				minUnreservedConcurrentExecutions,
+				budget.concurrentExecutions,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(reserved.SourceMeta, nil),
+		})
+	}
+
+	return diagnostics
+}
+
+// forSession returns the budget for the current session, retrieving the account settings
+// the first time a function is validated in the session.
+// A budget that is not stored is returned when there is no session ID,
+// in which case each function is checked on its own.
+func (c *concurrencyBudget) forSession(
+	ctx context.Context,
+	sessionID string,
+	hasSessionID bool,
+	lambdaService lambdaservice.Service,
+) (*sessionConcurrencyBudget, error) {
+	if hasSessionID {
+		c.mu.Lock()
+		c.evictExpired()
+		budget, hasBudget := c.sessions[sessionID]
+		if hasBudget {
+			budget.lastUsed = c.now()
+		}
+		c.mu.Unlock()
+		if hasBudget {
+			return budget, nil
+		}
+	}
+
+	output, err := lambdaService.GetAccountSettings(ctx, &lambda.GetAccountSettingsInput{})
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.AccountLimit == nil ||
+		output.AccountLimit.UnreservedConcurrentExecutions == nil {
+		return nil, nil
+	}
+
+	budget := &sessionConcurrencyBudget{
+		concurrentExecutions:           int(output.AccountLimit.ConcurrentExecutions),
+		unreservedConcurrentExecutions: int(aws.ToInt32(output.AccountLimit.UnreservedConcurrentExecutions)),
+		reservationChanges:             map[string]int{},
+		currentReservations:            map[string]int{},
+		lastUsed:                       c.now(),
+	}
+	if !hasSessionID {
+		return budget, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Another function in the same session may have stored the budget
+	// while the account settings were being retrieved.
+	if existing, hasBudget := c.sessions[sessionID]; hasBudget {
+		return existing, nil
+	}
+	if len(c.sessions) >= maxConcurrencyBudgetSessions {
+		c.evictLeastRecentlyUsed()
+	}
+	c.sessions[sessionID] = budget
+	return budget, nil
+}
+
+// evictExpired removes the budgets for sessions that have not been used
+// within the session TTL, the caller must hold the lock.
+func (c *concurrencyBudget) evictExpired() {
+	cutoff := c.now().Add(-concurrencyBudgetSessionTTL)
+	for sessionID, budget := range c.sessions {
+		if budget.lastUsed.Before(cutoff) {
+			delete(c.sessions, sessionID)
+		}
+	}
+}
+
+// evictLeastRecentlyUsed removes the budget for the session that was used
+// least recently, the caller must hold the lock.
+func (c *concurrencyBudget) evictLeastRecentlyUsed() {
+	oldestSessionID := ""
+	var oldest time.Time
+	for sessionID, budget := range c.sessions {
+		if oldestSessionID == "" || budget.lastUsed.Before(oldest) {
+			oldestSessionID = sessionID
+			oldest = budget.lastUsed
+		}
+	}
+	delete(c.sessions, oldestSessionID)
+}
+
+// currentReservation returns the reserved concurrency currently applied
+// to a function, retrieving it the first time the function is validated
+// in the session.
+func (c *concurrencyBudget) currentReservation(
+	ctx context.Context,
+	budget *sessionConcurrencyBudget,
+	lambdaService lambdaservice.Service,
+	functionName string,
+) int {
+	c.mu.Lock()
+	reserved, hasReserved := budget.currentReservations[functionName]
+	c.mu.Unlock()
+	if hasReserved {
+		return reserved
+	}
+
+	reserved = getCurrentReservedConcurrency(ctx, lambdaService, functionName)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	budget.currentReservations[functionName] = reserved
+	return reserved
+}
+
+// reserve records the change in reserved concurrency for a function
+// and returns the unreserved concurrency that would be left in the account
+// once the changes for all of the functions validated so far are applied.
+func (c *concurrencyBudget) reserve(
+	budget *sessionConcurrencyBudget,
+	key string,
+	change int,
+) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	budget.reservationChanges[key] = change
+	return budget.projectedUnreserved()
+}
+
+func getCurrentReservedConcurrency(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+) int {
+	// The function will not exist yet for the first deployment,
+	// in which case none of the account's concurrency is reserved for it.
+	output, err := lambdaService.GetFunctionConcurrency(
+		ctx,
+		&lambda.GetFunctionConcurrencyInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil || output == nil {
+		return 0
+	}
+
+	return int(aws.ToInt32(output.ReservedConcurrentExecutions))
+}
+
+// concurrencyBudgetKey identifies a function in the concurrency budget so that
+// validating the same function more than once in a session does not count
+// its reserved concurrency more than once.
+func concurrencyBudgetKey(functionName *core.MappingNode, resource *schema.Resource) string {
+	if functionName != nil && functionName.Scalar != nil && functionName.Scalar.StringValue != nil {
+		return "name:" + core.StringValue(functionName)
+	}
+
+	if resource.SourceMeta != nil {
+		return fmt.Sprintf(
+			"position:%d:%d",
+			resource.SourceMeta.Line,
+			resource.SourceMeta.Column,
+		)
+	}
+
+	return fmt.Sprintf("resource:%p", resource)
+}
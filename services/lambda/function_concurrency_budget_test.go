@@ -0,0 +1,239 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionConcurrencyBudgetSuite struct {
+	suite.Suite
+	loader *testutils.MockAWSConfigLoader
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) SetupTest() {
+	s.loader = &testutils.MockAWSConfigLoader{}
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_warns_when_reserved_concurrency_exceeds_budget() {
+	resource := s.createFunctionResource(
+		lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 300)),
+		),
+	)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "order-processor", 250),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Equal(core.DiagnosticLevelWarning, output.Diagnostics[0].Level)
+	s.Contains(
+		output.Diagnostics[0].Message,
+		"Reserving 250 concurrent executions in $.reservedConcurrentExecutions would leave 50 unreserved",
+	)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_warns_when_total_reserved_concurrency_exceeds_budget() {
+	resource := s.createFunctionResource(
+		lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 300)),
+		),
+	)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "order-processor", 150),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+
+	// Validating the same function again should replace its reservation
+	// in the budget instead of adding to it.
+	output, err = resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "order-processor", 150),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+
+	output, err = resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "payment-processor", 100),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Contains(output.Diagnostics[0].Message, "would leave 50 unreserved concurrent executions")
+
+	// Functions validated in a different session have their own budget.
+	output, err = resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-2", "payment-processor", 100),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_accounts_for_currently_reserved_concurrency() {
+	resource := s.createFunctionResource(
+		lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 150)),
+			lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{
+				ReservedConcurrentExecutions: aws.Int32(200),
+			}),
+		),
+	)
+
+	// 200 of the account's concurrency is already reserved for the function,
+	// so increasing the reservation to 220 leaves 130 unreserved.
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "order-processor", 220),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_skips_check_when_account_settings_are_unavailable() {
+	resource := s.createFunctionResource(
+		lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetAccountSettingsError(errors.New("no credentials")),
+		),
+	)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		s.createValidateInput("session-1", "order-processor", 5000),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_evicts_expired_session_budgets() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 300)),
+	)
+	now := time.Date(2025, time.July, 1, 10, 0, 0, 0, time.UTC)
+	budget := newConcurrencyBudget()
+	budget.now = func() time.Time { return now }
+
+	_, err := budget.forSession(context.Background(), "session-1", true, service)
+	s.Require().NoError(err)
+
+	now = now.Add(concurrencyBudgetSessionTTL + time.Minute)
+	_, err = budget.forSession(context.Background(), "session-2", true, service)
+	s.Require().NoError(err)
+
+	s.Len(budget.sessions, 1)
+	s.Contains(budget.sessions, "session-2")
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_bounds_the_number_of_session_budgets() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 300)),
+	)
+	now := time.Date(2025, time.July, 1, 10, 0, 0, 0, time.UTC)
+	budget := newConcurrencyBudget()
+	budget.now = func() time.Time { return now }
+
+	for i := range maxConcurrencyBudgetSessions + 1 {
+		now = now.Add(time.Second)
+		_, err := budget.forSession(context.Background(), fmt.Sprintf("session-%d", i), true, service)
+		s.Require().NoError(err)
+	}
+
+	s.Len(budget.sessions, maxConcurrencyBudgetSessions)
+	s.NotContains(budget.sessions, "session-0")
+	s.Contains(budget.sessions, fmt.Sprintf("session-%d", maxConcurrencyBudgetSessions))
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) Test_caches_current_reservations_for_the_session() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetAccountSettingsOutput(createTestAccountSettingsOutput(1000, 150)),
+		lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{
+			ReservedConcurrentExecutions: aws.Int32(200),
+		}),
+	)
+	budget := newConcurrencyBudget()
+
+	sessionBudget, err := budget.forSession(context.Background(), "session-1", true, service)
+	s.Require().NoError(err)
+
+	reserved := budget.currentReservation(context.Background(), sessionBudget, service, "order-processor")
+	s.Equal(200, reserved)
+	s.Equal(map[string]int{"order-processor": 200}, sessionBudget.currentReservations)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) createFunctionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+) provider.Resource {
+	return newTestFunctionResource(
+		lambdaServiceFactory,
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			s.loader,
+			utils.AWSConfigCacheKey,
+		),
+	)
+}
+
+func (s *LambdaFunctionConcurrencyBudgetSuite) createValidateInput(
+	sessionID string,
+	functionName string,
+	reservedConcurrentExecutions int,
+) *provider.ResourceValidateInput {
+	return &provider.ResourceValidateInput{
+		SchemaResource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+			Spec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":                 core.MappingNodeFromString(functionName),
+					"reservedConcurrentExecutions": core.MappingNodeFromInt(reservedConcurrentExecutions),
+				},
+			},
+		},
+		ProviderContext: plugintestutils.NewTestProviderContext(
+			"aws",
+			map[string]*core.ScalarValue{
+				"region": core.ScalarFromString("us-west-2"),
+			},
+			map[string]*core.ScalarValue{
+				pluginutils.SessionIDKey: core.ScalarFromString(sessionID),
+			},
+		),
+	}
+}
+
+func createTestAccountSettingsOutput(
+	concurrentExecutions int32,
+	unreservedConcurrentExecutions int32,
+) *lambda.GetAccountSettingsOutput {
+	return &lambda.GetAccountSettingsOutput{
+		AccountLimit: &types.AccountLimit{
+			ConcurrentExecutions:           concurrentExecutions,
+			UnreservedConcurrentExecutions: aws.Int32(unreservedConcurrentExecutions),
+		},
+	}
+}
+
+func TestLambdaFunctionConcurrencyBudgetSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionConcurrencyBudgetSuite))
+}
@@ -0,0 +1,436 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	ecrmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/ecr_mock"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testImageFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	testImageTagURI      = "123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image:latest"
+	testImageDigest      = "sha256:3f1c5a9f8e2d4b6c7a8e9f0d1c2b3a4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c"
+	testNewImageDigest   = "sha256:9b0c8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b"
+	testImageDigestURI   = "123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image@" + testImageDigest
+)
+
+type LambdaFunctionImageDigestSuite struct {
+	suite.Suite
+	providerCtx provider.Context
+	loader      *testutils.MockAWSConfigLoader
+}
+
+func (s *LambdaFunctionImageDigestSuite) SetupTest() {
+	s.loader = &testutils.MockAWSConfigLoader{}
+	s.providerCtx = plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_create_function_deploys_resolved_image_digest() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesOutput(createTestDescribeImagesOutput(testImageDigest)),
+	)
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(testImageFunctionARN),
+		}),
+	)
+
+	testCase := s.imageDigestDeployTestCase(
+		"create function with a resolved image digest",
+		service,
+		&service.MockCalls,
+		createTestImageFunctionSpec(),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":         core.MappingNodeFromString(testImageFunctionARN),
+			"spec.imageDigest": core.MappingNodeFromString(testImageDigest),
+		},
+	}
+	testCase.SaveActionsCalled = map[string]any{
+		"CreateFunction": &lambda.CreateFunctionInput{
+			FunctionName: aws.String("test-function"),
+			Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
+			Code: &types.FunctionCode{
+				ImageUri: aws.String(testImageDigestURI),
+			},
+			PackageType: types.PackageTypeImage,
+		},
+	}
+
+	s.runImageDigestDeployTestCase(testCase, ecrService)
+
+	ecrService.AssertCalledWith(
+		&s.Suite,
+		"DescribeImages",
+		0,
+		plugintestutils.Any,
+		&ecr.DescribeImagesInput{
+			RegistryId:     aws.String("123456789012"),
+			RepositoryName: aws.String("test-image"),
+			ImageIds: []ecrtypes.ImageIdentifier{
+				{
+					ImageTag: aws.String("latest"),
+				},
+			},
+		},
+	)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_update_function_redeploys_image_when_digest_moves() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesOutput(createTestDescribeImagesOutput(testNewImageDigest)),
+	)
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionCodeOutput(&lambda.UpdateFunctionCodeOutput{}),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(testImageFunctionARN),
+			},
+			Code: &types.FunctionCodeLocation{
+				ImageUri: aws.String(
+					"123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image@" + testNewImageDigest,
+				),
+				ResolvedImageUri: aws.String(
+					"123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image@" + testNewImageDigest,
+				),
+			},
+		}),
+	)
+
+	testCase := s.imageDigestDeployTestCase(
+		"update function image when the tag resolves to a new digest",
+		service,
+		&service.MockCalls,
+		createTestImageFunctionSpec(),
+		createTestImageFunctionCurrentState(),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":         core.MappingNodeFromString(testImageFunctionARN),
+			"spec.imageDigest": core.MappingNodeFromString(testNewImageDigest),
+		},
+	}
+	testCase.SaveActionsCalled = map[string]any{
+		"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+			FunctionName: aws.String(testImageFunctionARN),
+			Publish:      true,
+			ImageUri: aws.String(
+				"123456789012.dkr.ecr.us-west-2.amazonaws.com/test-image@" + testNewImageDigest,
+			),
+		},
+	}
+	testCase.SaveActionsNotCalled = []string{"UpdateFunctionConfiguration"}
+
+	s.runImageDigestDeployTestCase(testCase, ecrService)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_update_function_skips_image_when_digest_is_unchanged() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesOutput(createTestDescribeImagesOutput(testImageDigest)),
+	)
+	service := lambdamock.CreateLambdaServiceMock()
+
+	testCase := s.imageDigestDeployTestCase(
+		"does not update function image when the tag resolves to the deployed digest",
+		service,
+		&service.MockCalls,
+		createTestImageFunctionSpec(),
+		createTestImageFunctionCurrentState(),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":         core.MappingNodeFromString(testImageFunctionARN),
+			"spec.imageDigest": core.MappingNodeFromString(testImageDigest),
+		},
+	}
+	testCase.SaveActionsNotCalled = []string{"UpdateFunctionCode", "UpdateFunctionConfiguration"}
+
+	s.runImageDigestDeployTestCase(testCase, ecrService)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_create_function_fails_when_image_tag_is_not_found() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesError(errors.New("ImageNotFoundException")),
+	)
+	service := lambdamock.CreateLambdaServiceMock()
+
+	testCase := s.imageDigestDeployTestCase(
+		"fails when the image tag can not be resolved",
+		service,
+		&service.MockCalls,
+		createTestImageFunctionSpec(),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.SaveActionsNotCalled = []string{"CreateFunction"}
+	testCase.ExpectError = true
+
+	s.runImageDigestDeployTestCase(testCase, ecrService)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_get_external_state_reports_deployed_image_digest() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesOutput(createTestDescribeImagesOutput(testImageDigest)),
+	)
+
+	output, err := s.getImageFunctionExternalState(ecrService)
+	s.Require().NoError(err)
+
+	specFields := output.ResourceSpecState.Fields
+	s.Equal(testImageDigest, core.StringValue(specFields["imageDigest"]))
+	s.Equal(testImageTagURI, core.StringValue(specFields["code"].Fields["imageUri"]))
+	s.True(core.BoolValue(specFields["code"].Fields["resolveImageDigest"]))
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_get_external_state_reports_drift_when_tag_moves() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesOutput(createTestDescribeImagesOutput(testNewImageDigest)),
+	)
+
+	output, err := s.getImageFunctionExternalState(ecrService)
+	s.Require().NoError(err)
+
+	specFields := output.ResourceSpecState.Fields
+	s.Equal(testImageDigest, core.StringValue(specFields["imageDigest"]))
+	s.Equal(testImageDigestURI, core.StringValue(specFields["code"].Fields["imageUri"]))
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_get_external_state_fails_when_image_tag_is_not_found() {
+	ecrService := ecrmock.CreateECRServiceMock(
+		ecrmock.WithDescribeImagesError(errors.New("image not found")),
+	)
+
+	_, err := s.getImageFunctionExternalState(ecrService)
+	s.Require().Error(err)
+	s.ErrorContains(err, "failed to resolve the digest of image")
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_parse_image_uri() {
+	testCases := []struct {
+		imageURI string
+		expected *imageReference
+	}{
+		{
+			imageURI: testImageTagURI,
+			expected: &imageReference{
+				registry:   "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+				registryID: "123456789012",
+				repository: "test-image",
+				tag:        "latest",
+			},
+		},
+		{
+			imageURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/team/service",
+			expected: &imageReference{
+				registry:   "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+				registryID: "123456789012",
+				repository: "team/service",
+				tag:        "latest",
+			},
+		},
+		{
+			imageURI: testImageDigestURI,
+			expected: &imageReference{
+				registry:   "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+				registryID: "123456789012",
+				repository: "test-image",
+				digest:     testImageDigest,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ref, err := parseImageURI(tc.imageURI)
+		s.Require().NoError(err)
+		s.Equal(tc.expected, ref, tc.imageURI)
+	}
+
+	_, err := parseImageURI("public.ecr.aws/lambda/nodejs:20")
+	s.Error(err)
+}
+
+func (s *LambdaFunctionImageDigestSuite) Test_resolve_image_digest_keeps_digest_uri() {
+	ecrService := ecrmock.CreateECRServiceMock()
+
+	resolved, err := resolveImageDigest(context.Background(), ecrService, testImageDigestURI)
+	s.Require().NoError(err)
+	s.Equal(&resolvedImage{uri: testImageDigestURI, digest: testImageDigest}, resolved)
+	ecrService.AssertNotCalled(&s.Suite, "DescribeImages")
+}
+
+func (s *LambdaFunctionImageDigestSuite) imageDigestDeployTestCase(
+	name string,
+	service lambdaservice.Service,
+	mockCalls *plugintestutils.MockCalls,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceInfo := provider.ResourceInfo{
+		ResourceID:   "test-function-id",
+		ResourceName: "TestFunction",
+		InstanceID:   "test-instance-id",
+		ResourceWithResolvedSubs: &provider.ResolvedResource{
+			Type: &schema.ResourceTypeWrapper{
+				Value: "aws/lambda/function",
+			},
+			Spec: specData,
+		},
+	}
+	if currentStateSpecData != nil {
+		resourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-function-id",
+			Name:       "TestFunction",
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: mockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			s.loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: resourceInfo,
+			},
+			ProviderContext: s.providerCtx,
+		},
+	}
+}
+
+func (s *LambdaFunctionImageDigestSuite) runImageDigestDeployTestCase(
+	testCase plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service],
+	ecrService ecrservice.Service,
+) {
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestFunctionResourceWithECR(
+			func(*aws.Config, provider.Context) ecrservice.Service {
+				return ecrService
+			},
+		),
+		&s.Suite,
+	)
+}
+
+func (s *LambdaFunctionImageDigestSuite) getImageFunctionExternalState(
+	ecrService ecrservice.Service,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionName:  aws.String("test-function"),
+				FunctionArn:   aws.String(testImageFunctionARN),
+				Role:          aws.String("arn:aws:iam::123456789012:role/test-role"),
+				PackageType:   types.PackageTypeImage,
+				Architectures: []types.Architecture{types.ArchitectureX8664},
+			},
+			Code: &types.FunctionCodeLocation{
+				ImageUri:         aws.String(testImageDigestURI),
+				ResolvedImageUri: aws.String(testImageDigestURI),
+			},
+		}),
+		lambdamock.WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+		lambdamock.WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+		lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+	)
+
+	return newTestFunctionResourceWithECR(
+		func(*aws.Config, provider.Context) ecrservice.Service {
+			return ecrService
+		},
+	)(
+		func(*aws.Config, provider.Context) lambdaservice.Service {
+			return service
+		},
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			s.loader,
+			utils.AWSConfigCacheKey,
+		),
+	).GetExternalState(
+		context.Background(),
+		&provider.ResourceGetExternalStateInput{
+			ProviderContext:     s.providerCtx,
+			CurrentResourceSpec: createTestImageFunctionCurrentState(),
+		},
+	)
+}
+
+func createTestImageFunctionSpec() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"packageType":  core.MappingNodeFromString("Image"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"imageUri":           core.MappingNodeFromString(testImageTagURI),
+					"resolveImageDigest": core.MappingNodeFromBool(true),
+				},
+			},
+		},
+	}
+}
+
+func createTestImageFunctionCurrentState() *core.MappingNode {
+	currentState := createTestImageFunctionSpec()
+	currentState.Fields["arn"] = core.MappingNodeFromString(testImageFunctionARN)
+	currentState.Fields["imageDigest"] = core.MappingNodeFromString(testImageDigest)
+	return currentState
+}
+
+func createTestDescribeImagesOutput(digest string) *ecr.DescribeImagesOutput {
+	return &ecr.DescribeImagesOutput{
+		ImageDetails: []ecrtypes.ImageDetail{
+			{
+				RegistryId:     aws.String("123456789012"),
+				RepositoryName: aws.String("test-image"),
+				ImageDigest:    aws.String(digest),
+				ImageTags:      []string{"latest"},
+			},
+		},
+	}
+}
+
+func TestLambdaFunctionImageDigestSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionImageDigestSuite))
+}
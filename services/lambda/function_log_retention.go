@@ -0,0 +1,67 @@
+package lambda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink-provider-aws/services/logs"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// functionLogGroupName returns the name of the log group that Lambda
+// writes the logs of a function to when a custom log group is not configured.
+func functionLogGroupName(functionARN string) string {
+	functionName := functionARN
+	// arn:{partition}:lambda:{region}:{account}:function:{name}
+	parts := strings.Split(functionARN, ":")
+	if len(parts) >= 7 && parts[5] == "function" {
+		functionName = parts[6]
+	}
+
+	return "/aws/lambda/" + functionName
+}
+
+// applyFunctionLogRetention sets the retention period of the default log group
+// of a function when loggingConfig.retentionInDays is set and differs from the
+// retention period applied in the previous deployment.
+// Lambda only creates the log group when the function is first invoked,
+// so the log group is created if it does not already exist.
+// The log group is not deleted or modified when the retention period is removed
+// from the function or the function is destroyed so that logs are not lost.
+func (l *lambdaFunctionResourceActions) applyFunctionLogRetention(
+	ctx context.Context,
+	providerContext provider.Context,
+	functionARN string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) error {
+	retentionInDays, hasRetention := pluginutils.GetValueByPath(
+		"$.loggingConfig.retentionInDays",
+		specData,
+	)
+	if !hasRetention {
+		return nil
+	}
+
+	currentRetentionInDays, hasCurrentRetention := pluginutils.GetValueByPath(
+		"$.loggingConfig.retentionInDays",
+		currentStateSpecData,
+	)
+	if hasCurrentRetention && core.IntValue(currentRetentionInDays) == core.IntValue(retentionInDays) {
+		return nil
+	}
+
+	logsService, err := l.getLogsService(ctx, providerContext)
+	if err != nil {
+		return err
+	}
+
+	return logs.EnsureLogGroupRetention(
+		ctx,
+		logsService,
+		functionLogGroupName(functionARN),
+		int32(core.IntValue(retentionInDays)),
+	)
+}
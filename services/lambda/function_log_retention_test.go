@@ -0,0 +1,274 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testLogRetentionFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	testLogRetentionLogGroup    = "/aws/lambda/test-function"
+)
+
+type LambdaFunctionLogRetentionSuite struct {
+	suite.Suite
+	providerCtx provider.Context
+	loader      *testutils.MockAWSConfigLoader
+}
+
+func (s *LambdaFunctionLogRetentionSuite) SetupTest() {
+	s.loader = &testutils.MockAWSConfigLoader{}
+	s.providerCtx = plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func (s *LambdaFunctionLogRetentionSuite) Test_create_function_sets_log_group_retention() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(testLogRetentionFunctionARN),
+		}),
+	)
+	logsService := logsmock.CreateLogsServiceMock()
+
+	testCase := s.logRetentionDeployTestCase(
+		"create function and set the retention period of the default log group",
+		service,
+		&service.MockCalls,
+		createTestLogRetentionFunctionSpec(30),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(testLogRetentionFunctionARN),
+		},
+	}
+
+	s.runLogRetentionDeployTestCase(testCase, logsService)
+	logsService.AssertCalledWith(
+		&s.Suite,
+		"CreateLogGroup",
+		0,
+		plugintestutils.Any,
+		&cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(testLogRetentionLogGroup),
+		},
+	)
+	logsService.AssertCalledWith(
+		&s.Suite,
+		"PutRetentionPolicy",
+		0,
+		plugintestutils.Any,
+		&cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(testLogRetentionLogGroup),
+			RetentionInDays: aws.Int32(30),
+		},
+	)
+}
+
+func (s *LambdaFunctionLogRetentionSuite) Test_update_function_changes_log_group_retention() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionConfigurationOutput(&lambda.UpdateFunctionConfigurationOutput{}),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(testLogRetentionFunctionARN),
+			},
+		}),
+	)
+	logsService := logsmock.CreateLogsServiceMock()
+
+	testCase := s.logRetentionDeployTestCase(
+		"update the retention period of the default log group",
+		service,
+		&service.MockCalls,
+		createTestLogRetentionFunctionSpec(90),
+		createTestLogRetentionFunctionCurrentState(30),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(testLogRetentionFunctionARN),
+		},
+	}
+
+	s.runLogRetentionDeployTestCase(testCase, logsService)
+	logsService.AssertCalledWith(
+		&s.Suite,
+		"PutRetentionPolicy",
+		0,
+		plugintestutils.Any,
+		&cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(testLogRetentionLogGroup),
+			RetentionInDays: aws.Int32(90),
+		},
+	)
+}
+
+func (s *LambdaFunctionLogRetentionSuite) Test_update_function_skips_unchanged_log_group_retention() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionConfigurationOutput(&lambda.UpdateFunctionConfigurationOutput{}),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(testLogRetentionFunctionARN),
+			},
+		}),
+	)
+	logsService := logsmock.CreateLogsServiceMock()
+
+	testCase := s.logRetentionDeployTestCase(
+		"skip the default log group when the retention period is unchanged",
+		service,
+		&service.MockCalls,
+		createTestLogRetentionFunctionSpec(30),
+		createTestLogRetentionFunctionCurrentState(30),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(testLogRetentionFunctionARN),
+		},
+	}
+
+	s.runLogRetentionDeployTestCase(testCase, logsService)
+	logsService.AssertNotCalled(&s.Suite, "CreateLogGroup")
+	logsService.AssertNotCalled(&s.Suite, "PutRetentionPolicy")
+}
+
+func (s *LambdaFunctionLogRetentionSuite) Test_validate_log_retention_with_custom_log_group() {
+	specData := createTestLogRetentionFunctionSpec(30)
+	specData.Fields["loggingConfig"].Fields["logGroup"] = core.MappingNodeFromString("/orders/api")
+
+	diagnostics := validateLogRetentionInDays(
+		"$.loggingConfig.retentionInDays",
+		specData.Fields["loggingConfig"].Fields["retentionInDays"],
+		&schema.Resource{Spec: specData},
+	)
+	s.Require().Len(diagnostics, 1)
+	s.Equal(core.DiagnosticLevelError, diagnostics[0].Level)
+	s.Contains(diagnostics[0].Message, "$.loggingConfig.logGroup")
+
+	delete(specData.Fields["loggingConfig"].Fields, "logGroup")
+	diagnostics = validateLogRetentionInDays(
+		"$.loggingConfig.retentionInDays",
+		specData.Fields["loggingConfig"].Fields["retentionInDays"],
+		&schema.Resource{Spec: specData},
+	)
+	s.Empty(diagnostics)
+}
+
+func (s *LambdaFunctionLogRetentionSuite) logRetentionDeployTestCase(
+	name string,
+	service lambdaservice.Service,
+	mockCalls *plugintestutils.MockCalls,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceInfo := provider.ResourceInfo{
+		ResourceID:   "test-function-id",
+		ResourceName: "TestFunction",
+		InstanceID:   "test-instance-id",
+		ResourceWithResolvedSubs: &provider.ResolvedResource{
+			Type: &schema.ResourceTypeWrapper{
+				Value: "aws/lambda/function",
+			},
+			Spec: specData,
+		},
+	}
+	if currentStateSpecData != nil {
+		resourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-function-id",
+			Name:       "TestFunction",
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: mockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			s.loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: resourceInfo,
+			},
+			ProviderContext: s.providerCtx,
+		},
+	}
+}
+
+func (s *LambdaFunctionLogRetentionSuite) runLogRetentionDeployTestCase(
+	testCase plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service],
+	logsService logsservice.Service,
+) {
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestFunctionResourceWithLogs(
+			func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+				return logsService
+			},
+		),
+		&s.Suite,
+	)
+}
+
+func createTestLogRetentionFunctionSpec(retentionInDays int) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"runtime":      core.MappingNodeFromString("java21"),
+			"handler":      core.MappingNodeFromString("example.Handler::handleRequest"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("test-key.zip"),
+				},
+			},
+			"loggingConfig": {
+				Fields: map[string]*core.MappingNode{
+					"retentionInDays": core.MappingNodeFromInt(retentionInDays),
+				},
+			},
+		},
+	}
+}
+
+func createTestLogRetentionFunctionCurrentState(retentionInDays int) *core.MappingNode {
+	currentState := createTestLogRetentionFunctionSpec(retentionInDays)
+	currentState.Fields["arn"] = core.MappingNodeFromString(testLogRetentionFunctionARN)
+	return currentState
+}
+
+func TestLambdaFunctionLogRetentionSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionLogRetentionSuite))
+}
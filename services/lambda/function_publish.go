@@ -0,0 +1,71 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// shouldPublishFunctionVersion determines whether a new version of a function
+// should be published after it has been created or updated.
+// A version is published when the function opts in with the publish field
+// and either the function has been modified or a version has not yet been
+// published by the provider, such as when publish is first enabled.
+func shouldPublishFunctionVersion(
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	hasUpdates bool,
+) bool {
+	publish, _ := pluginutils.GetValueByPath("$.publish", specData)
+	if !core.BoolValue(publish) {
+		return false
+	}
+
+	_, hasPublishedVersion := pluginutils.GetValueByPath(
+		"$.latestPublishedVersion",
+		currentStateSpecData,
+	)
+	return hasUpdates || !hasPublishedVersion
+}
+
+// publishFunctionVersion publishes a version from the current code and configuration
+// of a function once any in-progress creation or update of the function has completed.
+// Lambda does not publish a new version when the code and configuration have not changed
+// since the last published version, the last published version is returned instead.
+func publishFunctionVersion(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	waiter *functionUpdateWaiter,
+	functionARN string,
+) (map[string]*core.MappingNode, error) {
+	var output *lambda.PublishVersionOutput
+	err := waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		functionARN,
+		func() error {
+			var publishErr error
+			output, publishErr = lambdaService.PublishVersion(
+				ctx,
+				&lambda.PublishVersionInput{
+					FunctionName: aws.String(functionARN),
+				},
+			)
+			return publishErr
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish a version of function %s: %w", functionARN, err)
+	}
+
+	version := aws.ToString(output.Version)
+	return map[string]*core.MappingNode{
+		"spec.latestPublishedVersion":    core.MappingNodeFromString(version),
+		"spec.latestPublishedVersionArn": core.MappingNodeFromString(functionARN + ":" + version),
+	}, nil
+}
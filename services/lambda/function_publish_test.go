@@ -0,0 +1,293 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const testPublishFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+type LambdaFunctionPublishSuite struct {
+	suite.Suite
+	providerCtx provider.Context
+	loader      *testutils.MockAWSConfigLoader
+}
+
+func (s *LambdaFunctionPublishSuite) SetupTest() {
+	s.loader = &testutils.MockAWSConfigLoader{}
+	s.providerCtx = plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func (s *LambdaFunctionPublishSuite) Test_create_function_publishes_version() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(testPublishFunctionARN),
+		}),
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			Version: aws.String("1"),
+		}),
+	)
+
+	testCase := s.publishDeployTestCase(
+		"create function and publish a version",
+		service,
+		&service.MockCalls,
+		createTestPublishFunctionSpec("Test function", true),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":                       core.MappingNodeFromString(testPublishFunctionARN),
+			"spec.latestPublishedVersion":    core.MappingNodeFromString("1"),
+			"spec.latestPublishedVersionArn": core.MappingNodeFromString(testPublishFunctionARN + ":1"),
+		},
+	}
+	testCase.SaveActionsCalled = map[string]any{
+		"PublishVersion": &lambda.PublishVersionInput{
+			FunctionName: aws.String(testPublishFunctionARN),
+		},
+	}
+
+	s.runPublishDeployTestCase(testCase)
+}
+
+func (s *LambdaFunctionPublishSuite) Test_create_function_does_not_publish_by_default() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(testPublishFunctionARN),
+		}),
+	)
+
+	testCase := s.publishDeployTestCase(
+		"create function without publishing a version",
+		service,
+		&service.MockCalls,
+		createTestPublishFunctionSpec("Test function", false),
+		/* currentStateSpecData */ nil,
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(testPublishFunctionARN),
+		},
+	}
+	testCase.SaveActionsNotCalled = []string{"PublishVersion"}
+
+	s.runPublishDeployTestCase(testCase)
+}
+
+func (s *LambdaFunctionPublishSuite) Test_update_function_publishes_version_on_change() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionConfigurationOutput(&lambda.UpdateFunctionConfigurationOutput{}),
+		lambdamock.WithGetFunctionOutput(createTestPublishGetFunctionOutput()),
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			Version: aws.String("2"),
+		}),
+	)
+
+	testCase := s.publishDeployTestCase(
+		"update function and publish a new version",
+		service,
+		&service.MockCalls,
+		createTestPublishFunctionSpec("Updated test function", true),
+		createTestPublishFunctionCurrentState(true, "1"),
+	)
+	testCase.Input.Changes.ModifiedFields = []provider.FieldChange{
+		{
+			FieldPath: "spec.description",
+		},
+	}
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":                       core.MappingNodeFromString(testPublishFunctionARN),
+			"spec.latestPublishedVersion":    core.MappingNodeFromString("2"),
+			"spec.latestPublishedVersionArn": core.MappingNodeFromString(testPublishFunctionARN + ":2"),
+		},
+	}
+	testCase.SaveActionsCalled = map[string]any{
+		"PublishVersion": &lambda.PublishVersionInput{
+			FunctionName: aws.String(testPublishFunctionARN),
+		},
+	}
+
+	s.runPublishDeployTestCase(testCase)
+}
+
+func (s *LambdaFunctionPublishSuite) Test_update_function_publishes_version_when_publish_is_enabled() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(createTestPublishGetFunctionOutput()),
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			Version: aws.String("1"),
+		}),
+	)
+
+	testCase := s.publishDeployTestCase(
+		"publish a version when publish is enabled for an unchanged function",
+		service,
+		&service.MockCalls,
+		createTestPublishFunctionSpec("Test function", true),
+		createTestPublishFunctionCurrentState(false, ""),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":                       core.MappingNodeFromString(testPublishFunctionARN),
+			"spec.latestPublishedVersion":    core.MappingNodeFromString("1"),
+			"spec.latestPublishedVersionArn": core.MappingNodeFromString(testPublishFunctionARN + ":1"),
+		},
+	}
+	testCase.SaveActionsCalled = map[string]any{
+		"PublishVersion": &lambda.PublishVersionInput{
+			FunctionName: aws.String(testPublishFunctionARN),
+		},
+	}
+	testCase.SaveActionsNotCalled = []string{"UpdateFunctionConfiguration", "UpdateFunctionCode"}
+
+	s.runPublishDeployTestCase(testCase)
+}
+
+func (s *LambdaFunctionPublishSuite) Test_update_function_keeps_published_version_when_unchanged() {
+	service := lambdamock.CreateLambdaServiceMock()
+
+	testCase := s.publishDeployTestCase(
+		"keep the latest published version when the function is unchanged",
+		service,
+		&service.MockCalls,
+		createTestPublishFunctionSpec("Test function", true),
+		createTestPublishFunctionCurrentState(true, "3"),
+	)
+	testCase.ExpectedOutput = &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":                       core.MappingNodeFromString(testPublishFunctionARN),
+			"spec.latestPublishedVersion":    core.MappingNodeFromString("3"),
+			"spec.latestPublishedVersionArn": core.MappingNodeFromString(testPublishFunctionARN + ":3"),
+		},
+	}
+	testCase.SaveActionsNotCalled = []string{"PublishVersion", "UpdateFunctionConfiguration"}
+
+	s.runPublishDeployTestCase(testCase)
+}
+
+func (s *LambdaFunctionPublishSuite) publishDeployTestCase(
+	name string,
+	service lambdaservice.Service,
+	mockCalls *plugintestutils.MockCalls,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceInfo := provider.ResourceInfo{
+		ResourceID:   "test-function-id",
+		ResourceName: "TestFunction",
+		InstanceID:   "test-instance-id",
+		ResourceWithResolvedSubs: &provider.ResolvedResource{
+			Type: &schema.ResourceTypeWrapper{
+				Value: "aws/lambda/function",
+			},
+			Spec: specData,
+		},
+	}
+	if currentStateSpecData != nil {
+		resourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-function-id",
+			Name:       "TestFunction",
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: mockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			s.loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: resourceInfo,
+			},
+			ProviderContext: s.providerCtx,
+		},
+	}
+}
+
+func (s *LambdaFunctionPublishSuite) runPublishDeployTestCase(
+	testCase plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service],
+) {
+	plugintestutils.RunResourceDeployTestCases(
+		[]plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{testCase},
+		newTestFunctionResource,
+		&s.Suite,
+	)
+}
+
+func createTestPublishFunctionSpec(description string, publish bool) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"runtime":      core.MappingNodeFromString("java21"),
+			"handler":      core.MappingNodeFromString("example.Handler::handleRequest"),
+			"description":  core.MappingNodeFromString(description),
+			"publish":      core.MappingNodeFromBool(publish),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("test-key.zip"),
+				},
+			},
+		},
+	}
+}
+
+func createTestPublishFunctionCurrentState(publish bool, latestPublishedVersion string) *core.MappingNode {
+	currentState := createTestPublishFunctionSpec("Test function", publish)
+	currentState.Fields["arn"] = core.MappingNodeFromString(testPublishFunctionARN)
+	if latestPublishedVersion != "" {
+		currentState.Fields["latestPublishedVersion"] = core.MappingNodeFromString(
+			latestPublishedVersion,
+		)
+		currentState.Fields["latestPublishedVersionArn"] = core.MappingNodeFromString(
+			testPublishFunctionARN + ":" + latestPublishedVersion,
+		)
+	}
+	return currentState
+}
+
+func createTestPublishGetFunctionOutput() *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionArn: aws.String(testPublishFunctionARN),
+		},
+	}
+}
+
+func TestLambdaFunctionPublishSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionPublishSuite))
+}
@@ -5,7 +5,11 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
@@ -14,15 +18,44 @@ import (
 // FunctionResource returns a resource implementation for an AWS Lambda Function.
 func FunctionResource(
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3service.Service],
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecrservice.Service],
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
 	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newFunctionResource(
+		lambdaServiceFactory,
+		s3ServiceFactory,
+		ecrServiceFactory,
+		logsServiceFactory,
+		awsConfigStore,
+		defaultFunctionUpdateWaiter(),
+	)
+}
+
+func newFunctionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3service.Service],
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecrservice.Service],
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	updateWaiter *functionUpdateWaiter,
 ) provider.Resource {
 	yamlExample, _ := examples.ReadFile("examples/resources/lambda_function_yaml.md")
 	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_function_jsonc.md")
 	yamlInlineExample, _ := examples.ReadFile("examples/resources/lambda_function_inline_yaml.md")
+	yamlLocalSourceExample, _ := examples.ReadFile("examples/resources/lambda_function_local_source_yaml.md")
+	yamlImageDigestExample, _ := examples.ReadFile("examples/resources/lambda_function_image_digest_yaml.md")
+	yamlPublishExample, _ := examples.ReadFile("examples/resources/lambda_function_publish_yaml.md")
 
 	lambdaFunctionActions := &lambdaFunctionResourceActions{
 		lambdaServiceFactory,
+		s3ServiceFactory,
+		ecrServiceFactory,
+		logsServiceFactory,
 		awsConfigStore,
+		updateWaiter,
+		newConcurrencyBudget(),
 	}
 	return &providerv1.ResourceDefinition{
 		Type:             "aws/lambda/function",
@@ -40,19 +73,33 @@ func FunctionResource(
 			string(yamlExample),
 			string(jsoncExample),
 			string(yamlInlineExample),
+			string(yamlLocalSourceExample),
+			string(yamlImageDigestExample),
+			string(yamlPublishExample),
+		},
+		ResourceCanLinkTo: []string{
+			"aws/lambda/function",
+			"aws/sqs/queue",
+			"aws/sns/topic",
+			"aws/events/eventBus",
 		},
-		ResourceCanLinkTo:    []string{},
 		GetExternalStateFunc: lambdaFunctionActions.GetExternalState,
 		CreateFunc:           lambdaFunctionActions.Create,
 		UpdateFunc:           lambdaFunctionActions.Update,
 		DestroyFunc:          lambdaFunctionActions.Destroy,
 		StabilisedFunc:       lambdaFunctionActions.Stabilised,
+		CustomValidateFunc:   lambdaFunctionActions.CustomValidate,
 	}
 }
 
 type lambdaFunctionResourceActions struct {
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	s3ServiceFactory     pluginutils.ServiceFactory[*aws.Config, s3service.Service]
+	ecrServiceFactory    pluginutils.ServiceFactory[*aws.Config, ecrservice.Service]
+	logsServiceFactory   pluginutils.ServiceFactory[*aws.Config, logsservice.Service]
 	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	updateWaiter         *functionUpdateWaiter
+	concurrencyBudget    *concurrencyBudget
 }
 
 func (l *lambdaFunctionResourceActions) getLambdaService(
@@ -70,3 +117,98 @@ func (l *lambdaFunctionResourceActions) getLambdaService(
 
 	return l.lambdaServiceFactory(awsConfig, providerContext), nil
 }
+
+func (l *lambdaFunctionResourceActions) getS3Service(
+	ctx context.Context,
+	providerContext provider.Context,
+) (s3service.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.s3ServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaFunctionResourceActions) getECRService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (ecrservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.ecrServiceFactory(awsConfig, providerContext), nil
+}
+
+func (l *lambdaFunctionResourceActions) getLogsService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (logsservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.logsServiceFactory(awsConfig, providerContext), nil
+}
+
+// packageFunctionCode builds the deployment package for a function
+// that sources its code from a local directory or glob pattern.
+// This returns nil when the function does not use a local code source.
+func (l *lambdaFunctionResourceActions) packageFunctionCode(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*packagedCode, error) {
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	sourceData, hasSource := pluginutils.GetValueByPath("$.code.source", specData)
+	if !hasSource {
+		return nil, nil
+	}
+
+	s3Service, err := l.getS3Service(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deployed code hash is only available when updating an existing function.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	deployedSHA256, _ := pluginutils.GetValueByPath("$.codeSha256", currentStateSpecData)
+
+	return packageCodeSource(ctx, sourceData, core.StringValue(deployedSHA256), s3Service)
+}
+
+// resolveFunctionImage resolves the tag in the image URI of a function
+// to the digest of the image that it currently points to in Amazon ECR.
+// This returns nil when the function has not opted in to image digest resolution.
+func (l *lambdaFunctionResourceActions) resolveFunctionImage(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*resolvedImage, error) {
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	resolveDigest, _ := pluginutils.GetValueByPath("$.code.resolveImageDigest", specData)
+	imageURI, hasImageURI := pluginutils.GetValueByPath("$.code.imageUri", specData)
+	if !core.BoolValue(resolveDigest) || !hasImageURI {
+		return nil, nil
+	}
+
+	ecrService, err := l.getECRService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveImageDigest(ctx, ecrService, core.StringValue(imageURI))
+}
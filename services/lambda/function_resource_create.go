@@ -3,6 +3,7 @@ package lambda
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -22,6 +23,16 @@ func (l *lambdaFunctionResourceActions) Create(
 		return nil, err
 	}
 
+	packaged, err := l.packageFunctionCode(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := l.resolveFunctionImage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
 	createOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
 		&functionCreate{},
 		&functionConcurrencyUpdate{},
@@ -35,7 +46,10 @@ func (l *lambdaFunctionResourceActions) Create(
 	hasUpdates, saveOpCtx, err := pluginutils.RunSaveOperations(
 		ctx,
 		pluginutils.SaveOperationContext{
-			Data: map[string]any{},
+			Data: map[string]any{
+				"packagedCode":  packaged,
+				"resolvedImage": resolved,
+			},
 		},
 		createOperations,
 		input,
@@ -58,6 +72,41 @@ func (l *lambdaFunctionResourceActions) Create(
 		"spec.arn": core.MappingNodeFromString(aws.ToString(createFunctionOutput.FunctionArn)),
 	}
 
+	if createFunctionOutput.CodeSha256 != nil {
+		computedFields["spec.codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(createFunctionOutput.CodeSha256),
+		)
+	}
+
+	if resolved != nil {
+		computedFields["spec.imageDigest"] = core.MappingNodeFromString(resolved.digest)
+	}
+
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	if shouldPublishFunctionVersion(specData, nil, true) {
+		publishedFields, err := publishFunctionVersion(
+			ctx,
+			lambdaService,
+			l.updateWaiter,
+			aws.ToString(createFunctionOutput.FunctionArn),
+		)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(computedFields, publishedFields)
+	}
+
+	err = l.applyFunctionLogRetention(
+		ctx,
+		input.ProviderContext,
+		aws.ToString(createFunctionOutput.FunctionArn),
+		specData,
+		/* currentStateSpecData */ nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	if createFunctionOutput.SnapStart != nil {
 		computedFields["spec.snapStartResponseApplyOn"] = core.MappingNodeFromString(
 			string(createFunctionOutput.SnapStart.ApplyOn),
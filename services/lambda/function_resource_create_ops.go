@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -30,6 +31,17 @@ func (u *functionCreate) Prepare(
 	if err != nil {
 		return false, saveOpCtx, err
 	}
+
+	if packaged, ok := getPackagedCode(saveOpCtx); ok {
+		if input.Code == nil {
+			input.Code = &types.FunctionCode{}
+		}
+		setFunctionCodeFromPackagedCode(input.Code, packaged)
+	}
+
+	if resolved, ok := getResolvedImage(saveOpCtx); ok {
+		input.Code.ImageUri = aws.String(resolved.uri)
+	}
 	u.input = input
 	return hasValues, saveOpCtx, nil
 }
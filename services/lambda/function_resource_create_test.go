@@ -23,6 +23,7 @@ type LambdaFunctionResourceCreateSuite struct {
 }
 
 func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function() {
+	sourceDir, archive := createTestCodeSourceDir(s.T())
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -42,11 +43,12 @@ func (s *LambdaFunctionResourceCreateSuite) Test_create_lambda_function() {
 		createFunctionWithMultipleConfigsTestCase(providerCtx, loader),
 		createFunctionWithAdvancedConfigsTestCase(providerCtx, loader),
 		createFunctionWithAllCodeSourceFieldsTestCase(providerCtx, loader),
+		createFunctionFromLocalCodeSourceTestCase(providerCtx, loader, sourceDir, archive),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		FunctionResource,
+		newTestFunctionResource,
 		&s.Suite,
 	)
 }
@@ -987,6 +989,92 @@ func createFunctionWithAllCodeSourceFieldsTestCase(
 	}
 }
 
+func createFunctionFromLocalCodeSourceTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	archive *utils.ZipArchive,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithCreateFunctionOutput(&lambda.CreateFunctionOutput{
+			FunctionArn: aws.String(resourceARN),
+			CodeSha256:  aws.String(archive.SHA256),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs20.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create function from a local code source",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{FieldPath: "spec.functionName"},
+					{FieldPath: "spec.runtime"},
+					{FieldPath: "spec.handler"},
+					{FieldPath: "spec.role"},
+					{FieldPath: "spec.code"},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(archive.SHA256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateFunction": &lambda.CreateFunctionInput{
+				FunctionName: aws.String("test-function"),
+				Runtime:      types.RuntimeNodejs20x,
+				Handler:      aws.String("index.handler"),
+				Role:         aws.String("arn:aws:iam::123456789012:role/test-role"),
+				Code: &types.FunctionCode{
+					ZipFile: archive.Content,
+				},
+			},
+		},
+	}
+}
+
 func TestLambdaFunctionResourceCreate(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceCreateSuite))
 }
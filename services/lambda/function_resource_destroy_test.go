@@ -40,7 +40,7 @@ func (s *LambdaFunctionResourceDestroySuite) Test_destroy() {
 
 	plugintestutils.RunResourceDestroyTestCases(
 		testCases,
-		FunctionResource,
+		newTestFunctionResource,
 		&s.Suite,
 	)
 }
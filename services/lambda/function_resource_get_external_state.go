@@ -61,11 +61,76 @@ func (l *lambdaFunctionResourceActions) GetExternalState(
 
 	l.addComputedFieldsToSpec(functionOutput, resourceSpecState.Fields)
 
+	err = l.addImageTagDriftToSpec(
+		ctx,
+		input,
+		functionOutput,
+		resourceSpecState.Fields["code"],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The retention period of the default log group is applied through CloudWatch Logs
+	// and is not part of the function configuration, so it is carried over from the current spec.
+	carryOverLogRetention(input.CurrentResourceSpec, resourceSpecState)
+
+	// Publishing is applied by the provider after the function is saved
+	// and is not stored in AWS, so the publish flag and the most recently
+	// published version are carried over from the current spec.
+	for _, field := range []string{"publish", "latestPublishedVersion", "latestPublishedVersionArn"} {
+		if value, hasValue := input.CurrentResourceSpec.Fields[field]; hasValue {
+			resourceSpecState.Fields[field] = value
+		}
+	}
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
 }
 
+// addImageTagDriftToSpec reports the deployed image URI in place of the image URI
+// with the tag from the input spec when the tag no longer points to the deployed image.
+// Pushing a new image with the same tag does not change the function configuration,
+// so the digest that the tag currently resolves to in Amazon ECR is compared
+// with the digest of the deployed image to detect that the function has drifted.
+func (l *lambdaFunctionResourceActions) addImageTagDriftToSpec(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+	functionOutput *lambda.GetFunctionOutput,
+	codeSpecState *core.MappingNode,
+) error {
+	inputSpecCode := input.CurrentResourceSpec.Fields["code"]
+	if inputSpecCode == nil ||
+		!core.BoolValue(inputSpecCode.Fields["resolveImageDigest"]) ||
+		functionOutput.Code == nil ||
+		functionOutput.Code.ResolvedImageUri == nil {
+		return nil
+	}
+
+	imageURI := core.StringValue(inputSpecCode.Fields["imageUri"])
+	if imageURI == "" {
+		return nil
+	}
+
+	ecrService, err := l.getECRService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	resolved, err := resolveImageDigest(ctx, ecrService, imageURI)
+	if err != nil {
+		return err
+	}
+
+	deployedImageURI := aws.ToString(functionOutput.Code.ResolvedImageUri)
+	if resolved.digest != imageDigestFromURI(deployedImageURI) {
+		codeSpecState.Fields["imageUri"] = core.MappingNodeFromString(deployedImageURI)
+	}
+
+	return nil
+}
+
 func (l *lambdaFunctionResourceActions) buildBaseResourceSpecState(
 	functionOutput *lambda.GetFunctionOutput,
 	inputSpecCode *core.MappingNode,
@@ -80,6 +145,7 @@ func (l *lambdaFunctionResourceActions) buildBaseResourceSpecState(
 			),
 			"code": functionCodeConfigToMappingNode(
 				functionOutput.Code,
+				aws.ToString(functionOutput.Configuration.CodeSha256),
 				inputSpecCode,
 			),
 			"functionName": core.MappingNodeFromString(
@@ -397,6 +463,18 @@ func (l *lambdaFunctionResourceActions) addComputedFieldsToSpec(
 		aws.ToString(functionOutput.Configuration.FunctionArn),
 	)
 
+	if functionOutput.Configuration.CodeSha256 != nil {
+		specFields["codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(functionOutput.Configuration.CodeSha256),
+		)
+	}
+
+	if functionOutput.Code != nil && functionOutput.Code.ResolvedImageUri != nil {
+		specFields["imageDigest"] = core.MappingNodeFromString(
+			imageDigestFromURI(aws.ToString(functionOutput.Code.ResolvedImageUri)),
+		)
+	}
+
 	if functionOutput.Configuration.SnapStart != nil {
 		specFields["snapStartResponseApplyOn"] = core.MappingNodeFromString(
 			string(functionOutput.Configuration.SnapStart.ApplyOn),
@@ -487,6 +565,7 @@ func (l *lambdaFunctionResourceActions) addConcurrencyConfigToSpec(
 
 func functionCodeConfigToMappingNode(
 	code *types.FunctionCodeLocation,
+	deployedCodeSHA256 string,
 	inputSpecCode *core.MappingNode,
 ) *core.MappingNode {
 	fields := map[string]*core.MappingNode{}
@@ -509,10 +588,41 @@ func functionCodeConfigToMappingNode(
 		if zipFile, hasZipFile := inputSpecCode.Fields["zipFile"]; hasZipFile {
 			fields["zipFile"] = zipFile
 		}
+		// The local code source is omitted when the files that it describes no longer
+		// package into the deployed code so that changes to the files are reported as drift.
+		if source, hasSource := inputSpecCode.Fields["source"]; hasSource &&
+			codeSourceMatchesDeployedCode(source, deployedCodeSHA256) {
+			fields["source"] = source
+		}
+		// The source code hash is an input that is only used by the provider to
+		// determine when code should be redeployed, it is only carried over when
+		// it matches the hash of the deployed code, otherwise the deployed hash is reported
+		// so that code that has been changed outside of the blueprint is reported as drift.
+		if sourceCodeHash, hasSourceCodeHash := inputSpecCode.Fields["sourceCodeHash"]; hasSourceCodeHash {
+			if core.StringValue(sourceCodeHash) == deployedCodeSHA256 {
+				fields["sourceCodeHash"] = sourceCodeHash
+			} else {
+				fields["sourceCodeHash"] = core.MappingNodeFromString(deployedCodeSHA256)
+			}
+		}
+		if resolveImageDigest, hasResolveImageDigest := inputSpecCode.Fields["resolveImageDigest"]; hasResolveImageDigest {
+			fields["resolveImageDigest"] = resolveImageDigest
+		}
 	}
 
 	if code.ImageUri != nil {
 		fields["imageUri"] = core.MappingNodeFromString(aws.ToString(code.ImageUri))
+		// When the image digest is resolved by the provider, the function is deployed
+		// with an image URI that references the image by digest,
+		// the image URI with the tag from the input spec is reported instead
+		// so that resolving a tag is not reported as drift.
+		// A tag that has moved to a different image is reported as drift
+		// by addImageTagDriftToSpec.
+		if inputSpecCode != nil && core.BoolValue(inputSpecCode.Fields["resolveImageDigest"]) {
+			if imageURI, hasImageURI := inputSpecCode.Fields["imageUri"]; hasImageURI {
+				fields["imageUri"] = imageURI
+			}
+		}
 	}
 
 	if code.SourceKMSKeyArn != nil {
@@ -769,3 +879,22 @@ func functionVPCConfigToMappingNode(
 		Fields: fields,
 	}
 }
+
+func carryOverLogRetention(currentSpec *core.MappingNode, resourceSpecState *core.MappingNode) {
+	retentionInDays, hasRetention := pluginutils.GetValueByPath(
+		"$.loggingConfig.retentionInDays",
+		currentSpec,
+	)
+	if !hasRetention {
+		return
+	}
+
+	loggingConfig, hasLoggingConfig := resourceSpecState.Fields["loggingConfig"]
+	if !hasLoggingConfig {
+		loggingConfig = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		}
+		resourceSpecState.Fields["loggingConfig"] = loggingConfig
+	}
+	loggingConfig.Fields["retentionInDays"] = retentionInDays
+}
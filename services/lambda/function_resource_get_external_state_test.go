@@ -23,6 +23,9 @@ type LambdaFunctionResourceGetExternalStateSuite struct {
 }
 
 func (s *LambdaFunctionResourceGetExternalStateSuite) Test_get_external_state() {
+	sourceDir, archive := createTestCodeSourceDir(s.T())
+	changedSourceDir, changedArchive := createTestCodeSourceDir(s.T())
+	changeTestCodeSourceFiles(s.T(), changedSourceDir)
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -42,11 +45,29 @@ func (s *LambdaFunctionResourceGetExternalStateSuite) Test_get_external_state()
 		createEphemeralStorageTestCase(providerCtx, loader),
 		createImageConfigTestCase(providerCtx, loader),
 		createTracingAndRuntimeVersionTestCase(providerCtx, loader),
+		createDeployedCodeSha256TestCase(providerCtx, loader),
+		createMatchingSourceCodeHashTestCase(providerCtx, loader),
+		createCodeSourceTestCase(
+			"reports the code source when the files match the deployed code",
+			providerCtx,
+			loader,
+			sourceDir,
+			archive,
+			/* expectSource */ true,
+		),
+		createCodeSourceTestCase(
+			"omits the code source when the files have changed since the code was deployed",
+			providerCtx,
+			loader,
+			changedSourceDir,
+			changedArchive,
+			/* expectSource */ false,
+		),
 	}
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
 		testCases,
-		FunctionResource,
+		newTestFunctionResource,
 		&s.Suite,
 	)
 }
@@ -631,3 +652,204 @@ func createTracingAndRuntimeVersionTestCase(
 		ExpectError: false,
 	}
 }
+
+func createDeployedCodeSha256TestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		types.RuntimeNodejs18x,
+		"index.handler",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.CodeSha256 = aws.String("deployed-code-sha256")
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "reports the deployed code hash in place of a source code hash that does not match the deployed code",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetFunctionOutput(functionOutput),
+			lambdamock.WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			lambdamock.WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+							"s3Key":          core.MappingNodeFromString("test-key"),
+							"sourceCodeHash": core.MappingNodeFromString("expected-code-sha256"),
+						},
+					},
+					"codeSha256": core.MappingNodeFromString("expected-code-sha256"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"runtime":      core.MappingNodeFromString("nodejs18.x"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+							"s3Key":          core.MappingNodeFromString("test-key"),
+							"sourceCodeHash": core.MappingNodeFromString("deployed-code-sha256"),
+						},
+					},
+					"arn":        core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"codeSha256": core.MappingNodeFromString("deployed-code-sha256"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createMatchingSourceCodeHashTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		types.RuntimeNodejs18x,
+		"index.handler",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.CodeSha256 = aws.String("deployed-code-sha256")
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "carries over the source code hash when it matches the deployed code",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetFunctionOutput(functionOutput),
+			lambdamock.WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			lambdamock.WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+							"s3Key":          core.MappingNodeFromString("test-key"),
+							"sourceCodeHash": core.MappingNodeFromString("deployed-code-sha256"),
+						},
+					},
+					"codeSha256": core.MappingNodeFromString("deployed-code-sha256"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"runtime":      core.MappingNodeFromString("nodejs18.x"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+							"s3Key":          core.MappingNodeFromString("test-key"),
+							"sourceCodeHash": core.MappingNodeFromString("deployed-code-sha256"),
+						},
+					},
+					"arn":        core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"codeSha256": core.MappingNodeFromString("deployed-code-sha256"),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
+
+func createCodeSourceTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	deployedArchive *utils.ZipArchive,
+	expectSource bool,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	functionOutput := createBaseTestFunctionConfig(
+		"test-function",
+		types.RuntimeNodejs18x,
+		"index.handler",
+		"arn:aws:iam::123456789012:role/test-role",
+	)
+	functionOutput.Configuration.CodeSha256 = aws.String(deployedArchive.SHA256)
+
+	expectedCodeFields := map[string]*core.MappingNode{}
+	if expectSource {
+		expectedCodeFields["source"] = createTestCodeSourceSpec(sourceDir)
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetFunctionOutput(functionOutput),
+			lambdamock.WithGetFunctionCodeSigningOutput(&lambda.GetFunctionCodeSigningConfigOutput{}),
+			lambdamock.WithGetFunctionRecursionOutput(&lambda.GetFunctionRecursionConfigOutput{}),
+			lambdamock.WithGetFunctionConcurrencyOutput(&lambda.GetFunctionConcurrencyOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"code": {
+						Fields: map[string]*core.MappingNode{
+							"source": createTestCodeSourceSpec(sourceDir),
+						},
+					},
+					"codeSha256": core.MappingNodeFromString(deployedArchive.SHA256),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"architecture": core.MappingNodeFromString("x86_64"),
+					"functionName": core.MappingNodeFromString("test-function"),
+					"runtime":      core.MappingNodeFromString("nodejs18.x"),
+					"handler":      core.MappingNodeFromString("index.handler"),
+					"role":         core.MappingNodeFromString("arn:aws:iam::123456789012:role/test-role"),
+					"code": {
+						Fields: expectedCodeFields,
+					},
+					"arn":        core.MappingNodeFromString("arn:aws:lambda:us-east-1:123456789012:function:test-function"),
+					"codeSha256": core.MappingNodeFromString(deployedArchive.SHA256),
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"github.com/newstack-cloud/bluelink-provider-aws/services/logs"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
@@ -81,6 +82,38 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 							"The handler property in the resource must be of the form `index.{handlerName}`.",
 						ValidateFunc: validateZipFileRuntime,
 					},
+					"sourceCodeHash": {
+						Type: provider.ResourceDefinitionsSchemaTypeString,
+						Description: "An optional hash of the deployment package, such as the SHA-256 hash of an object in Amazon S3. " +
+							"When this value changes, the function code is redeployed even if the other code fields have not changed. " +
+							"This is useful when the contents of an S3 object are replaced without changing its bucket, key or version. " +
+							"The hash of the code that is deployed is available in the computed codeSha256 field. " +
+							"This should be the base64-encoded SHA-256 hash of the deployment package, the same format as codeSha256, " +
+							"as a hash that does not match the deployed code is reported as drift.",
+						FormattedDescription: "An optional hash of the deployment package, such as the SHA-256 hash of an object in Amazon S3. " +
+							"When this value changes, the function code is redeployed even if the other code fields have not changed. " +
+							"This is useful when the contents of an S3 object are replaced without changing its bucket, key or version. " +
+							"The hash of the code that is deployed is available in the computed `codeSha256` field. " +
+							"This should be the base64-encoded SHA-256 hash of the deployment package, the same format as `codeSha256`, " +
+							"as a hash that does not match the deployed code is reported as drift.",
+						MinLength: 1,
+					},
+					"resolveImageDigest": {
+						Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+						Description: "When set to true, the tag in imageUri is resolved to the digest of the image that it " +
+							"points to in Amazon ECR and the function is deployed with the image digest. " +
+							"The image is redeployed when a new image is pushed with the same tag, even if imageUri " +
+							"has not changed. When drift is checked, the image URI of the deployed image is reported in place of imageUri " +
+							"if the tag no longer points to it. The digest of the deployed image is available in the computed imageDigest field.",
+						FormattedDescription: "When set to `true`, the tag in `imageUri` is resolved to the digest of the image that it " +
+							"points to in Amazon ECR and the function is deployed with the image digest. " +
+							"The image is redeployed when a new image is pushed with the same tag, even if `imageUri` " +
+							"has not changed. When drift is checked, the image URI of the deployed image is reported in place of `imageUri` " +
+							"if the tag no longer points to it. The digest of the deployed image is available in the computed `imageDigest` field.",
+						Default:      core.MappingNodeFromBool(false),
+						ValidateFunc: validateResolveImageDigest,
+					},
+					"source": lambdaCodeSourceSchema(false),
 				},
 			},
 			"codeSigningConfigArn": {
@@ -279,6 +312,21 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 						Description: "The name of the CloudWatch Logs group the function sends logs to.",
 						Pattern:     "[\\.\\-_/#A-Za-z0-9]+",
 					},
+					"retentionInDays": {
+						Type: provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The number of days to retain the logs in the default /aws/lambda/{functionName} log group " +
+							"of the function. When set, the provider creates the log group if it does not already exist " +
+							"and sets its retention period, otherwise the log group is created by Lambda and logs never expire. " +
+							"The log group is kept when the function is destroyed. " +
+							"This can not be set along with logGroup, use an aws/logs/logGroup resource to manage custom log groups.",
+						FormattedDescription: "The number of days to retain the logs in the default `/aws/lambda/{functionName}` log group " +
+							"of the function. When set, the provider creates the log group if it does not already exist " +
+							"and sets its retention period, otherwise the log group is created by Lambda and logs never expire. " +
+							"The log group is kept when the function is destroyed. " +
+							"This can not be set along with `logGroup`, use an `aws/logs/logGroup` resource to manage custom log groups.",
+						AllowedValues: logs.RetentionInDaysAllowedValues(),
+						ValidateFunc:  validateLogRetentionInDays,
+					},
 					"systemLogLevel": {
 						Type: provider.ResourceDefinitionsSchemaTypeString,
 						Description: "A property to filter the system logs for your function that Lambda sends to CloudWatch." +
@@ -340,7 +388,11 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 			"reservedConcurrentExecutions": {
 				Type:        provider.ResourceDefinitionsSchemaTypeInteger,
 				Description: "The number of simultaneous executions to reserve for the function.",
-				Minimum:     core.ScalarFromInt(0),
+				FormattedDescription: "The number of simultaneous executions to reserve for the function.\n\n" +
+					"During validation, the reserved concurrency of the functions in a blueprint is checked against " +
+					"the unreserved concurrency available in the account. " +
+					"Provisioned concurrency configured for function aliases and versions is not included in this check.",
+				Minimum: core.ScalarFromInt(0),
 			},
 			"role": {
 				Type: provider.ResourceDefinitionsSchemaTypeString,
@@ -358,51 +410,11 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					"if you're deploying a function using a container image.\n\n" +
 					"The following list includes deprecated runtimes. Lambda blocks creating new functions and updating existing functions " +
 					"shortly after each runtime is deprecated. For more information, see [Runtime use after deprecation](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtime-deprecation-levels).\n\n" +
+					"Runtimes that are deprecated or will be deprecated within 180 days are reported as warnings during validation, " +
+					"runtimes that Lambda blocks creating functions with are reported as errors.\n\n" +
 					"For a list of all currently supported runtimes, see [Supported runtimes](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtimes-supported)",
-				AllowedValues: []*core.MappingNode{
-					core.MappingNodeFromString("nodejs"),
-					core.MappingNodeFromString("nodejs4.3"),
-					core.MappingNodeFromString("nodejs4.3-edge"),
-					core.MappingNodeFromString("nodejs6.10"),
-					core.MappingNodeFromString("nodejs8.10"),
-					core.MappingNodeFromString("nodejs10.x"),
-					core.MappingNodeFromString("nodejs12.x"),
-					core.MappingNodeFromString("nodejs14.x"),
-					core.MappingNodeFromString("nodejs16.x"),
-					core.MappingNodeFromString("nodejs18.x"),
-					core.MappingNodeFromString("nodejs20.x"),
-					core.MappingNodeFromString("nodejs22.x"),
-					core.MappingNodeFromString("java8"),
-					core.MappingNodeFromString("java8.al2"),
-					core.MappingNodeFromString("java11"),
-					core.MappingNodeFromString("java17"),
-					core.MappingNodeFromString("java21"),
-					core.MappingNodeFromString("python2.7"),
-					core.MappingNodeFromString("python3.6"),
-					core.MappingNodeFromString("python3.7"),
-					core.MappingNodeFromString("python3.8"),
-					core.MappingNodeFromString("python3.9"),
-					core.MappingNodeFromString("python3.10"),
-					core.MappingNodeFromString("python3.11"),
-					core.MappingNodeFromString("python3.12"),
-					core.MappingNodeFromString("python3.13"),
-					core.MappingNodeFromString("dotnetcore1.0"),
-					core.MappingNodeFromString("dotnetcore2.0"),
-					core.MappingNodeFromString("dotnetcore2.1"),
-					core.MappingNodeFromString("dotnetcore3.1"),
-					core.MappingNodeFromString("dotnet6"),
-					core.MappingNodeFromString("dotnet7"),
-					core.MappingNodeFromString("dotnet8"),
-					core.MappingNodeFromString("go1.x"),
-					core.MappingNodeFromString("ruby2.5"),
-					core.MappingNodeFromString("ruby2.7"),
-					core.MappingNodeFromString("ruby3.2"),
-					core.MappingNodeFromString("ruby3.3"),
-					core.MappingNodeFromString("ruby3.4"),
-					core.MappingNodeFromString("provided"),
-					core.MappingNodeFromString("provided.al2"),
-					core.MappingNodeFromString("provided.al2023"),
-				},
+				AllowedValues: lambdaRuntimeAllowedValues(),
+				ValidateFunc:  validateRuntimeLifecycle(core.SystemClock{}),
 			},
 			"runtimeManagementConfig": {
 				Type:        provider.ResourceDefinitionsSchemaTypeObject,
@@ -445,6 +457,21 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 					},
 				},
 			},
+			"publish": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "When set to true, a new version of the function is published after the function is created " +
+					"and each time the code or configuration of the function is updated. " +
+					"SnapStart only takes effect for published versions, this can be used instead of a separate " +
+					"function version resource. The published version is available in the computed " +
+					"latestPublishedVersion and latestPublishedVersionArn fields.",
+				FormattedDescription: "When set to `true`, a new version of the function is published after the function is created " +
+					"and each time the code or configuration of the function is updated. " +
+					"[SnapStart](https://docs.aws.amazon.com/lambda/latest/dg/snapstart.html) only takes effect for " +
+					"published versions, this can be used instead of a separate `aws/lambda/functionVersion` resource. " +
+					"The published version is available in the computed `latestPublishedVersion` and " +
+					"`latestPublishedVersionArn` fields.",
+				Default: core.MappingNodeFromBool(false),
+			},
 			"snapStart": {
 				Type:                 provider.ResourceDefinitionsSchemaTypeObject,
 				Label:                "SnapStart",
@@ -529,6 +556,31 @@ func lambdaFunctionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Description: "The Amazon Resource Name (ARN) of the Lambda function.",
 				Computed:    true,
 			},
+			"codeSha256": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The base64-encoded SHA-256 hash of the function's deployment package. " +
+					"When the code is packaged from a local source, the function code is only updated " +
+					"when this hash changes.",
+				Computed: true,
+			},
+			"imageDigest": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The digest of the container image that is deployed for a function " +
+					"with the Image package type.",
+				Computed: true,
+			},
+			"latestPublishedVersion": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The most recent version of the function that was published by the provider " +
+					"when publish is set to true.",
+				Computed: true,
+			},
+			"latestPublishedVersionArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The ARN of the most recent version of the function that was published by the provider " +
+					"when publish is set to true.",
+				Computed: true,
+			},
 			"snapStartResponseApplyOn": {
 				Type:        provider.ResourceDefinitionsSchemaTypeString,
 				Description: "When SnapStart is set to PublishedVersions, this field indicates the apply setting.",
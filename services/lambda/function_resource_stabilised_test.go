@@ -94,6 +94,98 @@ func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised() {
 			},
 			ExpectError: false,
 		},
+		{
+			Name: "returns not stabilised when the last update is still in progress",
+			ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+				lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:            types.StateActive,
+						LastUpdateStatus: types.LastUpdateStatusInProgress,
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+				utils.AWSConfigCacheKey,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "returns error when function is in a failed state",
+			ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+				lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:           types.StateFailed,
+						StateReasonCode: types.StateReasonCodeSubnetOutOfIPAddresses,
+						StateReason:     aws.String("All subnets are out of IP addresses."),
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+				utils.AWSConfigCacheKey,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: nil,
+			ExpectError:    true,
+		},
+		{
+			Name: "returns error when the last update of the function failed",
+			ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+				lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+					Configuration: &types.FunctionConfiguration{
+						State:                      types.StateActive,
+						LastUpdateStatus:           types.LastUpdateStatusFailed,
+						LastUpdateStatusReasonCode: types.LastUpdateStatusReasonCodeInvalidImage,
+						LastUpdateStatusReason:     aws.String("The image manifest could not be read."),
+					},
+				}),
+			),
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+				utils.AWSConfigCacheKey,
+			),
+			Input: &provider.ResourceHasStabilisedInput{
+				ProviderContext: providerCtx,
+				ResourceSpec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+						),
+					},
+				},
+			},
+			ExpectedOutput: nil,
+			ExpectError:    true,
+		},
 		{
 			Name: "handles get function error",
 			ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
@@ -122,11 +214,33 @@ func (s *LambdaFunctionResourceStabilisedSuite) Test_stabilised() {
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
 		testCases,
-		FunctionResource,
+		newTestFunctionResource,
 		&s.Suite,
 	)
 }
 
+func (s *LambdaFunctionResourceStabilisedSuite) Test_failed_state_error_carries_reason_code() {
+	_, err := checkFunctionConfigurationStabilised(
+		"test-function",
+		"1",
+		&types.FunctionConfiguration{
+			State:           types.StateFailed,
+			StateReasonCode: types.StateReasonCodeInvalidImage,
+			StateReason:     aws.String("The image manifest could not be read."),
+		},
+	)
+
+	failedErr := &FunctionFailedError{}
+	s.Require().ErrorAs(err, &failedErr)
+	s.Assert().Equal("State", failedErr.Status)
+	s.Assert().Equal("InvalidImage", failedErr.ReasonCode)
+	s.Assert().Equal(
+		`lambda function "test-function:1" failed (State) with reason code InvalidImage: `+
+			"The image manifest could not be read.",
+		err.Error(),
+	)
+}
+
 func TestLambdaFunctionResourceStabilisedSuite(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceStabilisedSuite))
 }
@@ -3,8 +3,6 @@ package lambda
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/service/lambda"
-	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
@@ -21,18 +19,16 @@ func (l *lambdaFunctionResourceActions) Stabilised(
 	functionARN := core.StringValue(
 		input.ResourceSpec.Fields["arn"],
 	)
-	functionOutput, err := lambdaService.GetFunction(
+	hasStabilised, err := checkQualifiedFunctionStabilised(
 		ctx,
-		&lambda.GetFunctionInput{
-			FunctionName: &functionARN,
-		},
+		lambdaService,
+		functionARN,
+		/* qualifier */ "",
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	functionState := functionOutput.Configuration.State
-	hasStabilised := functionState == types.StateActive
 	return &provider.ResourceHasStabilisedOutput{
 		Stabilised: hasStabilised,
 	}, nil
@@ -3,6 +3,7 @@ package lambda
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -37,15 +38,26 @@ func (l *lambdaFunctionResourceActions) Update(
 
 	arn := core.StringValue(arnValue)
 
+	packaged, err := l.packageFunctionCode(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := l.resolveFunctionImage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
 	updateOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
-		&functionConfigUpdate{},
-		&functionCodeUpdate{},
-		&functionCodeSigningConfigUpdate{},
-		&functionConcurrencyUpdate{},
-		&functionRecursionConfigUpdate{},
+		&functionConfigUpdate{waiter: l.updateWaiter},
+		&functionCodeUpdate{waiter: l.updateWaiter},
+		&functionCodeSigningConfigUpdate{waiter: l.updateWaiter},
+		&functionConcurrencyUpdate{waiter: l.updateWaiter},
+		&functionRecursionConfigUpdate{waiter: l.updateWaiter},
 		&functionRuntimeManagementConfigUpdate{
 			path:                 "$.runtimeManagementConfig",
 			fieldChangesPathRoot: "spec.runtimeManagementConfig",
+			waiter:               l.updateWaiter,
 		},
 		&tagsUpdate{
 			pathRoot: "$.tags",
@@ -57,7 +69,9 @@ func (l *lambdaFunctionResourceActions) Update(
 		pluginutils.SaveOperationContext{
 			ProviderUpstreamID: arn,
 			Data: map[string]any{
-				"functionARN": arn,
+				"functionARN":   arn,
+				"packagedCode":  packaged,
+				"resolvedImage": resolved,
 			},
 		},
 		updateOperations,
@@ -68,7 +82,28 @@ func (l *lambdaFunctionResourceActions) Update(
 		return nil, err
 	}
 
-	if hasUpdates {
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	err = l.applyFunctionLogRetention(
+		ctx,
+		input.ProviderContext,
+		arn,
+		specData,
+		currentStateSpecData,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	publish := shouldPublishFunctionVersion(specData, currentStateSpecData, hasUpdates)
+	publishedFields := map[string]*core.MappingNode{}
+	if publish {
+		publishedFields, err = publishFunctionVersion(ctx, lambdaService, l.updateWaiter, arn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if hasUpdates || publish {
 		getFunctionOutput, err := lambdaService.GetFunction(ctx, &lambda.GetFunctionInput{
 			FunctionName: &arn,
 		})
@@ -79,6 +114,12 @@ func (l *lambdaFunctionResourceActions) Update(
 		computedFields := l.extractComputedFieldsFromFunctionConfig(
 			getFunctionOutput.Configuration,
 		)
+		if getFunctionOutput.Code != nil && getFunctionOutput.Code.ResolvedImageUri != nil {
+			computedFields["spec.imageDigest"] = core.MappingNodeFromString(
+				imageDigestFromURI(aws.ToString(getFunctionOutput.Code.ResolvedImageUri)),
+			)
+		}
+		maps.Copy(computedFields, publishedFields)
 		return &provider.ResourceDeployOutput{
 			ComputedFieldValues: computedFields,
 		}, nil
@@ -107,6 +148,22 @@ func (l *lambdaFunctionResourceActions) extractComputedFieldsFromCurrentState(
 		fields["spec.arn"] = v
 	}
 
+	if v, ok := pluginutils.GetValueByPath("$.codeSha256", currentStateSpecData); ok {
+		fields["spec.codeSha256"] = v
+	}
+
+	if v, ok := pluginutils.GetValueByPath("$.imageDigest", currentStateSpecData); ok {
+		fields["spec.imageDigest"] = v
+	}
+
+	if v, ok := pluginutils.GetValueByPath("$.latestPublishedVersion", currentStateSpecData); ok {
+		fields["spec.latestPublishedVersion"] = v
+	}
+
+	if v, ok := pluginutils.GetValueByPath("$.latestPublishedVersionArn", currentStateSpecData); ok {
+		fields["spec.latestPublishedVersionArn"] = v
+	}
+
 	if v, ok := pluginutils.GetValueByPath(
 		"$.snapStartResponseApplyOn",
 		currentStateSpecData,
@@ -683,9 +740,72 @@ func changesToUpdateFunctionCodeInput(
 		hasUpdates = hasUpdates || valueSetter.DidSet()
 	}
 
+	if sourceCodeHashChanged(updatedSpecData, changes) {
+		// The code location has not necessarily changed when the source code hash
+		// changes, so the full code location must be provided to redeploy the code.
+		setUpdateFunctionCodeLocation(updatedSpecData, input)
+		hasUpdates = true
+	}
+
 	return input, hasUpdates, nil
 }
 
+func sourceCodeHashChanged(
+	updatedSpecData *core.MappingNode,
+	changes *provider.Changes,
+) bool {
+	newHash, hasNewHash := pluginutils.GetValueByPath(
+		"$.code.sourceCodeHash",
+		updatedSpecData,
+	)
+	if !hasNewHash {
+		return false
+	}
+
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+	currentHash, _ := pluginutils.GetValueByPath(
+		"$.code.sourceCodeHash",
+		currentStateSpecData,
+	)
+	return core.StringValue(newHash) != core.StringValue(currentHash)
+}
+
+func setUpdateFunctionCodeLocation(
+	updatedSpecData *core.MappingNode,
+	input *lambda.UpdateFunctionCodeInput,
+) {
+	valueSetters := []*pluginutils.ValueSetter[*lambda.UpdateFunctionCodeInput]{
+		pluginutils.NewValueSetter(
+			"$.code.imageUri",
+			setUpdateFunctionCodeImageUri,
+		),
+		pluginutils.NewValueSetter(
+			"$.code.s3Bucket",
+			setUpdateFunctionCodeS3Bucket,
+		),
+		pluginutils.NewValueSetter(
+			"$.code.s3Key",
+			setUpdateFunctionCodeS3Key,
+		),
+		pluginutils.NewValueSetter(
+			"$.code.s3ObjectVersion",
+			setUpdateFunctionCodeS3ObjectVersion,
+		),
+		pluginutils.NewValueSetter(
+			"$.code.sourceKMSKeyArn",
+			setUpdateFunctionCodeSourceKMSKeyARN,
+		),
+		pluginutils.NewValueSetter(
+			"$.code.zipFile",
+			setUpdateFunctionCodeZipFile,
+		),
+	}
+
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(updatedSpecData, input)
+	}
+}
+
 func prepareZipFormatForInlineCode(
 	inputSpecData *core.MappingNode,
 	runtime string,
@@ -3,6 +3,7 @@ package lambda
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
@@ -11,7 +12,8 @@ import (
 )
 
 type functionConfigUpdate struct {
-	input *lambda.UpdateFunctionConfigurationInput
+	input  *lambda.UpdateFunctionConfigurationInput
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionConfigUpdate) Name() string {
@@ -37,12 +39,28 @@ func (u *functionConfigUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.UpdateFunctionConfiguration(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		saveOpCtx.ProviderUpstreamID,
+		func() error {
+			_, err := lambdaService.UpdateFunctionConfiguration(ctx, u.input)
+			return err
+		},
+	)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	// Wait for the update to complete before moving on to the next operation,
+	// Lambda rejects further changes to the function while the update is in progress.
+	err = u.waiter.waitForUpdate(ctx, lambdaService, saveOpCtx.ProviderUpstreamID)
 	return saveOpCtx, err
 }
 
 type functionCodeUpdate struct {
-	input *lambda.UpdateFunctionCodeInput
+	input  *lambda.UpdateFunctionCodeInput
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionCodeUpdate) Name() string {
@@ -62,6 +80,32 @@ func (u *functionCodeUpdate) Prepare(
 	if err != nil {
 		return false, saveOpCtx, err
 	}
+
+	if packaged, ok := getPackagedCode(saveOpCtx); ok {
+		// Changes to files in a local code source are not visible in the
+		// resource spec, so the hash of the packaged code is compared with the hash
+		// of the deployed code to determine whether the code needs to be updated.
+		currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+		currentCodeSHA256, _ := pluginutils.GetValueByPath("$.codeSha256", currentStateSpecData)
+		codeChanged := packaged.sha256 != core.StringValue(currentCodeSHA256)
+		if codeChanged || hasUpdates {
+			setUpdateFunctionCodeFromPackagedCode(input, packaged)
+		}
+		hasUpdates = hasUpdates || codeChanged
+	}
+
+	if resolved, ok := getResolvedImage(saveOpCtx); ok {
+		// Pushing a new image with the same tag does not change the resource spec,
+		// so the digest that the tag resolves to is compared with the digest
+		// of the deployed image to determine whether the image needs to be updated.
+		currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(changes)
+		currentDigest, _ := pluginutils.GetValueByPath("$.imageDigest", currentStateSpecData)
+		imageChanged := resolved.digest != core.StringValue(currentDigest)
+		if imageChanged || input.ImageUri != nil {
+			input.ImageUri = aws.String(resolved.uri)
+		}
+		hasUpdates = hasUpdates || imageChanged
+	}
 	u.input = input
 	return hasUpdates, saveOpCtx, nil
 }
@@ -71,12 +115,28 @@ func (u *functionCodeUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.UpdateFunctionCode(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		saveOpCtx.ProviderUpstreamID,
+		func() error {
+			_, err := lambdaService.UpdateFunctionCode(ctx, u.input)
+			return err
+		},
+	)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	// Wait for the update to complete before moving on to the next operation,
+	// Lambda rejects further changes to the function while the update is in progress.
+	err = u.waiter.waitForUpdate(ctx, lambdaService, saveOpCtx.ProviderUpstreamID)
 	return saveOpCtx, err
 }
 
 type functionCodeSigningConfigUpdate struct {
-	input *lambda.PutFunctionCodeSigningConfigInput
+	input  *lambda.PutFunctionCodeSigningConfigInput
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionCodeSigningConfigUpdate) Name() string {
@@ -101,12 +161,21 @@ func (u *functionCodeSigningConfigUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.PutFunctionCodeSigningConfig(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		saveOpCtx.ProviderUpstreamID,
+		func() error {
+			_, err := lambdaService.PutFunctionCodeSigningConfig(ctx, u.input)
+			return err
+		},
+	)
 	return saveOpCtx, err
 }
 
 type functionConcurrencyUpdate struct {
-	input *lambda.PutFunctionConcurrencyInput
+	input  *lambda.PutFunctionConcurrencyInput
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionConcurrencyUpdate) Name() string {
@@ -131,12 +200,21 @@ func (u *functionConcurrencyUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.PutFunctionConcurrency(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		saveOpCtx.ProviderUpstreamID,
+		func() error {
+			_, err := lambdaService.PutFunctionConcurrency(ctx, u.input)
+			return err
+		},
+	)
 	return saveOpCtx, err
 }
 
 type functionRecursionConfigUpdate struct {
-	input *lambda.PutFunctionRecursionConfigInput
+	input  *lambda.PutFunctionRecursionConfigInput
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionRecursionConfigUpdate) Name() string {
@@ -161,6 +239,14 @@ func (u *functionRecursionConfigUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.PutFunctionRecursionConfig(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		saveOpCtx.ProviderUpstreamID,
+		func() error {
+			_, err := lambdaService.PutFunctionRecursionConfig(ctx, u.input)
+			return err
+		},
+	)
 	return saveOpCtx, err
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
 	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
 	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
@@ -24,6 +25,7 @@ type LambdaFunctionResourceUpdateSuite struct {
 }
 
 func (s *LambdaFunctionResourceUpdateSuite) Test_update_lambda_function() {
+	sourceDir, archive := createTestCodeSourceDir(s.T())
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -42,11 +44,17 @@ func (s *LambdaFunctionResourceUpdateSuite) Test_update_lambda_function() {
 		createFunctionMultipleConfigsUpdateTestCase(providerCtx, loader),
 		createFunctionUpdateFailureTestCase(providerCtx, loader),
 		recreateFunctionOnNameOrPackageTypeChangeTestCase(providerCtx, loader),
+		updateFunctionCodeSourceChangedTestCase(providerCtx, loader, sourceDir, archive),
+		updateFunctionCodeSourceUnchangedTestCase(providerCtx, loader, sourceDir, archive),
+		updateFunctionSourceCodeHashChangedTestCase(providerCtx, loader),
+		updateFunctionWaitsForInProgressUpdateTestCase(providerCtx, loader),
+		updateFunctionLastUpdateFailedTestCase(providerCtx, loader),
+		updateFunctionResourceConflictRetriesExhaustedTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		FunctionResource,
+		newTestFunctionResource,
 		&s.Suite,
 	)
 }
@@ -872,6 +880,651 @@ func recreateFunctionOnNameOrPackageTypeChangeTestCase(
 	}
 }
 
+func updateFunctionCodeSourceChangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	archive *utils.ZipArchive,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionCodeOutput(&lambda.UpdateFunctionCodeOutput{}),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String(archive.SHA256),
+			},
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs20.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs20.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+			"codeSha256": core.MappingNodeFromString("previous-code-sha256"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function code when the local code source has changed",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(archive.SHA256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				Publish:      true,
+				ZipFile:      archive.Content,
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func updateFunctionCodeSourceUnchangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	archive *utils.ZipArchive,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock()
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs20.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"runtime":      core.MappingNodeFromString("nodejs20.x"),
+			"handler":      core.MappingNodeFromString("index.handler"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+			"codeSha256": core.MappingNodeFromString(archive.SHA256),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "skip function code update when the local code source hash is unchanged",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{FieldPath: "spec.code.source.include"},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString(archive.SHA256),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+			"UpdateFunctionCode",
+		},
+	}
+}
+
+func updateFunctionSourceCodeHashChangedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithUpdateFunctionCodeOutput(&lambda.UpdateFunctionCodeOutput{}),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				CodeSha256:  aws.String("new-code-sha256"),
+			},
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+					"s3Key":          core.MappingNodeFromString("functions/test-function.zip"),
+					"sourceCodeHash": core.MappingNodeFromString("new-code-sha256"),
+				},
+			},
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(resourceARN),
+			"functionName": core.MappingNodeFromString("test-function"),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket":       core.MappingNodeFromString("test-bucket"),
+					"s3Key":          core.MappingNodeFromString("functions/test-function.zip"),
+					"sourceCodeHash": core.MappingNodeFromString("old-code-sha256"),
+				},
+			},
+			"codeSha256": core.MappingNodeFromString("old-code-sha256"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function code when only the source code hash has changed",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: specData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.code.sourceCodeHash",
+						PrevValue: core.MappingNodeFromString("old-code-sha256"),
+						NewValue:  core.MappingNodeFromString("new-code-sha256"),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":        core.MappingNodeFromString(resourceARN),
+				"spec.codeSha256": core.MappingNodeFromString("new-code-sha256"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				Publish:      true,
+				S3Bucket:     aws.String("test-bucket"),
+				S3Key:        aws.String("functions/test-function.zip"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func updateFunctionWaitsForInProgressUpdateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutputSequence(
+			&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionArn:      aws.String(resourceARN),
+					State:            types.StateActive,
+					LastUpdateStatus: types.LastUpdateStatusInProgress,
+				},
+			},
+			&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionArn:      aws.String(resourceARN),
+					State:            types.StateActive,
+					LastUpdateStatus: types.LastUpdateStatusSuccessful,
+				},
+			},
+			&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionArn:      aws.String(resourceARN),
+					State:            types.StateActive,
+					LastUpdateStatus: types.LastUpdateStatusInProgress,
+				},
+			},
+			&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionArn:      aws.String(resourceARN),
+					State:            types.StateActive,
+					LastUpdateStatus: types.LastUpdateStatusInProgress,
+				},
+			},
+			&lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionArn:      aws.String(resourceARN),
+					State:            types.StateActive,
+					LastUpdateStatus: types.LastUpdateStatusSuccessful,
+				},
+			},
+		),
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(128),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v1/code.zip"),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(256),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v2/code.zip"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function configuration and code waits for in-progress updates to complete",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.memorySize",
+					},
+					{
+						FieldPath: "spec.code.s3Key",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(resourceARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+			"UpdateFunctionCode": &lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String(resourceARN),
+				S3Key:        aws.String("v2/code.zip"),
+				Publish:      true,
+			},
+			"GetFunction": &lambda.GetFunctionInput{
+				FunctionName: aws.String(resourceARN),
+			},
+		},
+	}
+}
+
+func updateFunctionLastUpdateFailedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn:                aws.String(resourceARN),
+				State:                      types.StateActive,
+				LastUpdateStatus:           types.LastUpdateStatusFailed,
+				LastUpdateStatusReasonCode: types.LastUpdateStatusReasonCodeSubnetOutOfIPAddresses,
+				LastUpdateStatusReason:     aws.String("All subnets are out of IP addresses."),
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(128),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v1/code.zip"),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(256),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v2/code.zip"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function fails when the configuration update fails to apply",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.memorySize",
+					},
+					{
+						FieldPath: "spec.code.s3Key",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+		},
+	}
+}
+
+func updateFunctionResourceConflictRetriesExhaustedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn:      aws.String(resourceARN),
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusSuccessful,
+			},
+		}),
+		lambdamock.WithUpdateFunctionConfigurationError(&smithy.GenericAPIError{
+			Code:    "ResourceConflictException",
+			Message: "The operation cannot be performed at this time. An update is in progress for resource.",
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(128),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v1/code.zip"),
+				},
+			},
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":        core.MappingNodeFromString(resourceARN),
+			"memorySize": core.MappingNodeFromInt(256),
+			"code": {
+				Fields: map[string]*core.MappingNode{
+					"s3Bucket": core.MappingNodeFromString("test-bucket"),
+					"s3Key":    core.MappingNodeFromString("v2/code.zip"),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function fails when the function remains in conflict after retrying",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-id",
+					ResourceName: "TestFunction",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-id",
+						Name:       "TestFunction",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/function",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.memorySize",
+					},
+					{
+						FieldPath: "spec.code.s3Key",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+		SaveActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(resourceARN),
+				MemorySize:   aws.Int32(256),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"UpdateFunctionCode",
+		},
+	}
+}
+
 func TestLambdaFunctionResourceUpdate(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionResourceUpdateSuite))
 }
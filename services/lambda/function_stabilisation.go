@@ -0,0 +1,146 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+)
+
+// FunctionFailedError is returned when polling for stabilisation finds that
+// a Lambda function, a published version or the provisioned concurrency
+// of a version or alias has ended up in a failed state.
+// Polling will never succeed in this case, so the error carries
+// the reason code reported by Lambda (e.g. SubnetOutOfIPAddresses, InvalidImage)
+// to allow the cause to be surfaced to the user.
+type FunctionFailedError struct {
+	// FunctionName is the name or ARN of the function that failed.
+	FunctionName string
+	// Qualifier is the version or alias of the function that failed,
+	// this will be empty for the unqualified function.
+	Qualifier string
+	// Status is the field that reported the failure,
+	// one of "State", "LastUpdateStatus" or "ProvisionedConcurrencyStatus".
+	Status string
+	// ReasonCode is the reason code reported by Lambda for the failure.
+	ReasonCode string
+	// Reason is the human-readable reason reported by Lambda for the failure.
+	Reason string
+}
+
+func (e *FunctionFailedError) Error() string {
+	functionName := e.FunctionName
+	if e.Qualifier != "" {
+		functionName = fmt.Sprintf("%s:%s", e.FunctionName, e.Qualifier)
+	}
+
+	message := fmt.Sprintf("lambda function %q failed (%s)", functionName, e.Status)
+	if e.ReasonCode != "" {
+		message = fmt.Sprintf("%s with reason code %s", message, e.ReasonCode)
+	}
+	if e.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Reason)
+	}
+	return message
+}
+
+// checkFunctionConfigurationStabilised determines whether a function configuration
+// has reached a stable state.
+// A function is stable when it is active and its last update, if any, has succeeded.
+// An error is returned when either the state or the last update status is failed.
+func checkFunctionConfigurationStabilised(
+	functionName string,
+	qualifier string,
+	config *types.FunctionConfiguration,
+) (bool, error) {
+	if config == nil {
+		return false, nil
+	}
+
+	if config.State == types.StateFailed {
+		return false, &FunctionFailedError{
+			FunctionName: functionName,
+			Qualifier:    qualifier,
+			Status:       "State",
+			ReasonCode:   string(config.StateReasonCode),
+			Reason:       aws.ToString(config.StateReason),
+		}
+	}
+
+	if config.LastUpdateStatus == types.LastUpdateStatusFailed {
+		return false, &FunctionFailedError{
+			FunctionName: functionName,
+			Qualifier:    qualifier,
+			Status:       "LastUpdateStatus",
+			ReasonCode:   string(config.LastUpdateStatusReasonCode),
+			Reason:       aws.ToString(config.LastUpdateStatusReason),
+		}
+	}
+
+	// An empty last update status means that the function has not been updated
+	// since it was created.
+	lastUpdateComplete := config.LastUpdateStatus == "" ||
+		config.LastUpdateStatus == types.LastUpdateStatusSuccessful
+	return config.State == types.StateActive && lastUpdateComplete, nil
+}
+
+// checkQualifiedFunctionStabilised determines whether a function or a specific version
+// of a function has reached a stable state.
+func checkQualifiedFunctionStabilised(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+	qualifier string,
+) (bool, error) {
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	functionOutput, err := lambdaService.GetFunction(ctx, input)
+	if err != nil {
+		return false, err
+	}
+
+	return checkFunctionConfigurationStabilised(
+		functionName,
+		qualifier,
+		functionOutput.Configuration,
+	)
+}
+
+// checkProvisionedConcurrencyStabilised determines whether the provisioned concurrency
+// configured for a version or alias of a function has finished allocating.
+func checkProvisionedConcurrencyStabilised(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+	qualifier string,
+) (bool, error) {
+	output, err := lambdaService.GetProvisionedConcurrencyConfig(
+		ctx,
+		&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if output.Status == types.ProvisionedConcurrencyStatusEnumFailed {
+		return false, &FunctionFailedError{
+			FunctionName: functionName,
+			Qualifier:    qualifier,
+			Status:       "ProvisionedConcurrencyStatus",
+			Reason:       aws.ToString(output.StatusReason),
+		}
+	}
+
+	return output.Status == types.ProvisionedConcurrencyStatusEnumReady, nil
+}
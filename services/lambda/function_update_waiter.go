@@ -0,0 +1,149 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+)
+
+// functionUpdateWaiter sequences operations that modify a Lambda function.
+// Lambda rejects a modification with a ResourceConflictException while a previous
+// update to the same function is still in progress, so dependent operations
+// must wait for the LastUpdateStatus of the function to leave the InProgress state.
+type functionUpdateWaiter struct {
+	// initialDelay is the delay before the second attempt,
+	// the delay is doubled for each subsequent attempt.
+	initialDelay time.Duration
+	// maxDelay caps the delay between attempts.
+	maxDelay time.Duration
+	// maxAttempts is the maximum number of times the function will be polled
+	// or an operation retried before giving up.
+	maxAttempts int
+}
+
+func defaultFunctionUpdateWaiter() *functionUpdateWaiter {
+	return &functionUpdateWaiter{
+		initialDelay: 1 * time.Second,
+		maxDelay:     10 * time.Second,
+		maxAttempts:  60,
+	}
+}
+
+// waitForUpdate polls the function until the last update is no longer in progress.
+// A nil waiter does not wait.
+// An error is returned if the update failed, if the maximum number of attempts
+// is reached or if the context is cancelled.
+func (w *functionUpdateWaiter) waitForUpdate(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionARN string,
+) error {
+	if w == nil {
+		return nil
+	}
+
+	for attempt := 0; attempt < w.maxAttempts; attempt += 1 {
+		if attempt > 0 {
+			err := w.sleep(ctx, attempt)
+			if err != nil {
+				return err
+			}
+		}
+
+		functionOutput, err := lambdaService.GetFunction(
+			ctx,
+			&lambda.GetFunctionInput{
+				FunctionName: aws.String(functionARN),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		config := functionOutput.Configuration
+		if config == nil || config.LastUpdateStatus != types.LastUpdateStatusInProgress {
+			_, err := checkFunctionConfigurationStabilised(
+				functionARN,
+				/* qualifier */ "",
+				config,
+			)
+			return err
+		}
+	}
+
+	return fmt.Errorf(
+		"timed out waiting for the in-progress update of lambda function %q to complete "+
+			"after %d attempts",
+		functionARN,
+		w.maxAttempts,
+	)
+}
+
+// retryOnConflict runs the provided operation, waiting for any in-progress update
+// of the function to complete and retrying the operation when Lambda rejects it
+// with a ResourceConflictException.
+// A nil waiter runs the operation once without retrying.
+func (w *functionUpdateWaiter) retryOnConflict(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionARN string,
+	operation func() error,
+) error {
+	if w == nil {
+		return operation()
+	}
+
+	var err error
+	for attempt := 0; attempt < w.maxAttempts; attempt += 1 {
+		if attempt > 0 {
+			sleepErr := w.sleep(ctx, attempt)
+			if sleepErr != nil {
+				return sleepErr
+			}
+
+			waitErr := w.waitForUpdate(ctx, lambdaService, functionARN)
+			if waitErr != nil {
+				return waitErr
+			}
+		}
+
+		err = operation()
+		if !isResourceConflictError(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (w *functionUpdateWaiter) sleep(ctx context.Context, attempt int) error {
+	delay := w.initialDelay << (attempt - 1)
+	if delay > w.maxDelay || delay <= 0 {
+		delay = w.maxDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isResourceConflictError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ResourceConflictException"
+	}
+	return false
+}
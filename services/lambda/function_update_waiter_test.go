@@ -0,0 +1,96 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FunctionUpdateWaiterSuite struct {
+	suite.Suite
+}
+
+func (s *FunctionUpdateWaiterSuite) Test_stops_waiting_when_context_is_cancelled() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusInProgress,
+			},
+		}),
+	)
+	waiter := &functionUpdateWaiter{
+		initialDelay: 1 * time.Hour,
+		maxDelay:     1 * time.Hour,
+		maxAttempts:  5,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := waiter.waitForUpdate(ctx, service, "test-function")
+	s.Assert().ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *FunctionUpdateWaiterSuite) Test_times_out_after_max_attempts() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusInProgress,
+			},
+		}),
+	)
+
+	err := newTestFunctionUpdateWaiter().waitForUpdate(
+		context.Background(),
+		service,
+		"test-function",
+	)
+	s.Assert().EqualError(
+		err,
+		`timed out waiting for the in-progress update of lambda function "test-function" `+
+			"to complete after 5 attempts",
+	)
+}
+
+func (s *FunctionUpdateWaiterSuite) Test_retries_operation_on_resource_conflict() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:            types.StateActive,
+				LastUpdateStatus: types.LastUpdateStatusSuccessful,
+			},
+		}),
+	)
+
+	attempts := 0
+	err := newTestFunctionUpdateWaiter().retryOnConflict(
+		context.Background(),
+		service,
+		"test-function",
+		func() error {
+			attempts += 1
+			if attempts < 3 {
+				return &smithy.GenericAPIError{
+					Code:    "ResourceConflictException",
+					Message: "An update is in progress for resource.",
+				}
+			}
+			return nil
+		},
+	)
+	s.Assert().NoError(err)
+	s.Assert().Equal(3, attempts)
+	service.AssertCalled(&s.Suite, "GetFunction")
+}
+
+func TestFunctionUpdateWaiterSuite(t *testing.T) {
+	suite.Run(t, new(FunctionUpdateWaiterSuite))
+}
@@ -1,10 +1,12 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 )
@@ -41,3 +43,115 @@ func validateZipFileRuntime(
 
 	return []*core.Diagnostic{}
 }
+
+// CustomValidate validates the fields of a function that can not be validated
+// by the validation functions of the schema definition, as the blueprint framework
+// only calls the validation functions of schema definitions for scalar values.
+func (l *lambdaFunctionResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	diagnostics = append(
+		diagnostics,
+		validateCodeSourceExclusive(
+			input.SchemaResource.Spec,
+			"$.code",
+			[]string{"imageUri", "s3Bucket", "s3Key", "s3ObjectVersion", "zipFile"},
+		)...,
+	)
+	diagnostics = append(diagnostics, l.validateReservedConcurrency(ctx, input)...)
+
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
+
+// validateCodeSourceExclusive makes sure a local code source is not used
+// together with any of the other fields used to specify the location of the code.
+func validateCodeSourceExclusive(
+	spec *core.MappingNode,
+	parentPath string,
+	conflictingFields []string,
+) []*core.Diagnostic {
+	diagnostics := []*core.Diagnostic{}
+	sourcePath := fmt.Sprintf("%s.source", parentPath)
+	source, hasSource := pluginutils.GetValueByPath(sourcePath, spec)
+	if !hasSource {
+		return diagnostics
+	}
+
+	for _, field := range conflictingFields {
+		_, hasField := pluginutils.GetValueByPath(
+			fmt.Sprintf("%s.%s", parentPath, field),
+			spec,
+		)
+		if hasField {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"The %s field can not be used together with the %s field.",
+					sourcePath,
+					field,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(source.SourceMeta, nil),
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+// validateResolveImageDigest makes sure that image digest resolution
+// is only enabled for functions that are deployed from a container image.
+func validateResolveImageDigest(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	if !core.BoolValue(value) {
+		return []*core.Diagnostic{}
+	}
+
+	_, hasImageURI := pluginutils.GetValueByPath("$.code.imageUri", resource.Spec)
+	if hasImageURI {
+		return []*core.Diagnostic{}
+	}
+
+	return []*core.Diagnostic{
+		{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s field can only be set to true when the $.code.imageUri field is set.",
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		},
+	}
+}
+
+func validateLogRetentionInDays(
+	path string,
+	value *core.MappingNode,
+	resource *schema.Resource,
+) []*core.Diagnostic {
+	_, hasLogGroup := pluginutils.GetValueByPath("$.loggingConfig.logGroup", resource.Spec)
+	if !hasLogGroup {
+		return []*core.Diagnostic{}
+	}
+
+	return []*core.Diagnostic{
+		{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s field can only be set for the default log group of a function, "+
+					"it can not be set along with the $.loggingConfig.logGroup field. "+
+					"Use an aws/logs/logGroup resource to manage the retention of a custom log group.",
+				path,
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaCodeSourceValidationSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaCodeSourceValidationSuite) Test_function_code_source_can_not_be_used_with_other_code_locations() {
+	resource := s.createResource(newTestFunctionResource)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		createCodeSourceValidateInput("aws/lambda/function", "code", map[string]*core.MappingNode{
+			"source": createTestCodeSourceNode(),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Empty(output.Diagnostics)
+
+	output, err = resource.CustomValidate(
+		context.Background(),
+		createCodeSourceValidateInput("aws/lambda/function", "code", map[string]*core.MappingNode{
+			"source":   createTestCodeSourceNode(),
+			"s3Bucket": core.MappingNodeFromString("order-functions"),
+			"imageUri": core.MappingNodeFromString("123456789012.dkr.ecr.us-west-2.amazonaws.com/orders:latest"),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 2)
+	s.Equal(core.DiagnosticLevelError, output.Diagnostics[0].Level)
+	s.Equal(14, output.Diagnostics[0].Range.Start.Line)
+	s.Equal(
+		"The $.code.source field can not be used together with the imageUri field.",
+		output.Diagnostics[0].Message,
+	)
+	s.Equal(
+		"The $.code.source field can not be used together with the s3Bucket field.",
+		output.Diagnostics[1].Message,
+	)
+}
+
+func (s *LambdaCodeSourceValidationSuite) Test_layer_version_content_source_can_not_be_used_with_other_content_locations() {
+	resource := s.createResource(newTestLayerVersionResource)
+
+	output, err := resource.CustomValidate(
+		context.Background(),
+		createCodeSourceValidateInput("aws/lambda/layerVersion", "content", map[string]*core.MappingNode{
+			"source": createTestCodeSourceNode(),
+			"s3Key":  core.MappingNodeFromString("layers/orders.zip"),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.Diagnostics, 1)
+	s.Equal(core.DiagnosticLevelError, output.Diagnostics[0].Level)
+	s.Equal(
+		"The $.content.source field can not be used together with the s3Key field.",
+		output.Diagnostics[0].Message,
+	)
+}
+
+func (s *LambdaCodeSourceValidationSuite) createResource(
+	resourceFactory func(
+		pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+		pluginutils.ServiceConfigStore[*aws.Config],
+	) provider.Resource,
+) provider.Resource {
+	return resourceFactory(
+		lambdamock.CreateLambdaServiceMockFactory(),
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+			utils.AWSConfigCacheKey,
+		),
+	)
+}
+
+func createCodeSourceValidateInput(
+	resourceType string,
+	codeField string,
+	codeFields map[string]*core.MappingNode,
+) *provider.ResourceValidateInput {
+	return &provider.ResourceValidateInput{
+		SchemaResource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: resourceType},
+			Spec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					codeField: {
+						Fields: codeFields,
+					},
+				},
+			},
+		},
+		ProviderContext: plugintestutils.NewTestProviderContext(
+			"aws",
+			map[string]*core.ScalarValue{
+				"region": core.ScalarFromString("us-west-2"),
+			},
+			map[string]*core.ScalarValue{},
+		),
+	}
+}
+
+func createTestCodeSourceNode() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"path": core.MappingNodeFromString("dist"),
+		},
+		SourceMeta: &source.Meta{Position: source.Position{Line: 14, Column: 7}},
+	}
+}
+
+func TestLambdaCodeSourceValidationSuite(t *testing.T) {
+	suite.Run(t, new(LambdaCodeSourceValidationSuite))
+}
@@ -28,6 +28,7 @@ func (l *lambdaFunctionVersionResourceActions) Create(
 			fieldChangesPathRoot: "spec.runtimePolicy",
 		},
 		&functionVersionPutProvisionedConcurrencyConfig{},
+		&functionVersionRetention{},
 	}
 
 	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
@@ -71,6 +71,12 @@ func (l *lambdaFunctionVersionResourceActions) GetExternalState(
 		}
 	}
 
+	// Retention is applied by the provider after a version is published
+	// and is not stored in AWS, so it is carried over from the current spec.
+	if retention, hasRetention := input.CurrentResourceSpec.Fields["retention"]; hasRetention {
+		resourceSpecState.Fields["retention"] = retention
+	}
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
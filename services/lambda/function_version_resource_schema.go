@@ -91,6 +91,32 @@ func lambdaFunctionVersionResourceSchema() *provider.ResourceDefinitionsSchema {
 					},
 				},
 			},
+			"retention": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "VersionRetention",
+				Description: "The retention policy for published versions of the function. " +
+					"After this version has been published, older versions of the function are deleted, " +
+					"keeping the most recent versions along with any version that an alias routes traffic to, " +
+					"that has provisioned concurrency configured or that an event source mapping invokes.",
+				FormattedDescription: "The retention policy for published versions of the function. " +
+					"After this version has been published, older versions of the function are deleted, " +
+					"keeping the most recent versions along with any version that an alias routes traffic to, " +
+					"that has provisioned concurrency configured or that an event source mapping invokes.\n\n" +
+					"This applies to all published versions of the function, including versions that were " +
+					"published by other function version resources for the same function. " +
+					"When more than one function version resource for a function defines a retention policy, " +
+					"the policy of the most recently published version is applied.",
+				Required: []string{"keepLastVersions"},
+				// Retention can be changed without having to publish a new version.
+				MustRecreate: false,
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"keepLastVersions": {
+						Type:        provider.ResourceDefinitionsSchemaTypeInteger,
+						Description: "The number of most recently published versions to keep, including this version.",
+						Minimum:     core.ScalarFromInt(1),
+					},
+				},
+			},
 
 			// Computed fields
 			"functionArn": {
@@ -36,6 +36,9 @@ func (s *LambdaFunctionVersionResourceStabilisedSuite) Test_stabilised() {
 	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
 		createSuccessfulFunctionVersionStabilisedTestCase(providerCtx, loader),
 		createFailingFunctionVersionStabilisedTestCase(providerCtx, loader),
+		createFailedStateFunctionVersionStabilisedTestCase(providerCtx, loader),
+		createProvisionedConcurrencyInProgressFunctionVersionStabilisedTestCase(providerCtx, loader),
+		createProvisionedConcurrencyFailedFunctionVersionStabilisedTestCase(providerCtx, loader),
 	}
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
@@ -123,6 +126,141 @@ func createFailingFunctionVersionStabilisedTestCase(
 	}
 }
 
+func createFailedStateFunctionVersionStabilisedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State:           types.StateFailed,
+				StateReasonCode: types.StateReasonCodeEniLimitExceeded,
+				StateReason:     aws.String("The ENI limit for the VPC has been reached."),
+			},
+		}),
+	)
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "returns error when function version is in a failed state",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+					),
+					"version": core.MappingNodeFromString("1"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
+
+func createProvisionedConcurrencyInProgressFunctionVersionStabilisedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State: types.StateActive,
+			},
+		}),
+		lambdamock.WithGetProvisionedConcurrencyOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+			Status: types.ProvisionedConcurrencyStatusEnumInProgress,
+		}),
+	)
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "returns not stabilised while provisioned concurrency is being allocated",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+					),
+					"version": core.MappingNodeFromString("1"),
+					"provisionedConcurrencyConfig": {
+						Fields: map[string]*core.MappingNode{
+							"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		},
+	}
+}
+
+func createProvisionedConcurrencyFailedFunctionVersionStabilisedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				State: types.StateActive,
+			},
+		}),
+		lambdamock.WithGetProvisionedConcurrencyOutput(&lambda.GetProvisionedConcurrencyConfigOutput{
+			Status:       types.ProvisionedConcurrencyStatusEnumFailed,
+			StatusReason: aws.String("Insufficient unreserved concurrency in the account."),
+		}),
+	)
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "returns error when provisioned concurrency allocation fails",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionArn": core.MappingNodeFromString(
+						"arn:aws:lambda:us-east-1:123456789012:function:test-function",
+					),
+					"version": core.MappingNodeFromString("1"),
+					"provisionedConcurrencyConfig": {
+						Fields: map[string]*core.MappingNode{
+							"provisionedConcurrentExecutions": core.MappingNodeFromInt(5),
+						},
+					},
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
+
 func TestLambdaFunctionVersionResourceStabilisedSuite(t *testing.T) {
 	suite.Run(t, new(LambdaFunctionVersionResourceStabilisedSuite))
 }
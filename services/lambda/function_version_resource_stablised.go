@@ -3,9 +3,6 @@ package lambda
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/lambda"
-	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
@@ -25,19 +22,29 @@ func (l *lambdaFunctionVersionResourceActions) Stabilised(
 	version := core.StringValue(
 		input.ResourceSpec.Fields["version"],
 	)
-	functionOutput, err := lambdaService.GetFunction(
+	hasStabilised, err := checkQualifiedFunctionStabilised(
 		ctx,
-		&lambda.GetFunctionInput{
-			FunctionName: &functionARN,
-			Qualifier:    aws.String(version),
-		},
+		lambdaService,
+		functionARN,
+		version,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	functionState := functionOutput.Configuration.State
-	hasStabilised := functionState == types.StateActive
+	_, hasProvisionedConcurrency := input.ResourceSpec.Fields["provisionedConcurrencyConfig"]
+	if hasStabilised && hasProvisionedConcurrency {
+		hasStabilised, err = checkProvisionedConcurrencyStabilised(
+			ctx,
+			lambdaService,
+			functionARN,
+			version,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &provider.ResourceHasStabilisedOutput{
 		Stabilised: hasStabilised,
 	}, nil
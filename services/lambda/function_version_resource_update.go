@@ -60,6 +60,7 @@ func (l *lambdaFunctionVersionResourceActions) Update(
 			path:                 "$.runtimePolicy",
 			fieldChangesPathRoot: "spec.runtimePolicy",
 		},
+		&functionVersionRetention{},
 	}
 
 	hasUpdates, _, err := pluginutils.RunSaveOperations(
@@ -0,0 +1,307 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// functionVersionRetention deletes published versions of a function
+// that fall outside of the retention policy of a function version resource.
+// This runs after the version has been published so the new version counts
+// towards the versions that are kept.
+type functionVersionRetention struct {
+	functionName     string
+	keepLastVersions int
+}
+
+func (u *functionVersionRetention) Name() string {
+	return "apply function version retention"
+}
+
+func (u *functionVersionRetention) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	keepLastVersions, hasRetention := pluginutils.GetValueByPath(
+		"$.retention.keepLastVersions",
+		specData,
+	)
+	if !hasRetention {
+		return false, saveOpCtx, nil
+	}
+
+	functionName, _ := pluginutils.GetValueByPath("$.functionName", specData)
+	u.functionName = core.StringValue(functionName)
+	u.keepLastVersions = int(core.IntValue(keepLastVersions))
+	return true, saveOpCtx, nil
+}
+
+func (u *functionVersionRetention) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService lambdaservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, currentVersion := extractFunctionARNAndVersion(saveOpCtx)
+	err := pruneFunctionVersions(
+		ctx,
+		lambdaService,
+		u.functionName,
+		u.keepLastVersions,
+		currentVersion,
+	)
+	return saveOpCtx, err
+}
+
+// pruneFunctionVersions deletes all published versions of a function apart from
+// the most recent keepLastVersions versions, the provided version and any versions
+// that are in use by an alias, are the target of an event source mapping or have
+// provisioned concurrency configured.
+// Retention applies to the function as a whole, the versions that are kept are
+// not limited to those published by the function version resource, so versions
+// managed by other function version resources for the same function are deleted
+// when they fall outside of the retention policy and are not otherwise in use.
+func pruneFunctionVersions(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+	keepLastVersions int,
+	currentVersion string,
+) error {
+	versions, err := listPublishedVersions(ctx, lambdaService, functionName)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) <= keepLastVersions {
+		return nil
+	}
+
+	inUse, err := listVersionsInUse(ctx, lambdaService, functionName)
+	if err != nil {
+		return err
+	}
+	inUse[currentVersion] = true
+
+	// Versions are sorted with the most recent first.
+	for _, version := range versions[keepLastVersions:] {
+		versionStr := strconv.Itoa(version)
+		if inUse[versionStr] {
+			continue
+		}
+
+		_, err := lambdaService.DeleteFunction(
+			ctx,
+			&lambda.DeleteFunctionInput{
+				FunctionName: aws.String(functionName),
+				Qualifier:    aws.String(versionStr),
+			},
+		)
+		if err != nil && !isVersionAlreadyDeletedOrInUseError(err) {
+			return fmt.Errorf(
+				"failed to delete version %s of function %s: %w",
+				versionStr,
+				functionName,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func listPublishedVersions(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+) ([]int, error) {
+	versions := []int{}
+	var marker *string
+	for {
+		output, err := lambdaService.ListVersionsByFunction(
+			ctx,
+			&lambda.ListVersionsByFunctionInput{
+				FunctionName: aws.String(functionName),
+				Marker:       marker,
+				MaxItems:     aws.Int32(50),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of function %s: %w", functionName, err)
+		}
+
+		for _, config := range output.Versions {
+			// $LATEST is the unpublished version and can not be deleted
+			// without deleting the function.
+			version, err := strconv.Atoi(aws.ToString(config.Version))
+			if err == nil {
+				versions = append(versions, version)
+			}
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	slices.Sort(versions)
+	slices.Reverse(versions)
+	return versions, nil
+}
+
+func listVersionsInUse(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+) (map[string]bool, error) {
+	inUse := map[string]bool{}
+
+	var marker *string
+	for {
+		output, err := lambdaService.ListAliases(
+			ctx,
+			&lambda.ListAliasesInput{
+				FunctionName: aws.String(functionName),
+				Marker:       marker,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases of function %s: %w", functionName, err)
+		}
+
+		for _, alias := range output.Aliases {
+			inUse[aws.ToString(alias.FunctionVersion)] = true
+			if alias.RoutingConfig != nil {
+				for version := range alias.RoutingConfig.AdditionalVersionWeights {
+					inUse[version] = true
+				}
+			}
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	marker = nil
+	for {
+		output, err := lambdaService.ListProvisionedConcurrencyConfigs(
+			ctx,
+			&lambda.ListProvisionedConcurrencyConfigsInput{
+				FunctionName: aws.String(functionName),
+				Marker:       marker,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to list provisioned concurrency configs of function %s: %w",
+				functionName,
+				err,
+			)
+		}
+
+		for _, config := range output.ProvisionedConcurrencyConfigs {
+			// Provisioned concurrency configured for an alias is covered
+			// by the alias versions, only the qualifier of the ARN is needed
+			// for configuration applied directly to a version.
+			functionARN := aws.ToString(config.FunctionArn)
+			inUse[functionARN[strings.LastIndex(functionARN, ":")+1:]] = true
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	err := addVersionsInUseByEventSourceMappings(ctx, lambdaService, functionName, inUse)
+	if err != nil {
+		return nil, err
+	}
+
+	return inUse, nil
+}
+
+// addVersionsInUseByEventSourceMappings marks the versions of a function that
+// event source mappings invoke through a version-qualified function ARN as in use.
+// Mappings are listed without a function name filter so that mappings for any
+// qualifier of the function are included.
+func addVersionsInUseByEventSourceMappings(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	functionName string,
+	inUse map[string]bool,
+) error {
+	unqualifiedName := unqualifiedFunctionName(functionName)
+
+	var marker *string
+	for {
+		output, err := lambdaService.ListEventSourceMappings(
+			ctx,
+			&lambda.ListEventSourceMappingsInput{
+				Marker: marker,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to list event source mappings for function %s: %w",
+				functionName,
+				err,
+			)
+		}
+
+		for _, mapping := range output.EventSourceMappings {
+			// Function ARNs take the form arn:partition:lambda:region:account:function:name[:qualifier].
+			arnParts := strings.Split(aws.ToString(mapping.FunctionArn), ":")
+			if len(arnParts) == 8 && arnParts[6] == unqualifiedName {
+				inUse[arnParts[7]] = true
+			}
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return nil
+}
+
+// unqualifiedFunctionName extracts the name of a function from a function name,
+// a full function ARN or a partial function ARN.
+func unqualifiedFunctionName(functionName string) string {
+	_, name, hasFunctionPrefix := strings.Cut(functionName, "function:")
+	if !hasFunctionPrefix {
+		return functionName
+	}
+
+	name, _, _ = strings.Cut(name, ":")
+	return name
+}
+
+func isVersionAlreadyDeletedOrInUseError(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	// A conflict is returned when the version is still in use by another resource
+	// such as an event source mapping, these versions are kept and will be
+	// deleted in a later deployment once they are no longer in use.
+	return apiErr.ErrorCode() == "ResourceNotFoundException" ||
+		apiErr.ErrorCode() == "ResourceConflictException"
+}
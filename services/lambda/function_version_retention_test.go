@@ -0,0 +1,539 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionVersionRetentionSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionVersionRetentionSuite) Test_function_version_retention() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		createFunctionVersionWithRetentionTestCase(providerCtx, loader),
+		createFunctionVersionWithinRetentionTestCase(providerCtx, loader),
+		createFunctionVersionRetentionWithOtherVersionResourceTestCase(providerCtx, loader),
+		updateFunctionVersionRetentionKeepsCurrentVersionTestCase(providerCtx, loader),
+		createFunctionVersionRetentionDeleteFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		FunctionVersionResource,
+		&s.Suite,
+	)
+}
+
+func createFunctionVersionWithRetentionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	version := "7"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN),
+			Version:     aws.String(version),
+		}),
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions:   createTestFunctionVersionConfigs("$LATEST", "1", "2", "3"),
+				NextMarker: aws.String("page-2"),
+			},
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs("4", "5", "6", "7"),
+			},
+		),
+		lambdamock.WithListAliasesOutput(&lambda.ListAliasesOutput{
+			Aliases: []types.AliasConfiguration{
+				{
+					Name:            aws.String("live"),
+					FunctionVersion: aws.String("4"),
+					RoutingConfig: &types.AliasRoutingConfiguration{
+						AdditionalVersionWeights: map[string]float64{
+							"3": 0.1,
+						},
+					},
+				},
+			},
+		}),
+		lambdamock.WithListProvisionedConcurrencyConfigsOutput(
+			&lambda.ListProvisionedConcurrencyConfigsOutput{
+				ProvisionedConcurrencyConfigs: []types.ProvisionedConcurrencyConfigListItem{
+					{FunctionArn: aws.String(resourceARN + ":1")},
+					{FunctionArn: aws.String(resourceARN + ":live")},
+				},
+			},
+		),
+		lambdamock.WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"retention": {
+				Fields: map[string]*core.MappingNode{
+					"keepLastVersions": core.MappingNodeFromInt(2),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create function version deletes versions outside of retention",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore:      createTestFunctionVersionConfigStore(loader),
+		Input:            createTestFunctionVersionCreateInput(providerCtx, specData),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn":            core.MappingNodeFromString(resourceARN),
+				"spec.version":                core.MappingNodeFromString(version),
+				"spec.functionArnWithVersion": core.MappingNodeFromString(resourceARN + ":" + version),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String("test-function"),
+			},
+			"ListVersionsByFunction": []any{
+				&lambda.ListVersionsByFunctionInput{
+					FunctionName: aws.String("test-function"),
+					MaxItems:     aws.Int32(50),
+				},
+				&lambda.ListVersionsByFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Marker:       aws.String("page-2"),
+					MaxItems:     aws.Int32(50),
+				},
+			},
+			"ListAliases": &lambda.ListAliasesInput{
+				FunctionName: aws.String("test-function"),
+			},
+			"ListProvisionedConcurrencyConfigs": &lambda.ListProvisionedConcurrencyConfigsInput{
+				FunctionName: aws.String("test-function"),
+			},
+			// Versions 7 and 6 are the most recent, 4 and 3 are routed to
+			// by the alias and 1 has provisioned concurrency.
+			"DeleteFunction": []any{
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("5"),
+				},
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("2"),
+				},
+			},
+		},
+	}
+}
+
+func createFunctionVersionRetentionWithOtherVersionResourceTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	version := "6"
+
+	// Version 5 was published by another function version resource for the same
+	// function and is invoked by an event source mapping through its qualified ARN.
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN),
+			Version:     aws.String(version),
+		}),
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs("$LATEST", "1", "2", "3", "4", "5", "6"),
+			},
+		),
+		lambdamock.WithListEventSourceMappingsOutput(&lambda.ListEventSourceMappingsOutput{
+			EventSourceMappings: []types.EventSourceMappingConfiguration{
+				{FunctionArn: aws.String(resourceARN + ":5")},
+				{FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:other-function:3")},
+			},
+		}),
+		lambdamock.WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"retention": {
+				Fields: map[string]*core.MappingNode{
+					"keepLastVersions": core.MappingNodeFromInt(1),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create function version keeps the version of another version resource " +
+			"that is invoked by an event source mapping",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore:      createTestFunctionVersionConfigStore(loader),
+		Input:            createTestFunctionVersionCreateInput(providerCtx, specData),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn":            core.MappingNodeFromString(resourceARN),
+				"spec.version":                core.MappingNodeFromString(version),
+				"spec.functionArnWithVersion": core.MappingNodeFromString(resourceARN + ":" + version),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"ListEventSourceMappings": &lambda.ListEventSourceMappingsInput{},
+			// Retention applies to the whole function, so versions 4 to 1 are deleted
+			// regardless of which function version resource published them.
+			"DeleteFunction": []any{
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("4"),
+				},
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("3"),
+				},
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("2"),
+				},
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("1"),
+				},
+			},
+		},
+	}
+}
+
+func createFunctionVersionWithinRetentionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	version := "2"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN),
+			Version:     aws.String(version),
+		}),
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs("$LATEST", "1", "2"),
+			},
+		),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"retention": {
+				Fields: map[string]*core.MappingNode{
+					"keepLastVersions": core.MappingNodeFromInt(5),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create function version does not delete versions within retention",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore:      createTestFunctionVersionConfigStore(loader),
+		Input:            createTestFunctionVersionCreateInput(providerCtx, specData),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn":            core.MappingNodeFromString(resourceARN),
+				"spec.version":                core.MappingNodeFromString(version),
+				"spec.functionArnWithVersion": core.MappingNodeFromString(resourceARN + ":" + version),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishVersion": &lambda.PublishVersionInput{
+				FunctionName: aws.String("test-function"),
+			},
+			"ListVersionsByFunction": &lambda.ListVersionsByFunctionInput{
+				FunctionName: aws.String("test-function"),
+				MaxItems:     aws.Int32(50),
+			},
+		},
+		SaveActionsNotCalled: []string{"ListAliases", "DeleteFunction"},
+	}
+}
+
+func updateFunctionVersionRetentionKeepsCurrentVersionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	version := "2"
+	resourceARNWithVersion := resourceARN + ":" + version
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(&lambda.GetFunctionOutput{
+			Configuration: &types.FunctionConfiguration{
+				FunctionArn: aws.String(resourceARN),
+				Version:     aws.String(version),
+			},
+		}),
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs("$LATEST", "1", "2", "3", "4"),
+			},
+		),
+		lambdamock.WithDeleteFunctionOutput(&lambda.DeleteFunctionOutput{}),
+		lambdamock.WithDeleteFunctionErrorByQualifier(map[string]error{
+			// Version 3 is still in use by an event source mapping.
+			"3": &smithy.GenericAPIError{
+				Code:    "ResourceConflictException",
+				Message: "The function version is in use",
+			},
+		}),
+	)
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":           core.MappingNodeFromString("test-function"),
+			"functionArn":            core.MappingNodeFromString(resourceARN),
+			"version":                core.MappingNodeFromString(version),
+			"functionArnWithVersion": core.MappingNodeFromString(resourceARNWithVersion),
+		},
+	}
+
+	updatedSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":           core.MappingNodeFromString("test-function"),
+			"functionArn":            core.MappingNodeFromString(resourceARN),
+			"version":                core.MappingNodeFromString(version),
+			"functionArnWithVersion": core.MappingNodeFromString(resourceARNWithVersion),
+			"retention": {
+				Fields: map[string]*core.MappingNode{
+					"keepLastVersions": core.MappingNodeFromInt(1),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update function version retention keeps the version of the resource",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore:      createTestFunctionVersionConfigStore(loader),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-function-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-function-version-id",
+					ResourceName: "TestFunctionVersion",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-function-version-id",
+						Name:       "TestFunctionVersion",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/functionVersion",
+						},
+						Spec: updatedSpecData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.retention.keepLastVersions",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.functionArn":            core.MappingNodeFromString(resourceARN),
+				"spec.version":                core.MappingNodeFromString(version),
+				"spec.functionArnWithVersion": core.MappingNodeFromString(resourceARNWithVersion),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"DeleteFunction": []any{
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("3"),
+				},
+				&lambda.DeleteFunctionInput{
+					FunctionName: aws.String("test-function"),
+					Qualifier:    aws.String("1"),
+				},
+			},
+		},
+	}
+}
+
+func createFunctionVersionRetentionDeleteFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	resourceARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithPublishVersionOutput(&lambda.PublishVersionOutput{
+			FunctionArn: aws.String(resourceARN),
+			Version:     aws.String("3"),
+		}),
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs("$LATEST", "1", "2", "3"),
+			},
+		),
+		lambdamock.WithDeleteFunctionError(errors.New("AccessDeniedException")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"retention": {
+				Fields: map[string]*core.MappingNode{
+					"keepLastVersions": core.MappingNodeFromInt(2),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create function version fails when an old version can not be deleted",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore:      createTestFunctionVersionConfigStore(loader),
+		Input:            createTestFunctionVersionCreateInput(providerCtx, specData),
+		ExpectError:      true,
+	}
+}
+
+func createTestFunctionVersionConfigs(versions ...string) []types.FunctionConfiguration {
+	configs := []types.FunctionConfiguration{}
+	for _, version := range versions {
+		configs = append(configs, types.FunctionConfiguration{
+			FunctionName: aws.String("test-function"),
+			Version:      aws.String(version),
+		})
+	}
+	return configs
+}
+
+func createTestFunctionVersionCreateInput(
+	providerCtx provider.Context,
+	specData *core.MappingNode,
+) *provider.ResourceDeployInput {
+	return &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-function-version-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-function-version-id",
+				ResourceName: "TestFunctionVersion",
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/lambda/functionVersion",
+					},
+					Spec: specData,
+				},
+			},
+			NewFields: []provider.FieldChange{
+				{
+					FieldPath: "spec.functionName",
+				},
+				{
+					FieldPath: "spec.retention.keepLastVersions",
+				},
+			},
+		},
+		ProviderContext: providerCtx,
+	}
+}
+
+func createTestFunctionVersionConfigStore(
+	loader *testutils.MockAWSConfigLoader,
+) *utils.AWSConfigStore {
+	return utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		loader,
+		utils.AWSConfigCacheKey,
+	)
+}
+
+func (s *LambdaFunctionVersionRetentionSuite) Test_list_published_versions_sorts_numerically() {
+	versions := []string{"$LATEST"}
+	for i := 1; i <= 12; i++ {
+		versions = append(versions, strconv.Itoa(i))
+	}
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithListVersionsByFunctionOutputSequence(
+			&lambda.ListVersionsByFunctionOutput{
+				Versions: createTestFunctionVersionConfigs(versions...),
+			},
+		),
+	)
+
+	published, err := listPublishedVersions(context.Background(), service, "test-function")
+	s.Require().NoError(err)
+	s.Equal([]int{12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, published)
+}
+
+func (s *LambdaFunctionVersionRetentionSuite) Test_unqualified_function_name() {
+	s.Equal("test-function", unqualifiedFunctionName("test-function"))
+	s.Equal(
+		"test-function",
+		unqualifiedFunctionName("arn:aws:lambda:us-west-2:123456789012:function:test-function"),
+	)
+	s.Equal("test-function", unqualifiedFunctionName("123456789012:function:test-function:3"))
+}
+
+func TestLambdaFunctionVersionRetentionSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionVersionRetentionSuite))
+}
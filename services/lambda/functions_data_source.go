@@ -0,0 +1,351 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// FunctionsDataSource returns a data source implementation for discovering
+// multiple AWS Lambda functions.
+func FunctionsDataSource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.DataSource {
+	yamlExample, _ := examples.ReadFile("examples/datasources/lambda_functions_yaml.md")
+	jsoncExample, _ := examples.ReadFile("examples/datasources/lambda_functions_jsonc.md")
+
+	lambdaFunctionsFetcher := &lambdaFunctionsDataSourceFetcher{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.DataSourceDefinition{
+		Type:             "aws/lambda/functions",
+		Label:            "AWS Lambda Functions",
+		PlainTextSummary: "A data source for discovering AWS Lambda functions by name prefix, runtime, architecture or tag.",
+		FormattedDescription: "The data source type used to discover [Lambda functions](https://docs.aws.amazon.com/lambda/latest/api/API_ListFunctions.html) " +
+			"managed externally in AWS. All functions in the region that match every filter are included, " +
+			"tags are only retrieved for each function when a `tag` filter is used.",
+		MarkdownExamples: []string{
+			string(yamlExample),
+			string(jsoncExample),
+		},
+		Fields: lambdaFunctionsDataSourceSchema(),
+		FilterFields: map[string]*provider.DataSourceFilterSchema{
+			"name": {
+				Type:        provider.DataSourceFilterSearchValueTypeString,
+				Description: "The name or name prefix of the Lambda functions to include.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+					schema.DataSourceFilterOperatorStartsWith,
+					schema.DataSourceFilterOperatorNotStartsWith,
+				},
+			},
+			"runtime": {
+				Type:        provider.DataSourceFilterSearchValueTypeString,
+				Description: "The runtime of the Lambda functions to include (e.g. nodejs22.x, python3.13).",
+				FormattedDescription: "The [runtime](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html) " +
+					"of the Lambda functions to include (e.g. `nodejs22.x`, `python3.13`).",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+					schema.DataSourceFilterOperatorNotEquals,
+					schema.DataSourceFilterOperatorIn,
+					schema.DataSourceFilterOperatorNotIn,
+				},
+			},
+			"architecture": {
+				Type:        provider.DataSourceFilterSearchValueTypeString,
+				Description: "The instruction set architecture of the Lambda functions to include, x86_64 or arm64.",
+				FormattedDescription: "The instruction set architecture of the Lambda functions to include, " +
+					"`x86_64` or `arm64`.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+					schema.DataSourceFilterOperatorIn,
+				},
+			},
+			"tag": {
+				Type: provider.DataSourceFilterSearchValueTypeString,
+				Description: "A tag of the Lambda functions to include. Use the equals operator with a " +
+					"key=value search to match a tag value or the has key operator with a tag key " +
+					"to match functions that have the tag with any value.",
+				FormattedDescription: "A tag of the Lambda functions to include. Use the `=` operator with a " +
+					"`key=value` search to match a tag value or the `has key` operator with a tag key " +
+					"to match functions that have the tag with any value.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+					schema.DataSourceFilterOperatorHasKey,
+				},
+			},
+			"region": {
+				Type:        provider.DataSourceFilterSearchValueTypeString,
+				Description: "The region of the Lambda functions to discover. Defaults to the region of the provider.",
+				FormattedDescription: "The [region](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) " +
+					"of the Lambda functions to discover. Defaults to the region of the provider.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+				},
+			},
+		},
+		FetchFunc: lambdaFunctionsFetcher.Fetch,
+	}
+}
+
+type lambdaFunctionsDataSourceFetcher struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaFunctionsDataSourceFetcher) getLambdaService(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (lambdaservice.Service, error) {
+	meta := map[string]*core.MappingNode{
+		"region": extractRegionFromFilters(input.DataSourceWithResolvedSubs.Filter),
+	}
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		input.ProviderContext,
+		meta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.lambdaServiceFactory(awsConfig, input.ProviderContext), nil
+}
+
+func (l *lambdaFunctionsDataSourceFetcher) Fetch(
+	ctx context.Context,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := functionListFiltersByField(input.DataSourceWithResolvedSubs.Filter)
+	tagFilters, err := functionTagFilters(filters["tag"])
+	if err != nil {
+		return nil, err
+	}
+
+	arns := []*core.MappingNode{}
+	names := []*core.MappingNode{}
+	var marker *string
+	for {
+		output, err := lambdaService.ListFunctions(
+			ctx,
+			&lambda.ListFunctionsInput{
+				Marker:   marker,
+				MaxItems: aws.Int32(50),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Lambda functions: %w", err)
+		}
+
+		for _, function := range output.Functions {
+			matches, err := functionMatchesListFilters(
+				ctx,
+				lambdaService,
+				function,
+				filters,
+				tagFilters,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if matches {
+				arns = append(arns, core.MappingNodeFromString(aws.ToString(function.FunctionArn)))
+				names = append(names, core.MappingNodeFromString(aws.ToString(function.FunctionName)))
+			}
+		}
+
+		if aws.ToString(output.NextMarker) == "" {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: map[string]*core.MappingNode{
+			"arns":  {Items: arns},
+			"names": {Items: names},
+		},
+	}, nil
+}
+
+func functionListFiltersByField(
+	filters *provider.ResolvedDataSourceFilters,
+) map[string][]*provider.ResolvedDataSourceFilter {
+	filtersByField := map[string][]*provider.ResolvedDataSourceFilter{}
+	if filters == nil {
+		return filtersByField
+	}
+
+	for _, filter := range filters.Filters {
+		field := core.StringValueFromScalar(filter.Field)
+		filtersByField[field] = append(filtersByField[field], filter)
+	}
+	return filtersByField
+}
+
+// functionTagFilter matches functions that have a tag with the given key,
+// and if hasValue is true, the given value.
+type functionTagFilter struct {
+	keys     []string
+	values   []string
+	hasValue bool
+}
+
+func functionTagFilters(filters []*provider.ResolvedDataSourceFilter) ([]*functionTagFilter, error) {
+	tagFilters := []*functionTagFilter{}
+	for _, filter := range filters {
+		tagFilter := &functionTagFilter{
+			hasValue: pluginutils.GetDataSourceFilterOperator(filter) == schema.DataSourceFilterOperatorEquals,
+		}
+
+		for _, searchValue := range pluginutils.GetDataSourceFilterSearchValues(filter) {
+			search := core.StringValue(searchValue)
+			if !tagFilter.hasValue {
+				tagFilter.keys = append(tagFilter.keys, search)
+				continue
+			}
+
+			key, value, hasSeparator := strings.Cut(search, "=")
+			if !hasSeparator {
+				return nil, fmt.Errorf(
+					"tag filter search value %q must be in the form \"key=value\"",
+					search,
+				)
+			}
+			tagFilter.keys = append(tagFilter.keys, key)
+			tagFilter.values = append(tagFilter.values, value)
+		}
+
+		tagFilters = append(tagFilters, tagFilter)
+	}
+
+	return tagFilters, nil
+}
+
+// matches determines whether any of the search values of the tag filter
+// match the provided tags.
+func (f *functionTagFilter) matches(tags map[string]string) bool {
+	for i, key := range f.keys {
+		value, hasKey := tags[key]
+		if hasKey && (!f.hasValue || value == f.values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func functionMatchesListFilters(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	function types.FunctionConfiguration,
+	filters map[string][]*provider.ResolvedDataSourceFilter,
+	tagFilters []*functionTagFilter,
+) (bool, error) {
+	architectures := []string{}
+	for _, architecture := range function.Architectures {
+		architectures = append(architectures, string(architecture))
+	}
+	if len(architectures) == 0 {
+		// Functions that were created without an explicit architecture
+		// use the default of x86_64.
+		architectures = append(architectures, string(types.ArchitectureX8664))
+	}
+
+	fieldValues := map[string][]string{
+		"name":         {aws.ToString(function.FunctionName)},
+		"runtime":      {string(function.Runtime)},
+		"architecture": architectures,
+	}
+	for field, values := range fieldValues {
+		for _, filter := range filters[field] {
+			if !stringFilterMatches(filter, values) {
+				return false, nil
+			}
+		}
+	}
+
+	if len(tagFilters) == 0 {
+		return true, nil
+	}
+
+	// Tags are not included in the ListFunctions output so they are fetched
+	// for each function that matches the other filters.
+	tagsOutput, err := lambdaService.ListTags(
+		ctx,
+		&lambda.ListTagsInput{
+			Resource: function.FunctionArn,
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to list tags for Lambda function %q: %w",
+			aws.ToString(function.FunctionName),
+			err,
+		)
+	}
+
+	for _, tagFilter := range tagFilters {
+		if !tagFilter.matches(tagsOutput.Tags) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// stringFilterMatches determines whether any of the provided values match
+// the search values of a filter for the filter's operator.
+func stringFilterMatches(
+	filter *provider.ResolvedDataSourceFilter,
+	values []string,
+) bool {
+	searchValues := []string{}
+	for _, searchValue := range pluginutils.GetDataSourceFilterSearchValues(filter) {
+		searchValues = append(searchValues, core.StringValue(searchValue))
+	}
+
+	matchesAny := func(match func(value string, search string) bool) bool {
+		for _, value := range values {
+			for _, search := range searchValues {
+				if match(value, search) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	switch pluginutils.GetDataSourceFilterOperator(filter) {
+	case schema.DataSourceFilterOperatorEquals, schema.DataSourceFilterOperatorIn:
+		return matchesAny(func(value, search string) bool { return value == search })
+	case schema.DataSourceFilterOperatorNotEquals, schema.DataSourceFilterOperatorNotIn:
+		return !matchesAny(func(value, search string) bool { return value == search })
+	case schema.DataSourceFilterOperatorStartsWith:
+		return matchesAny(strings.HasPrefix)
+	case schema.DataSourceFilterOperatorNotStartsWith:
+		return !matchesAny(strings.HasPrefix)
+	default:
+		return slices.ContainsFunc(values, func(value string) bool {
+			return slices.Contains(searchValues, value)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package lambda
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+
+func lambdaFunctionsDataSourceSchema() map[string]*provider.DataSourceSpecSchema {
+	return map[string]*provider.DataSourceSpecSchema{
+		"arns": {
+			Label:       "Function ARNs",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The unqualified ARNs of the Lambda functions that match the filters.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+		"names": {
+			Label:       "Function Names",
+			Type:        provider.DataSourceSpecTypeArray,
+			Description: "The names of the Lambda functions that match the filters, in the same order as arns.",
+			Items: &provider.DataSourceSpecSchema{
+				Type: provider.DataSourceSpecTypeString,
+			},
+			Nullable: false,
+		},
+	}
+}
@@ -0,0 +1,348 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaFunctionsDataSourceSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaFunctionsDataSourceSuite) Test_fetch() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			pluginutils.SessionIDKey: core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []DataSourceFetchTestCase{
+		createFunctionsByNamePrefixAndRuntimeTestCase(providerCtx, loader),
+		createFunctionsByArchitectureTestCase(providerCtx, loader),
+		createFunctionsByTagTestCase(providerCtx, loader),
+		createFunctionsNoMatchesTestCase(providerCtx, loader),
+		createFunctionsInvalidTagFilterTestCase(providerCtx, loader),
+		createFunctionsListErrorTestCase(providerCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			dataSource := FunctionsDataSource(tc.ServiceFactory, tc.ConfigStore)
+
+			output, err := dataSource.Fetch(context.Background(), tc.Input)
+
+			if tc.ExpectError {
+				s.Error(err)
+				if tc.ExpectedErrorMessage != "" {
+					s.ErrorContains(err, tc.ExpectedErrorMessage)
+				}
+				s.Nil(output)
+			} else {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Equal(tc.ExpectedOutput.Data, output.Data)
+			}
+		})
+	}
+}
+
+func (s *LambdaFunctionsDataSourceSuite) Test_fetch_pages_through_all_functions() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+	)
+
+	dataSource := FunctionsDataSource(
+		func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		createTestFunctionsConfigStore(&testutils.MockAWSConfigLoader{}),
+	)
+
+	_, err := dataSource.Fetch(
+		context.Background(),
+		createTestFunctionsFetchInput(
+			plugintestutils.NewTestProviderContext("aws", nil, nil),
+		),
+	)
+	s.Require().NoError(err)
+
+	service.AssertCalledWith(
+		&s.Suite,
+		"ListFunctions",
+		0,
+		plugintestutils.Any,
+		&lambda.ListFunctionsInput{
+			MaxItems: aws.Int32(50),
+		},
+	)
+	service.AssertCalledWith(
+		&s.Suite,
+		"ListFunctions",
+		1,
+		plugintestutils.Any,
+		&lambda.ListFunctionsInput{
+			Marker:   aws.String("page-2"),
+			MaxItems: aws.Int32(50),
+		},
+	)
+	// Tags are only fetched when there is a tag filter.
+	service.AssertNotCalled(&s.Suite, "ListTags")
+}
+
+func createFunctionsByNamePrefixAndRuntimeTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "fetches functions by name prefix and runtime across pages",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+		),
+		ConfigStore: createTestFunctionsConfigStore(loader),
+		Input: createTestFunctionsFetchInput(
+			providerCtx,
+			createTestFunctionsFilter("name", schema.DataSourceFilterOperatorStartsWith, "orders-"),
+			createTestFunctionsFilter("runtime", schema.DataSourceFilterOperatorIn, "nodejs20.x", "nodejs22.x"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestFunctionsData("orders-api", "orders-worker"),
+		},
+	}
+}
+
+func createFunctionsByArchitectureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "fetches functions by architecture with x86_64 as the default",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+		),
+		ConfigStore: createTestFunctionsConfigStore(loader),
+		Input: createTestFunctionsFetchInput(
+			providerCtx,
+			createTestFunctionsFilter("architecture", schema.DataSourceFilterOperatorEquals, "x86_64"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestFunctionsData("orders-api", "payments-api"),
+		},
+	}
+}
+
+func createFunctionsByTagTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "fetches functions by tag value and tag key",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+			lambdamock.WithListTagsOutputByResource(map[string]*lambda.ListTagsOutput{
+				testFunctionListArn("orders-api"): {
+					Tags: map[string]string{"team": "orders", "costCentre": "123"},
+				},
+				testFunctionListArn("orders-worker"): {
+					Tags: map[string]string{"team": "orders"},
+				},
+				testFunctionListArn("payments-api"): {
+					Tags: map[string]string{"team": "payments", "costCentre": "456"},
+				},
+			}),
+			lambdamock.WithListTagsOutput(&lambda.ListTagsOutput{}),
+		),
+		ConfigStore: createTestFunctionsConfigStore(loader),
+		Input: createTestFunctionsFetchInput(
+			providerCtx,
+			createTestFunctionsFilter("tag", schema.DataSourceFilterOperatorEquals, "team=orders"),
+			createTestFunctionsFilter("tag", schema.DataSourceFilterOperatorHasKey, "costCentre"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestFunctionsData("orders-api"),
+		},
+	}
+}
+
+func createFunctionsNoMatchesTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "returns empty lists when no functions match",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+		),
+		ConfigStore: createTestFunctionsConfigStore(loader),
+		Input: createTestFunctionsFetchInput(
+			providerCtx,
+			createTestFunctionsFilter("runtime", schema.DataSourceFilterOperatorEquals, "java21"),
+		),
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestFunctionsData(),
+		},
+	}
+}
+
+func createFunctionsInvalidTagFilterTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "fails when a tag equals filter is not in the key=value form",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsOutputSequence(createTestFunctionListPages()...),
+		),
+		ConfigStore: createTestFunctionsConfigStore(loader),
+		Input: createTestFunctionsFetchInput(
+			providerCtx,
+			createTestFunctionsFilter("tag", schema.DataSourceFilterOperatorEquals, "team"),
+		),
+		ExpectError:          true,
+		ExpectedErrorMessage: `tag filter search value "team" must be in the form "key=value"`,
+	}
+}
+
+func createFunctionsListErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) DataSourceFetchTestCase {
+	return DataSourceFetchTestCase{
+		Name: "fails when functions can not be listed",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListFunctionsError(errors.New("AccessDeniedException")),
+		),
+		ConfigStore:          createTestFunctionsConfigStore(loader),
+		Input:                createTestFunctionsFetchInput(providerCtx),
+		ExpectError:          true,
+		ExpectedErrorMessage: "failed to list Lambda functions",
+	}
+}
+
+func createTestFunctionListPages() []*lambda.ListFunctionsOutput {
+	return []*lambda.ListFunctionsOutput{
+		{
+			Functions: []types.FunctionConfiguration{
+				createTestFunctionListItem("orders-api", types.RuntimeNodejs22x),
+				createTestFunctionListItem(
+					"orders-worker",
+					types.RuntimeNodejs20x,
+					types.ArchitectureArm64,
+				),
+			},
+			NextMarker: aws.String("page-2"),
+		},
+		{
+			Functions: []types.FunctionConfiguration{
+				createTestFunctionListItem(
+					"payments-api",
+					types.RuntimePython313,
+					types.ArchitectureX8664,
+				),
+				createTestFunctionListItem(
+					"orders-legacy",
+					types.RuntimePython39,
+					types.ArchitectureArm64,
+				),
+			},
+		},
+	}
+}
+
+func createTestFunctionListItem(
+	name string,
+	runtime types.Runtime,
+	architectures ...types.Architecture,
+) types.FunctionConfiguration {
+	return types.FunctionConfiguration{
+		FunctionName:  aws.String(name),
+		FunctionArn:   aws.String(testFunctionListArn(name)),
+		Runtime:       runtime,
+		Architectures: architectures,
+	}
+}
+
+func testFunctionListArn(name string) string {
+	return "arn:aws:lambda:us-west-2:123456789012:function:" + name
+}
+
+func createTestFunctionsData(names ...string) map[string]*core.MappingNode {
+	arns := []*core.MappingNode{}
+	nameNodes := []*core.MappingNode{}
+	for _, name := range names {
+		arns = append(arns, core.MappingNodeFromString(testFunctionListArn(name)))
+		nameNodes = append(nameNodes, core.MappingNodeFromString(name))
+	}
+	return map[string]*core.MappingNode{
+		"arns":  {Items: arns},
+		"names": {Items: nameNodes},
+	}
+}
+
+func createTestFunctionsFilter(
+	field string,
+	operator schema.DataSourceFilterOperator,
+	values ...string,
+) *provider.ResolvedDataSourceFilter {
+	searchValues := []*core.MappingNode{}
+	for _, value := range values {
+		searchValues = append(searchValues, core.MappingNodeFromString(value))
+	}
+	return &provider.ResolvedDataSourceFilter{
+		Field: core.ScalarFromString(field),
+		Operator: &schema.DataSourceFilterOperatorWrapper{
+			Value: operator,
+		},
+		Search: &provider.ResolvedDataSourceFilterSearch{
+			Values: searchValues,
+		},
+	}
+}
+
+func createTestFunctionsFetchInput(
+	providerCtx provider.Context,
+	filters ...*provider.ResolvedDataSourceFilter,
+) *provider.DataSourceFetchInput {
+	return &provider.DataSourceFetchInput{
+		ProviderContext: providerCtx,
+		DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+			Filter: &provider.ResolvedDataSourceFilters{
+				Filters: filters,
+			},
+		},
+	}
+}
+
+func createTestFunctionsConfigStore(
+	loader *testutils.MockAWSConfigLoader,
+) *utils.AWSConfigStore {
+	return utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		loader,
+		utils.AWSConfigCacheKey,
+	)
+}
+
+func TestLambdaFunctionsDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(LambdaFunctionsDataSourceSuite))
+}
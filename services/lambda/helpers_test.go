@@ -1,11 +1,30 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	applicationautoscalingmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/applicationautoscaling_mock"
+	cloudwatchmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/cloudwatch_mock"
+	ecrmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/ecr_mock"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	s3mock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/s3_mock"
+	applicationautoscalingservice "github.com/newstack-cloud/bluelink-provider-aws/services/applicationautoscaling/service"
+	cloudwatchservice "github.com/newstack-cloud/bluelink-provider-aws/services/cloudwatch/service"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 )
 
 func createBaseTestFunctionConfig(
@@ -30,3 +49,208 @@ func createBaseTestFunctionConfig(
 		},
 	}
 }
+
+// newTestFunctionResource creates a function resource for tests
+// that do not package code from a local source.
+func newTestFunctionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestFunctionResourceWithECR(
+		ecrmock.CreateECRServiceMockFactory(),
+	)(lambdaServiceFactory, awsConfigStore)
+}
+
+// newTestFunctionResourceWithECR returns a function that creates a function resource
+// that uses the provided ECR service to resolve container image digests.
+func newTestFunctionResourceWithECR(
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecrservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestFunctionResourceWithServices(
+		ecrServiceFactory,
+		logsmock.CreateLogsServiceMockFactory(),
+	)
+}
+
+// newTestFunctionResourceWithLogs returns a function that creates a function resource
+// that uses the provided CloudWatch Logs service to manage log group retention.
+func newTestFunctionResourceWithLogs(
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestFunctionResourceWithServices(
+		ecrmock.CreateECRServiceMockFactory(),
+		logsServiceFactory,
+	)
+}
+
+func newTestFunctionResourceWithServices(
+	ecrServiceFactory pluginutils.ServiceFactory[*aws.Config, ecrservice.Service],
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return func(
+		lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+		awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	) provider.Resource {
+		return newFunctionResource(
+			lambdaServiceFactory,
+			s3mock.CreateS3ServiceMockFactory(),
+			ecrServiceFactory,
+			logsServiceFactory,
+			awsConfigStore,
+			newTestFunctionUpdateWaiter(),
+		)
+	}
+}
+
+// newTestFunctionUpdateWaiter creates a waiter with short delays
+// so tests that poll for in-progress updates complete quickly.
+func newTestFunctionUpdateWaiter() *functionUpdateWaiter {
+	return &functionUpdateWaiter{
+		initialDelay: 1 * time.Millisecond,
+		maxDelay:     5 * time.Millisecond,
+		maxAttempts:  5,
+	}
+}
+
+// newTestAliasResource creates an alias resource for tests
+// that do not check the state of deployment alarms.
+func newTestAliasResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestAliasResourceWithCloudWatch(
+		cloudwatchmock.CreateCloudWatchServiceMockFactory(),
+	)(lambdaServiceFactory, awsConfigStore)
+}
+
+// newTestAliasResourceWithCloudWatch returns a function that creates an alias resource
+// that uses the provided CloudWatch service to check the state of deployment alarms
+// and does not wait between the steps of a progressive deployment.
+func newTestAliasResourceWithCloudWatch(
+	cloudwatchServiceFactory pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestAliasResourceWithServices(
+		cloudwatchServiceFactory,
+		applicationautoscalingmock.CreateApplicationAutoScalingServiceMockFactory(),
+	)
+}
+
+// newTestAliasResourceWithAutoScaling returns a function that creates an alias resource
+// that uses the provided Application Auto Scaling service to scale provisioned concurrency.
+func newTestAliasResourceWithAutoScaling(
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newTestAliasResourceWithServices(
+		cloudwatchmock.CreateCloudWatchServiceMockFactory(),
+		autoScalingServiceFactory,
+	)
+}
+
+func newTestAliasResourceWithServices(
+	cloudwatchServiceFactory pluginutils.ServiceFactory[*aws.Config, cloudwatchservice.Service],
+	autoScalingServiceFactory pluginutils.ServiceFactory[*aws.Config, applicationautoscalingservice.Service],
+) func(
+	pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return func(
+		lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+		awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	) provider.Resource {
+		return newAliasResource(
+			lambdaServiceFactory,
+			cloudwatchServiceFactory,
+			autoScalingServiceFactory,
+			awsConfigStore,
+			func(ctx context.Context, duration time.Duration) error {
+				return ctx.Err()
+			},
+		)
+	}
+}
+
+// newTestLayerVersionResource creates a layer version resource for tests
+// that do not package content from a local source.
+func newTestLayerVersionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return LayerVersionResource(
+		lambdaServiceFactory,
+		s3mock.CreateS3ServiceMockFactory(),
+		awsConfigStore,
+	)
+}
+
+// createTestCodeSourceDir writes a small multi-file code source
+// to a temporary directory and returns the path to the directory
+// along with the archive that is expected to be produced for the source
+// when test files are excluded.
+func createTestCodeSourceDir(t *testing.T) (string, *utils.ZipArchive) {
+	sourceDir := t.TempDir()
+	files := map[string]string{
+		"index.js":         "exports.handler = async () => require('./lib/util').run();",
+		"lib/util.js":      "exports.run = () => 'ok';",
+		"lib/util.test.js": "test('run', () => {});",
+	}
+	for relPath, content := range files {
+		fullPath := filepath.Join(sourceDir, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive, err := utils.ZipFromSource(&utils.ZipSource{
+		Path:    sourceDir,
+		Exclude: []string{"**/*.test.js"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sourceDir, archive
+}
+
+// changeTestCodeSourceFiles changes the contents of a file in a code source
+// created by createTestCodeSourceDir without changing the code source spec.
+func changeTestCodeSourceFiles(t *testing.T, sourceDir string) {
+	err := os.WriteFile(
+		filepath.Join(sourceDir, "lib", "util.js"),
+		[]byte("exports.run = () => 'updated';"),
+		0644,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func createTestCodeSourceSpec(sourceDir string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"path": core.MappingNodeFromString(sourceDir),
+			"exclude": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("**/*.test.js"),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,130 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	ecrservice "github.com/newstack-cloud/bluelink-provider-aws/services/ecr/service"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// resolvedImage holds a container image URI that references an image
+// by the digest that a tag pointed to when the function was deployed.
+type resolvedImage struct {
+	// uri is in the form {registry}/{repository}@{digest}.
+	uri    string
+	digest string
+}
+
+// imageReference holds the parts of an Amazon ECR image URI in the form
+// {account}.dkr.ecr.{region}.amazonaws.com/{repository}[:{tag}|@{digest}].
+type imageReference struct {
+	registry   string
+	registryID string
+	repository string
+	tag        string
+	digest     string
+}
+
+var ecrRegistryPattern = regexp.MustCompile(`^([0-9]{12})\.dkr\.ecr(-fips)?\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+func parseImageURI(imageURI string) (*imageReference, error) {
+	registry, repositoryAndRef, hasRepository := strings.Cut(imageURI, "/")
+	registryMatch := ecrRegistryPattern.FindStringSubmatch(registry)
+	if !hasRepository || registryMatch == nil {
+		return nil, fmt.Errorf("%q is not an Amazon ECR image URI", imageURI)
+	}
+
+	ref := &imageReference{
+		registry:   registry,
+		registryID: registryMatch[1],
+	}
+	if repository, digest, hasDigest := strings.Cut(repositoryAndRef, "@"); hasDigest {
+		ref.repository = repository
+		ref.digest = digest
+		return ref, nil
+	}
+
+	// Repository names can not contain a colon, so the last colon
+	// always separates the repository name from the tag.
+	separatorIndex := strings.LastIndex(repositoryAndRef, ":")
+	if separatorIndex == -1 {
+		// Container tooling defaults to the "latest" tag
+		// when an image URI does not include a tag.
+		ref.repository = repositoryAndRef
+		ref.tag = "latest"
+		return ref, nil
+	}
+
+	ref.repository = repositoryAndRef[:separatorIndex]
+	ref.tag = repositoryAndRef[separatorIndex+1:]
+	return ref, nil
+}
+
+// resolveImageDigest looks up the digest of the image that the tag in an image URI
+// currently points to in Amazon ECR.
+// Image URIs that already reference an image by digest are returned as they are.
+func resolveImageDigest(
+	ctx context.Context,
+	ecrService ecrservice.Service,
+	imageURI string,
+) (*resolvedImage, error) {
+	ref, err := parseImageURI(imageURI)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.digest != "" {
+		return &resolvedImage{
+			uri:    imageURI,
+			digest: ref.digest,
+		}, nil
+	}
+
+	output, err := ecrService.DescribeImages(
+		ctx,
+		&ecr.DescribeImagesInput{
+			RegistryId:     aws.String(ref.registryID),
+			RepositoryName: aws.String(ref.repository),
+			ImageIds: []ecrtypes.ImageIdentifier{
+				{
+					ImageTag: aws.String(ref.tag),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the digest of image %s: %w", imageURI, err)
+	}
+
+	if len(output.ImageDetails) == 0 || output.ImageDetails[0].ImageDigest == nil {
+		return nil, fmt.Errorf(
+			"the %s tag was not found in the %s repository",
+			ref.tag,
+			ref.repository,
+		)
+	}
+
+	digest := aws.ToString(output.ImageDetails[0].ImageDigest)
+	return &resolvedImage{
+		uri:    fmt.Sprintf("%s/%s@%s", ref.registry, ref.repository, digest),
+		digest: digest,
+	}, nil
+}
+
+// imageDigestFromURI extracts the digest from an image URI that references
+// an image by digest, such as the resolved image URI reported by Lambda.
+func imageDigestFromURI(imageURI string) string {
+	_, digest, _ := strings.Cut(imageURI, "@")
+	return digest
+}
+
+func getResolvedImage(saveOpCtx pluginutils.SaveOperationContext) (*resolvedImage, bool) {
+	resolved, ok := saveOpCtx.Data["resolvedImage"].(*resolvedImage)
+	return resolved, ok && resolved != nil
+}
@@ -22,6 +22,7 @@ func LayerVersionDataSource(
 ) provider.DataSource {
 	yamlExample, _ := examples.ReadFile("examples/datasources/lambda_layer_version_basic.md")
 	jsoncExample, _ := examples.ReadFile("examples/datasources/lambda_layer_version_jsonc.md")
+	latestExample, _ := examples.ReadFile("examples/datasources/lambda_layer_version_latest.md")
 
 	lambdaLayerVersionFetcher := &lambdaLayerVersionDataSourceFetcher{
 		lambdaServiceFactory,
@@ -36,14 +37,19 @@ func LayerVersionDataSource(
 		MarkdownExamples: []string{
 			string(yamlExample),
 			string(jsoncExample),
+			string(latestExample),
 		},
 		Fields: lambdaLayerVersionDataSourceSchema(),
 		FilterFields: map[string]*provider.DataSourceFilterSchema{
 			"layerName": {
-				Type:        provider.DataSourceFilterSearchValueTypeString,
-				Description: "The name or ARN of the layer.",
+				Type: provider.DataSourceFilterSearchValueTypeString,
+				Description: "The name or ARN of the layer. A layer ARN can be used to retrieve a layer " +
+					"shared from another account, when the ARN includes a version number, that version is retrieved.",
 				FormattedDescription: "The name or ARN of the layer. " +
-					"For example: `my-layer`, `arn:aws:lambda:us-east-2:123456789012:layer:my-layer`.",
+					"For example: `my-layer`, `arn:aws:lambda:us-east-2:123456789012:layer:my-layer`.\n\n" +
+					"A layer ARN can be used to retrieve a layer shared from another account, " +
+					"when the ARN includes a version number, such as `arn:aws:lambda:us-east-2:123456789012:layer:my-layer:3`, " +
+					"that version is retrieved. The region of a layer ARN is used when the `region` filter is not set.",
 				SupportedOperators: []schema.DataSourceFilterOperator{
 					schema.DataSourceFilterOperatorEquals,
 				},
@@ -54,6 +60,37 @@ func LayerVersionDataSource(
 				SupportedOperators: []schema.DataSourceFilterOperator{
 					schema.DataSourceFilterOperatorEquals,
 				},
+				ConflictsWith: []string{"latest"},
+			},
+			"latest": {
+				Type: provider.DataSourceFilterSearchValueTypeBoolean,
+				Description: "When set to true, the most recent version of the layer that matches the " +
+					"compatibleRuntime and compatibleArchitecture filters is retrieved.",
+				FormattedDescription: "When set to `true`, the most recent version of the layer that matches the " +
+					"`compatibleRuntime` and `compatibleArchitecture` filters is retrieved.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+				},
+				ConflictsWith: []string{"versionNumber"},
+			},
+			"compatibleRuntime": {
+				Type: provider.DataSourceFilterSearchValueTypeString,
+				Description: "A runtime identifier that the layer version must be compatible with. " +
+					"For example, nodejs20.x.",
+				FormattedDescription: "A [runtime identifier](https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html) " +
+					"that the layer version must be compatible with. For example, `nodejs20.x`.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+				},
+			},
+			"compatibleArchitecture": {
+				Type:        provider.DataSourceFilterSearchValueTypeString,
+				Description: "An instruction set architecture that the layer version must be compatible with, x86_64 or arm64.",
+				FormattedDescription: "An [instruction set architecture](https://docs.aws.amazon.com/lambda/latest/dg/foundation-arch.html) " +
+					"that the layer version must be compatible with, `x86_64` or `arm64`.",
+				SupportedOperators: []schema.DataSourceFilterOperator{
+					schema.DataSourceFilterOperatorEquals,
+				},
 			},
 			"region": {
 				Type:        provider.DataSourceFilterSearchValueTypeString,
@@ -78,8 +115,18 @@ func (l *lambdaLayerVersionDataSourceFetcher) getLambdaService(
 	ctx context.Context,
 	input *provider.DataSourceFetchInput,
 ) (lambdaservice.Service, error) {
+	region := extractRegionFromFilters(input.DataSourceWithResolvedSubs.Filter)
+	if region == nil {
+		// A layer shared from another region must be retrieved from
+		// the region in the layer ARN.
+		layerName := extractLayerNameFromFilters(input.DataSourceWithResolvedSubs.Filter)
+		layerRef := parseLayerReference(core.StringValue(layerName))
+		if layerRef.region != "" {
+			region = core.MappingNodeFromString(layerRef.region)
+		}
+	}
 	meta := map[string]*core.MappingNode{
-		"region": extractRegionFromFilters(input.DataSourceWithResolvedSubs.Filter),
+		"region": region,
 	}
 	awsConfig, err := l.awsConfigStore.FromProviderContext(
 		ctx,
@@ -102,23 +149,44 @@ func (l *lambdaLayerVersionDataSourceFetcher) Fetch(
 		return nil, fmt.Errorf("failed to get Lambda service: %w", err)
 	}
 
-	layerName := extractLayerNameFromFilters(input.DataSourceWithResolvedSubs.Filter)
-	versionNumber := extractVersionNumberFromFilters(input.DataSourceWithResolvedSubs.Filter)
+	filters := input.DataSourceWithResolvedSubs.Filter
+	layerName := extractLayerNameFromFilters(filters)
+	versionNumber := extractVersionNumberFromFilters(filters)
+	latest := extractLatestFromFilters(filters)
 
 	if layerName == nil {
 		return nil, fmt.Errorf("layerName filter is required for the lambda layer version data source")
 	}
-	if versionNumber == nil {
-		return nil, fmt.Errorf("versionNumber filter is required for the lambda layer version data source")
+
+	layerRef := parseLayerReference(core.StringValue(layerName))
+	compatibility := layerVersionCompatibility{
+		runtime:      core.StringValue(extractCompatibleRuntimeFromFilters(filters)),
+		architecture: core.StringValue(extractCompatibleArchitectureFromFilters(filters)),
 	}
 
-	versionInt, err := strconv.ParseInt(core.StringValue(versionNumber), 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("versionNumber must be a valid integer: %w", err)
+	var versionInt int64
+	switch {
+	case core.BoolValue(latest):
+		versionInt, err = resolveLatestLayerVersion(ctx, lambdaService, layerRef.name, compatibility)
+		if err != nil {
+			return nil, err
+		}
+	case versionNumber != nil:
+		versionInt, err = strconv.ParseInt(core.StringValue(versionNumber), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("versionNumber must be a valid integer: %w", err)
+		}
+	case layerRef.version != nil:
+		versionInt = *layerRef.version
+	default:
+		return nil, fmt.Errorf(
+			"versionNumber filter is required for the lambda layer version data source " +
+				"when latest is not set to true and layerName is not a layer version ARN",
+		)
 	}
 
 	getLayerVersionInput := &lambda.GetLayerVersionInput{
-		LayerName:     aws.String(core.StringValue(layerName)),
+		LayerName:     aws.String(layerRef.name),
 		VersionNumber: aws.Int64(versionInt),
 	}
 
@@ -127,6 +195,18 @@ func (l *lambdaLayerVersionDataSourceFetcher) Fetch(
 		return nil, fmt.Errorf("failed to get Lambda layer version: %w", err)
 	}
 
+	if !compatibility.matches(
+		layerVersionOutput.CompatibleRuntimes,
+		layerVersionOutput.CompatibleArchitectures,
+	) {
+		return nil, fmt.Errorf(
+			"version %d of layer %s is not compatible with %s",
+			versionInt,
+			layerRef.name,
+			compatibility,
+		)
+	}
+
 	data := l.createBaseData(layerVersionOutput)
 
 	err = l.addOptionalConfigurationsToData(layerVersionOutput, data.Fields)
@@ -199,3 +279,30 @@ func extractVersionNumberFromFilters(
 		"versionNumber",
 	)
 }
+
+func extractLatestFromFilters(
+	filters *provider.ResolvedDataSourceFilters,
+) *core.MappingNode {
+	return pluginutils.ExtractMatchFromFilters(
+		filters,
+		"latest",
+	)
+}
+
+func extractCompatibleRuntimeFromFilters(
+	filters *provider.ResolvedDataSourceFilters,
+) *core.MappingNode {
+	return pluginutils.ExtractMatchFromFilters(
+		filters,
+		"compatibleRuntime",
+	)
+}
+
+func extractCompatibleArchitectureFromFilters(
+	filters *provider.ResolvedDataSourceFilters,
+) *core.MappingNode {
+	return pluginutils.ExtractMatchFromFilters(
+		filters,
+		"compatibleArchitecture",
+	)
+}
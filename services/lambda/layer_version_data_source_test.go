@@ -3,6 +3,7 @@ package lambda
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/newstack-cloud/bluelink-provider-aws/utils"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 	"github.com/stretchr/testify/suite"
@@ -54,6 +56,10 @@ func (s *LambdaLayerVersionDataSourceSuite) Test_fetch() {
 		createLayerVersionFetchErrorTestCase(providerCtx, loader),
 		createLayerVersionMissingLayerNameTestCase(providerCtx, loader),
 		createLayerVersionMissingVersionNumberTestCase(providerCtx, loader),
+		createLatestCompatibleLayerVersionTestCase(providerCtx, loader),
+		createLayerVersionFromVersionARNTestCase(providerCtx, loader),
+		createLatestLayerVersionNoCompatibleVersionsTestCase(providerCtx, loader),
+		createIncompatibleLayerVersionTestCase(providerCtx, loader),
 	}
 
 	for _, tc := range testCases {
@@ -80,6 +86,113 @@ func (s *LambdaLayerVersionDataSourceSuite) Test_fetch() {
 	}
 }
 
+func (s *LambdaLayerVersionDataSourceSuite) Test_fetch_latest_pages_through_layer_versions() {
+	layerARN := "arn:aws:lambda:eu-west-1:210987654321:layer:shared-layer"
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithListLayerVersionsOutputSequence(createTestLayerVersionListPages(layerARN)...),
+		lambdamock.WithGetLayerVersionOutput(createTestCompatibleLayerVersionOutput(layerARN, 7)),
+	)
+
+	dataSource := LayerVersionDataSource(
+		func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			&testutils.MockAWSConfigLoader{},
+			utils.AWSConfigCacheKey,
+		),
+	)
+
+	_, err := dataSource.Fetch(
+		context.Background(),
+		&provider.DataSourceFetchInput{
+			ProviderContext: plugintestutils.NewTestProviderContext("aws", nil, nil),
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: &provider.ResolvedDataSourceFilters{
+					Filters: []*provider.ResolvedDataSourceFilter{
+						// The version in the ARN is ignored when latest is set.
+						pluginutils.CreateStringEqualsFilter("layerName", layerARN+":2").Filters[0],
+						createTestLatestFilter(),
+						pluginutils.CreateStringEqualsFilter("compatibleRuntime", "nodejs20.x").Filters[0],
+						pluginutils.CreateStringEqualsFilter("compatibleArchitecture", "arm64").Filters[0],
+					},
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	service.AssertCalledWith(
+		&s.Suite,
+		"ListLayerVersions",
+		0,
+		plugintestutils.Any,
+		&lambda.ListLayerVersionsInput{
+			LayerName:              aws.String(layerARN),
+			CompatibleRuntime:      types.RuntimeNodejs20x,
+			CompatibleArchitecture: types.ArchitectureArm64,
+			MaxItems:               aws.Int32(50),
+		},
+	)
+	service.AssertCalledWith(
+		&s.Suite,
+		"ListLayerVersions",
+		1,
+		plugintestutils.Any,
+		&lambda.ListLayerVersionsInput{
+			LayerName:              aws.String(layerARN),
+			CompatibleRuntime:      types.RuntimeNodejs20x,
+			CompatibleArchitecture: types.ArchitectureArm64,
+			Marker:                 aws.String("page-2"),
+			MaxItems:               aws.Int32(50),
+		},
+	)
+	service.AssertCalledWith(
+		&s.Suite,
+		"GetLayerVersion",
+		0,
+		plugintestutils.Any,
+		&lambda.GetLayerVersionInput{
+			LayerName:     aws.String(layerARN),
+			VersionNumber: aws.Int64(7),
+		},
+	)
+}
+
+func (s *LambdaLayerVersionDataSourceSuite) Test_parse_layer_reference() {
+	version := int64(3)
+	testCases := []struct {
+		input    string
+		expected layerReference
+	}{
+		{
+			input:    "my-layer",
+			expected: layerReference{name: "my-layer"},
+		},
+		{
+			input: "arn:aws:lambda:eu-west-1:210987654321:layer:my-layer",
+			expected: layerReference{
+				name:   "arn:aws:lambda:eu-west-1:210987654321:layer:my-layer",
+				region: "eu-west-1",
+			},
+		},
+		{
+			input: "arn:aws:lambda:eu-west-1:210987654321:layer:my-layer:3",
+			expected: layerReference{
+				name:    "arn:aws:lambda:eu-west-1:210987654321:layer:my-layer",
+				region:  "eu-west-1",
+				version: &version,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expected, parseLayerReference(tc.input), tc.input)
+	}
+}
+
 func TestLambdaLayerVersionDataSourceSuite(t *testing.T) {
 	suite.Run(t, new(LambdaLayerVersionDataSourceSuite))
 }
@@ -410,3 +523,192 @@ func createLayerVersionMissingVersionNumberTestCase(
 		ExpectedErrorMessage: "versionNumber filter is required",
 	}
 }
+
+func createLatestCompatibleLayerVersionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) LayerVersionDataSourceFetchTestCase {
+	layerARN := "arn:aws:lambda:eu-west-1:210987654321:layer:shared-layer"
+	return LayerVersionDataSourceFetchTestCase{
+		Name: "successfully fetches the latest compatible version of a shared layer",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListLayerVersionsOutputSequence(createTestLayerVersionListPages(layerARN)...),
+			lambdamock.WithGetLayerVersionOutput(createTestCompatibleLayerVersionOutput(layerARN, 7)),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: &provider.ResolvedDataSourceFilters{
+					Filters: []*provider.ResolvedDataSourceFilter{
+						pluginutils.CreateStringEqualsFilter("layerName", layerARN).Filters[0],
+						createTestLatestFilter(),
+						pluginutils.CreateStringEqualsFilter("compatibleRuntime", "nodejs20.x").Filters[0],
+						pluginutils.CreateStringEqualsFilter("compatibleArchitecture", "arm64").Filters[0],
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestCompatibleLayerVersionData(layerARN, 7),
+		},
+	}
+}
+
+func createLayerVersionFromVersionARNTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) LayerVersionDataSourceFetchTestCase {
+	layerARN := "arn:aws:lambda:eu-west-1:210987654321:layer:shared-layer"
+	return LayerVersionDataSourceFetchTestCase{
+		Name: "successfully fetches the version in a layer version ARN",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetLayerVersionOutput(createTestCompatibleLayerVersionOutput(layerARN, 4)),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: &provider.ResolvedDataSourceFilters{
+					Filters: []*provider.ResolvedDataSourceFilter{
+						pluginutils.CreateStringEqualsFilter("layerName", layerARN+":4").Filters[0],
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.DataSourceFetchOutput{
+			Data: createTestCompatibleLayerVersionData(layerARN, 4),
+		},
+	}
+}
+
+func createLatestLayerVersionNoCompatibleVersionsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) LayerVersionDataSourceFetchTestCase {
+	return LayerVersionDataSourceFetchTestCase{
+		Name: "handles no compatible layer versions",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithListLayerVersionsOutputSequence(&lambda.ListLayerVersionsOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: &provider.ResolvedDataSourceFilters{
+					Filters: []*provider.ResolvedDataSourceFilter{
+						pluginutils.CreateStringEqualsFilter("layerName", "my-layer").Filters[0],
+						createTestLatestFilter(),
+						pluginutils.CreateStringEqualsFilter("compatibleRuntime", "python3.13").Filters[0],
+					},
+				},
+			},
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: `no version of layer my-layer is compatible with runtime "python3.13"`,
+	}
+}
+
+func createIncompatibleLayerVersionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) LayerVersionDataSourceFetchTestCase {
+	layerARN := "arn:aws:lambda:us-west-2:123456789012:layer:my-layer"
+	return LayerVersionDataSourceFetchTestCase{
+		Name: "handles an explicit layer version that is not compatible",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetLayerVersionOutput(createTestCompatibleLayerVersionOutput(layerARN, 2)),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.DataSourceFetchInput{
+			ProviderContext: providerCtx,
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Filter: &provider.ResolvedDataSourceFilters{
+					Filters: []*provider.ResolvedDataSourceFilter{
+						pluginutils.CreateStringEqualsFilter("layerName", "my-layer").Filters[0],
+						pluginutils.CreateStringEqualsFilter("versionNumber", "2").Filters[0],
+						pluginutils.CreateStringEqualsFilter("compatibleArchitecture", "x86_64").Filters[0],
+					},
+				},
+			},
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: `version 2 of layer my-layer is not compatible with architecture "x86_64"`,
+	}
+}
+
+func createTestLayerVersionListPages(layerARN string) []*lambda.ListLayerVersionsOutput {
+	return []*lambda.ListLayerVersionsOutput{
+		{
+			LayerVersions: []types.LayerVersionsListItem{
+				{LayerVersionArn: aws.String(layerARN + ":5"), Version: 5},
+				{LayerVersionArn: aws.String(layerARN + ":3"), Version: 3},
+			},
+			NextMarker: aws.String("page-2"),
+		},
+		{
+			LayerVersions: []types.LayerVersionsListItem{
+				{LayerVersionArn: aws.String(layerARN + ":7"), Version: 7},
+				{LayerVersionArn: aws.String(layerARN + ":1"), Version: 1},
+			},
+		},
+	}
+}
+
+func createTestCompatibleLayerVersionOutput(
+	layerARN string,
+	version int64,
+) *lambda.GetLayerVersionOutput {
+	return &lambda.GetLayerVersionOutput{
+		LayerArn:                aws.String(layerARN),
+		LayerVersionArn:         aws.String(fmt.Sprintf("%s:%d", layerARN, version)),
+		Version:                 version,
+		CompatibleRuntimes:      []types.Runtime{types.RuntimeNodejs20x},
+		CompatibleArchitectures: []types.Architecture{types.ArchitectureArm64},
+	}
+}
+
+func createTestCompatibleLayerVersionData(
+	layerARN string,
+	version int64,
+) map[string]*core.MappingNode {
+	return map[string]*core.MappingNode{
+		"arn":                     core.MappingNodeFromString(layerARN),
+		"version":                 core.MappingNodeFromInt(int(version)),
+		"layerVersionArn":         core.MappingNodeFromString(fmt.Sprintf("%s:%d", layerARN, version)),
+		"compatibleRuntimes":      {Items: []*core.MappingNode{core.MappingNodeFromString("nodejs20.x")}},
+		"compatibleArchitectures": {Items: []*core.MappingNode{core.MappingNodeFromString("arm64")}},
+	}
+}
+
+func createTestLatestFilter() *provider.ResolvedDataSourceFilter {
+	return &provider.ResolvedDataSourceFilter{
+		Field: core.ScalarFromString("latest"),
+		Operator: &schema.DataSourceFilterOperatorWrapper{
+			Value: schema.DataSourceFilterOperatorEquals,
+		},
+		Search: &provider.ResolvedDataSourceFilterSearch{
+			Values: []*core.MappingNode{core.MappingNodeFromBool(true)},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+)
+
+// layerReference holds a layer name or ARN along with the parts
+// that can be derived from a layer ARN.
+type layerReference struct {
+	// name is the layer name or the layer ARN without a version number,
+	// this can be used as the LayerName in Lambda API calls.
+	name string
+	// region is the region in a layer ARN, this is empty
+	// when the layer is referenced by name.
+	region string
+	// version is the version number in a layer version ARN.
+	version *int64
+}
+
+// parseLayerReference parses a layer name, layer ARN or layer version ARN
+// in the form arn:{partition}:lambda:{region}:{account}:layer:{name}[:{version}].
+func parseLayerReference(layerNameOrARN string) layerReference {
+	if !strings.HasPrefix(layerNameOrARN, "arn:") {
+		return layerReference{name: layerNameOrARN}
+	}
+
+	parts := strings.Split(layerNameOrARN, ":")
+	if len(parts) < 7 || parts[5] != "layer" {
+		return layerReference{name: layerNameOrARN}
+	}
+
+	ref := layerReference{
+		name:   strings.Join(parts[:7], ":"),
+		region: parts[3],
+	}
+	if len(parts) == 8 {
+		version, err := strconv.ParseInt(parts[7], 10, 64)
+		if err == nil {
+			ref.version = &version
+		}
+	}
+
+	return ref
+}
+
+// layerVersionCompatibility holds the optional runtime and architecture
+// that a layer version must be compatible with.
+type layerVersionCompatibility struct {
+	runtime      string
+	architecture string
+}
+
+func (c layerVersionCompatibility) matches(
+	runtimes []types.Runtime,
+	architectures []types.Architecture,
+) bool {
+	if c.runtime != "" && !slices.Contains(runtimes, types.Runtime(c.runtime)) {
+		return false
+	}
+
+	return c.architecture == "" ||
+		slices.Contains(architectures, types.Architecture(c.architecture))
+}
+
+func (c layerVersionCompatibility) String() string {
+	conditions := []string{}
+	if c.runtime != "" {
+		conditions = append(conditions, fmt.Sprintf("runtime %q", c.runtime))
+	}
+	if c.architecture != "" {
+		conditions = append(conditions, fmt.Sprintf("architecture %q", c.architecture))
+	}
+	if len(conditions) == 0 {
+		return "any runtime or architecture"
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// resolveLatestLayerVersion finds the most recent version of a layer
+// that is compatible with the provided runtime and architecture.
+func resolveLatestLayerVersion(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	layerName string,
+	compatibility layerVersionCompatibility,
+) (int64, error) {
+	latestVersion := int64(0)
+	var marker *string
+	for {
+		output, err := lambdaService.ListLayerVersions(
+			ctx,
+			&lambda.ListLayerVersionsInput{
+				LayerName:              aws.String(layerName),
+				CompatibleRuntime:      types.Runtime(compatibility.runtime),
+				CompatibleArchitecture: types.Architecture(compatibility.architecture),
+				Marker:                 marker,
+				MaxItems:               aws.Int32(50),
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list Lambda layer versions: %w", err)
+		}
+
+		for _, layerVersion := range output.LayerVersions {
+			latestVersion = max(latestVersion, layerVersion.Version)
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	if latestVersion == 0 {
+		return 0, fmt.Errorf(
+			"no version of layer %s is compatible with %s",
+			layerName,
+			compatibility,
+		)
+	}
+
+	return latestVersion, nil
+}
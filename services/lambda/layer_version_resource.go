@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	s3service "github.com/newstack-cloud/bluelink-provider-aws/services/s3/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
@@ -14,6 +15,7 @@ import (
 // LayerVersionResource returns a resource implementation for an AWS Lambda Layer Version.
 func LayerVersionResource(
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	s3ServiceFactory pluginutils.ServiceFactory[*aws.Config, s3service.Service],
 	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
 ) provider.Resource {
 	basicExample, _ := examples.ReadFile("examples/resources/lambda_layer_version_basic.md")
@@ -22,6 +24,7 @@ func LayerVersionResource(
 
 	lambdaLayerVersionActions := &lambdaLayerVersionResourceActions{
 		lambdaServiceFactory,
+		s3ServiceFactory,
 		awsConfigStore,
 	}
 	return &providerv1.ResourceDefinition{
@@ -47,11 +50,13 @@ func LayerVersionResource(
 		UpdateFunc:           lambdaLayerVersionActions.Update,
 		DestroyFunc:          lambdaLayerVersionActions.Destroy,
 		StabilisedFunc:       lambdaLayerVersionActions.Stabilised,
+		CustomValidateFunc:   lambdaLayerVersionActions.CustomValidate,
 	}
 }
 
 type lambdaLayerVersionResourceActions struct {
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	s3ServiceFactory     pluginutils.ServiceFactory[*aws.Config, s3service.Service]
 	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
 }
 
@@ -70,3 +75,47 @@ func (l *lambdaLayerVersionResourceActions) getLambdaService(
 
 	return l.lambdaServiceFactory(awsConfig, providerContext), nil
 }
+
+func (l *lambdaLayerVersionResourceActions) getS3Service(
+	ctx context.Context,
+	providerContext provider.Context,
+) (s3service.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	return l.s3ServiceFactory(awsConfig, providerContext), nil
+}
+
+// packageLayerContent builds the archive for a layer version
+// that sources its content from a local directory or glob pattern.
+// This returns nil when the layer version does not use a local code source.
+func (l *lambdaLayerVersionResourceActions) packageLayerContent(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*packagedCode, error) {
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	sourceData, hasSource := pluginutils.GetValueByPath("$.content.source", specData)
+	if !hasSource {
+		return nil, nil
+	}
+
+	s3Service, err := l.getS3Service(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Layer versions are immutable, a new version is always created
+	// from the packaged content.
+	return packageCodeSource(
+		ctx,
+		sourceData,
+		/* deployedSHA256 */ "",
+		s3Service,
+	)
+}
@@ -21,6 +21,11 @@ func (l *lambdaLayerVersionResourceActions) Create(
 		return nil, err
 	}
 
+	packaged, err := l.packageLayerContent(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
 	createOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
 		&layerVersionCreate{},
 	}
@@ -28,7 +33,9 @@ func (l *lambdaLayerVersionResourceActions) Create(
 	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
 		ctx,
 		pluginutils.SaveOperationContext{
-			Data: map[string]any{},
+			Data: map[string]any{
+				"packagedCode": packaged,
+			},
 		},
 		createOperations,
 		input,
@@ -54,6 +61,12 @@ func (l *lambdaLayerVersionResourceActions) Create(
 		"spec.createdDate":     core.MappingNodeFromString(aws.ToString(publishLayerVersionOutput.CreatedDate)),
 	}
 
+	if publishLayerVersionOutput.Content != nil && publishLayerVersionOutput.Content.CodeSha256 != nil {
+		computedFields["spec.codeSha256"] = core.MappingNodeFromString(
+			aws.ToString(publishLayerVersionOutput.Content.CodeSha256),
+		)
+	}
+
 	return &provider.ResourceDeployOutput{
 		ComputedFieldValues: computedFields,
 	}, nil
@@ -31,6 +31,13 @@ func (u *layerVersionCreate) Prepare(
 	if err != nil {
 		return false, saveOpCtx, err
 	}
+
+	if packaged, ok := getPackagedCode(saveOpCtx); ok {
+		if input.Content == nil {
+			input.Content = &types.LayerVersionContentInput{}
+		}
+		setLayerContentFromPackagedCode(input.Content, packaged)
+	}
 	u.input = input
 	return hasValues, saveOpCtx, nil
 }
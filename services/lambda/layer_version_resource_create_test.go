@@ -23,6 +23,7 @@ type LambdaLayerVersionResourceCreateSuite struct {
 }
 
 func (s *LambdaLayerVersionResourceCreateSuite) Test_create_lambda_layer_version() {
+	sourceDir, archive := createTestCodeSourceDir(s.T())
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -38,11 +39,12 @@ func (s *LambdaLayerVersionResourceCreateSuite) Test_create_lambda_layer_version
 		createBasicLayerVersionTestCase(providerCtx, loader),
 		createLayerVersionWithAllOptionsTestCase(providerCtx, loader),
 		createLayerVersionFailureTestCase(providerCtx, loader),
+		createLayerVersionFromLocalCodeSourceTestCase(providerCtx, loader, sourceDir, archive),
 	}
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		LayerVersionResource,
+		newTestLayerVersionResource,
 		&s.Suite,
 	)
 }
@@ -331,6 +333,92 @@ func createLayerVersionFailureTestCase(
 	}
 }
 
+func createLayerVersionFromLocalCodeSourceTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	archive *utils.ZipArchive,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	layerArn := "arn:aws:lambda:us-west-2:123456789012:layer:source-layer"
+	layerVersionArn := layerArn + ":1"
+
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithPublishLayerVersionOutput(&lambda.PublishLayerVersionOutput{
+			LayerArn:        aws.String(layerArn),
+			LayerVersionArn: aws.String(layerVersionArn),
+			Version:         1,
+			CreatedDate:     aws.String("2023-12-01T12:00:00.000Z"),
+			Content: &types.LayerVersionContentOutput{
+				CodeSha256: aws.String(archive.SHA256),
+			},
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"layerName": core.MappingNodeFromString("source-layer"),
+			"content": {
+				Fields: map[string]*core.MappingNode{
+					"source": createTestCodeSourceSpec(sourceDir),
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create layer version from a local code source",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-layer-version-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-layer-version-id",
+					ResourceName: "TestLayerVersion",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/layerVersion",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{FieldPath: "spec.layerName"},
+					{FieldPath: "spec.content"},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.layerArn":        core.MappingNodeFromString(layerArn),
+				"spec.layerVersionArn": core.MappingNodeFromString(layerVersionArn),
+				"spec.version":         core.MappingNodeFromInt(1),
+				"spec.createdDate":     core.MappingNodeFromString("2023-12-01T12:00:00.000Z"),
+				"spec.codeSha256":      core.MappingNodeFromString(archive.SHA256),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PublishLayerVersion": &lambda.PublishLayerVersionInput{
+				LayerName: aws.String("source-layer"),
+				Content: &types.LayerVersionContentInput{
+					ZipFile: archive.Content,
+				},
+			},
+		},
+	}
+}
+
 func TestLambdaLayerVersionResourceCreate(t *testing.T) {
 	suite.Run(t, new(LambdaLayerVersionResourceCreateSuite))
 }
@@ -41,7 +41,7 @@ func (s *LambdaLayerVersionResourceDestroySuite) Test_destroy_lambda_layer_versi
 
 	plugintestutils.RunResourceDestroyTestCases(
 		testCases,
-		LayerVersionResource,
+		newTestLayerVersionResource,
 		&s.Suite,
 	)
 }
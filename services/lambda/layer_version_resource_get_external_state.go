@@ -62,6 +62,8 @@ func (l *lambdaLayerVersionResourceActions) GetExternalState(
 		return nil, err
 	}
 
+	carryOverLayerContentSource(getLayerVersionOutput, input.CurrentResourceSpec, resourceSpecState)
+
 	return &provider.ResourceGetExternalStateOutput{
 		ResourceSpecState: resourceSpecState,
 	}, nil
@@ -100,6 +102,27 @@ func (l *lambdaLayerVersionResourceActions) addOptionalConfigurationsToSpec(
 	return pluginutils.RunOptionalValueExtractors(output, specFields, extractors)
 }
 
+// carryOverLayerContentSource carries over the local code source of a layer version
+// from the current spec when the files that it describes still package into
+// the deployed content. Otherwise the source is omitted so that changes to the files
+// are reported as drift, as the source can not be changed in place,
+// resolving the drift will create a new layer version.
+func carryOverLayerContentSource(
+	output *lambda.GetLayerVersionOutput,
+	currentSpec *core.MappingNode,
+	resourceSpecState *core.MappingNode,
+) {
+	source, hasSource := pluginutils.GetValueByPath("$.content.source", currentSpec)
+	contentState := resourceSpecState.Fields["content"]
+	if !hasSource || output.Content == nil || contentState == nil {
+		return
+	}
+
+	if codeSourceMatchesDeployedCode(source, aws.ToString(output.Content.CodeSha256)) {
+		contentState.Fields["source"] = source
+	}
+}
+
 // parseLayerVersionArn extracts the layer name and version number from a layer version ARN
 // Format: arn:aws:lambda:region:account-id:layer:layer-name:version.
 func parseLayerVersionArn(arn string) (layerName string, versionNumber int64, err error) {
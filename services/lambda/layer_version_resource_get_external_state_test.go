@@ -24,6 +24,9 @@ type LambdaLayerVersionResourceGetExternalStateSuite struct {
 }
 
 func (s *LambdaLayerVersionResourceGetExternalStateSuite) Test_get_external_state() {
+	sourceDir, archive := createTestCodeSourceDir(s.T())
+	changedSourceDir, changedArchive := createTestCodeSourceDir(s.T())
+	changeTestCodeSourceFiles(s.T(), changedSourceDir)
 	loader := &testutils.MockAWSConfigLoader{}
 	providerCtx := plugintestutils.NewTestProviderContext(
 		"aws",
@@ -41,11 +44,27 @@ func (s *LambdaLayerVersionResourceGetExternalStateSuite) Test_get_external_stat
 		getLayerVersionNotFoundTestCase(providerCtx, loader),
 		getLayerVersionErrorTestCase(providerCtx, loader),
 		getLayerVersionInvalidArnTestCase(providerCtx, loader),
+		getLayerVersionContentSourceTestCase(
+			"reports the content source when the files match the deployed content",
+			providerCtx,
+			loader,
+			sourceDir,
+			archive,
+			/* expectSource */ true,
+		),
+		getLayerVersionContentSourceTestCase(
+			"omits the content source when the files have changed since the layer version was created",
+			providerCtx,
+			loader,
+			changedSourceDir,
+			changedArchive,
+			/* expectSource */ false,
+		),
 	}
 
 	plugintestutils.RunResourceGetExternalStateTestCases(
 		testCases,
-		LayerVersionResource,
+		newTestLayerVersionResource,
 		&s.Suite,
 	)
 }
@@ -321,3 +340,70 @@ func getLayerVersionInvalidArnTestCase(
 		ExpectError: false,
 	}
 }
+
+func getLayerVersionContentSourceTestCase(
+	name string,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+	sourceDir string,
+	deployedArchive *utils.ZipArchive,
+	expectSource bool,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	layerVersionArn := "arn:aws:lambda:us-west-2:123456789012:layer:test-layer:1"
+
+	expectedContentFields := map[string]*core.MappingNode{
+		"codeSha256": core.MappingNodeFromString(deployedArchive.SHA256),
+		"codeSize":   core.MappingNodeFromInt(len(deployedArchive.Content)),
+	}
+	if expectSource {
+		expectedContentFields["source"] = createTestCodeSourceSpec(sourceDir)
+	}
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: name,
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetLayerVersionOutput(&lambda.GetLayerVersionOutput{
+				LayerArn:        aws.String("arn:aws:lambda:us-west-2:123456789012:layer:test-layer"),
+				LayerVersionArn: aws.String(layerVersionArn),
+				Version:         1,
+				Content: &types.LayerVersionContentOutput{
+					CodeSha256: aws.String(deployedArchive.SHA256),
+					CodeSize:   int64(len(deployedArchive.Content)),
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerName": core.MappingNodeFromString("test-layer"),
+					"version":   core.MappingNodeFromInt(1),
+					"content": {
+						Fields: map[string]*core.MappingNode{
+							"source": createTestCodeSourceSpec(sourceDir),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"layerArn":        core.MappingNodeFromString("arn:aws:lambda:us-west-2:123456789012:layer:test-layer"),
+					"layerVersionArn": core.MappingNodeFromString(layerVersionArn),
+					"version":         core.MappingNodeFromInt(1),
+					"content": {
+						Fields: expectedContentFields,
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}
+}
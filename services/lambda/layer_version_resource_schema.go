@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
 
@@ -50,6 +51,7 @@ func lambdaLayerVersionResourceSchema() *provider.ResourceDefinitionsSchema {
 						MaxLength:    1024,
 						MustRecreate: true,
 					},
+					"source": lambdaCodeSourceSchema(true),
 				},
 			},
 			"compatibleArchitectures": {
@@ -60,10 +62,13 @@ func lambdaLayerVersionResourceSchema() *provider.ResourceDefinitionsSchema {
 				},
 			},
 			"compatibleRuntimes": {
-				Type:        provider.ResourceDefinitionsSchemaTypeArray,
-				Description: "A list of compatible function runtimes. Used for filtering with ListLayers and ListLayerVersions.",
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "A list of compatible function runtimes. Used for filtering with ListLayers and ListLayerVersions. " +
+					"Runtimes that are deprecated or approaching deprecation are reported as warnings during validation, " +
+					"runtimes that Lambda no longer allows new layers to be created with are reported as errors.",
 				Items: &provider.ResourceDefinitionsSchema{
-					Type: provider.ResourceDefinitionsSchemaTypeString,
+					Type:         provider.ResourceDefinitionsSchemaTypeString,
+					ValidateFunc: validateRuntimeLifecycle(core.SystemClock{}),
 				},
 			},
 			"description": {
@@ -99,6 +104,11 @@ func lambdaLayerVersionResourceSchema() *provider.ResourceDefinitionsSchema {
 				Description: "The version number.",
 				Computed:    true,
 			},
+			"codeSha256": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The base64-encoded SHA-256 hash of the layer archive.",
+				Computed:    true,
+			},
 			"createdDate": {
 				Type:        provider.ResourceDefinitionsSchemaTypeString,
 				Description: "The date that the layer version was created, in ISO-8601 format (YYYY-MM-DDThh:mm:ss.sTZD).",
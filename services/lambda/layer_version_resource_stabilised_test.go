@@ -36,7 +36,7 @@ func (s *LambdaLayerVersionResourceStabilisedSuite) Test_stabilised() {
 
 	plugintestutils.RunResourceHasStabilisedTestCases(
 		testCases,
-		LayerVersionResource,
+		newTestLayerVersionResource,
 		&s.Suite,
 	)
 }
@@ -40,7 +40,7 @@ func (s *LambdaLayerVersionResourceUpdateSuite) Test_update_lambda_layer_version
 
 	plugintestutils.RunResourceDeployTestCases(
 		testCases,
-		LayerVersionResource,
+		newTestLayerVersionResource,
 		&s.Suite,
 	)
 }
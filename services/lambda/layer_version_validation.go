@@ -0,0 +1,32 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// CustomValidate validates the fields of a layer version that can not be validated
+// by the validation functions of the schema definition, as the blueprint framework
+// only calls the validation functions of schema definitions for scalar values.
+func (l *lambdaLayerVersionResourceActions) CustomValidate(
+	ctx context.Context,
+	input *provider.ResourceValidateInput,
+) (*provider.ResourceValidateOutput, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.SchemaResource == nil {
+		return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+	}
+
+	diagnostics = append(
+		diagnostics,
+		validateCodeSourceExclusive(
+			input.SchemaResource.Spec,
+			"$.content",
+			[]string{"s3Bucket", "s3Key", "s3ObjectVersion"},
+		)...,
+	)
+
+	return &provider.ResourceValidateOutput{Diagnostics: diagnostics}, nil
+}
@@ -0,0 +1,578 @@
+package lambdalinks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/linkhelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+const (
+	destinationTypeOnSuccess = "onSuccess"
+	destinationTypeOnFailure = "onFailure"
+)
+
+var destinationTypes = []string{
+	destinationTypeOnSuccess,
+	destinationTypeOnFailure,
+}
+
+// DestinationTypeFromChanges returns the type of asynchronous invocation
+// destination that a target resource should be configured as based on the
+// annotations in the changes for the linked from function,
+// an empty string is returned when the target resource should not be configured
+// as a destination.
+func DestinationTypeFromChanges(
+	functionChanges *provider.Changes,
+	targetResourceName string,
+) string {
+	return destinationTypeFromAnnotations(
+		getAnnotationsFromChanges(functionChanges),
+		targetResourceName,
+	)
+}
+
+// DestinationTypeFromResourceInfo returns the type of asynchronous invocation
+// destination that a target resource should be configured as based on the
+// annotations of the linked from function,
+// an empty string is returned when the target resource should not be configured
+// as a destination.
+func DestinationTypeFromResourceInfo(
+	functionInfo *provider.ResourceInfo,
+	targetResourceName string,
+) string {
+	return destinationTypeFromAnnotations(
+		getAnnotationsFromResourceInfo(functionInfo),
+		targetResourceName,
+	)
+}
+
+func destinationTypeFromAnnotations(
+	annotations *core.MappingNode,
+	targetResourceName string,
+) string {
+	value, hasValue := getAnnotationValue(
+		annotations,
+		fmt.Sprintf("aws.lambda.function.%s.destination", targetResourceName),
+	)
+	if !hasValue {
+		return ""
+	}
+
+	return core.StringValue(value)
+}
+
+// DestinationAnnotationDefinition creates the definition for the annotation
+// that selects the destination type for a specific target resource,
+// the target placeholder is used in the annotation name, e.g. "<targetQueue>".
+func DestinationAnnotationDefinition(
+	targetPlaceholder string,
+	targetLabel string,
+) *provider.LinkAnnotationDefinition {
+	return &provider.LinkAnnotationDefinition{
+		Name:  fmt.Sprintf("aws.lambda.function.%s.destination", targetPlaceholder),
+		Label: fmt.Sprintf("Asynchronous Invocation Destination for Specific Target %s", targetLabel),
+		Type:  core.ScalarTypeString,
+		Description: fmt.Sprintf(
+			"Configures the target %s as a destination for asynchronous invocations of the linked from lambda function. "+
+				"Set to `onSuccess` to send records of successful invocations to the target %s "+
+				"or `onFailure` to send records of invocations that failed after all retries. "+
+				"A lambda function can only have one destination of each type.",
+			strings.ToLower(targetLabel),
+			strings.ToLower(targetLabel),
+		),
+		AllowedValues: []*core.ScalarValue{
+			core.ScalarFromString(destinationTypeOnSuccess),
+			core.ScalarFromString(destinationTypeOnFailure),
+		},
+		Required: false,
+	}
+}
+
+// CollectDestinationChanges collects the changes to the destination configuration
+// for the linked from function in the link data, the target ARN is recorded for
+// the selected destination type and any destination previously configured
+// by the link for a different destination type is marked as removed.
+func CollectDestinationChanges(
+	functionResourceName string,
+	destinationType string,
+	currentLinkData *core.MappingNode,
+	targetChanges *provider.Changes,
+	changes *provider.LinkChanges,
+) error {
+	for _, candidateType := range destinationTypes {
+		linkFieldPath := fmt.Sprintf(
+			"$[%q].destinationConfig.%s",
+			functionResourceName,
+			candidateType,
+		)
+		if candidateType == destinationType {
+			err := linkhelpers.CollectChanges(
+				"$.spec.arn",
+				linkFieldPath,
+				currentLinkData,
+				targetChanges,
+				changes,
+			)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Destination types that have never been configured by the link
+		// are not reported as changes.
+		currentValue, _ := core.GetPathValue(
+			linkFieldPath,
+			currentLinkData,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if core.IsNilMappingNode(currentValue) {
+			continue
+		}
+
+		err := linkhelpers.CollectLinkDataChanges(
+			linkFieldPath,
+			currentLinkData,
+			changes,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateFunctionDestination creates or updates the event invoke config
+// of a function so that the target ARN is the destination for the provided
+// destination type.
+// Destinations for other types that point to the target ARN are removed,
+// destinations that point to other targets are left untouched so that destinations
+// configured by other links or by other means are preserved.
+// An empty destination type will remove the target ARN from all destinations.
+//
+// The destination configuration managed by the link is returned to be stored
+// in the link data, this will be nil if the target is not a destination.
+func UpdateFunctionDestination(
+	ctx context.Context,
+	functionARN string,
+	targetARN string,
+	destinationType string,
+	lambdaService lambdaservice.Service,
+) (*core.MappingNode, error) {
+	currentConfig, err := getFunctionDestinationConfig(ctx, functionARN, lambdaService)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentConfig == nil {
+		if destinationType == "" {
+			return nil, nil
+		}
+
+		_, err = lambdaService.PutFunctionEventInvokeConfig(
+			ctx,
+			&lambda.PutFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(functionARN),
+				DestinationConfig: createDestinationConfig(
+					resolveDestination("", destinationType == destinationTypeOnSuccess, targetARN),
+					resolveDestination("", destinationType == destinationTypeOnFailure, targetARN),
+				),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create event invoke config for function %s: %w", functionARN, err)
+		}
+
+		return DestinationConfigLinkData(destinationType, targetARN), nil
+	}
+
+	currentOnSuccess, currentOnFailure := destinationARNs(currentConfig)
+	onSuccess := resolveDestination(
+		currentOnSuccess,
+		destinationType == destinationTypeOnSuccess,
+		targetARN,
+	)
+	onFailure := resolveDestination(
+		currentOnFailure,
+		destinationType == destinationTypeOnFailure,
+		targetARN,
+	)
+
+	if onSuccess != currentOnSuccess || onFailure != currentOnFailure {
+		// The update operation only modifies the provided settings,
+		// the retry and maximum event age settings are left as they are.
+		_, err = lambdaService.UpdateFunctionEventInvokeConfig(
+			ctx,
+			&lambda.UpdateFunctionEventInvokeConfigInput{
+				FunctionName:      aws.String(functionARN),
+				DestinationConfig: createDestinationConfig(onSuccess, onFailure),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update event invoke config for function %s: %w", functionARN, err)
+		}
+	}
+
+	return DestinationConfigLinkData(destinationType, targetARN), nil
+}
+
+// RecordedDestinationTypes returns the destination types that the link data
+// of a link records the target as being configured for.
+// The link data is read from the link changes that were collected from the
+// current link data when the changes were staged, when a link is destroyed
+// without staged changes, the destination type selected by the annotations
+// the linked from function was deployed with is used instead.
+// An empty slice is returned when the link has not configured the target
+// as a destination.
+func RecordedDestinationTypes(input *provider.LinkUpdateResourceInput) []string {
+	functionResourceName := ""
+	if input.ResourceInfo != nil {
+		functionResourceName = input.ResourceInfo.ResourceName
+	}
+
+	if input.Changes == nil {
+		if input.LinkUpdateType != provider.LinkUpdateTypeDestroy ||
+			input.OtherResourceInfo == nil {
+			return []string{}
+		}
+
+		destinationType := DestinationTypeFromResourceInfo(
+			input.ResourceInfo,
+			input.OtherResourceInfo.ResourceName,
+		)
+		if destinationType == "" {
+			return []string{}
+		}
+		return []string{destinationType}
+	}
+
+	recordedTypes := []string{}
+	for _, destinationType := range destinationTypes {
+		linkFieldPath := fmt.Sprintf(
+			"[%q].destinationConfig.%s",
+			functionResourceName,
+			destinationType,
+		)
+		if isRecordedInLinkChanges(input.Changes, linkFieldPath) {
+			recordedTypes = append(recordedTypes, destinationType)
+		}
+	}
+
+	return recordedTypes
+}
+
+func isRecordedInLinkChanges(changes *provider.LinkChanges, linkFieldPath string) bool {
+	for _, fieldChange := range changes.ModifiedFields {
+		if fieldChange.FieldPath == linkFieldPath &&
+			!core.IsNilMappingNode(fieldChange.PrevValue) {
+			return true
+		}
+	}
+
+	return slices.Contains(changes.RemovedFields, linkFieldPath) ||
+		slices.Contains(changes.UnchangedFields, linkFieldPath)
+}
+
+// RemoveFunctionDestination removes the target ARN from the destinations
+// of a function for the provided destination types that have been recorded
+// in the link data.
+// Only destinations that still point to the target ARN are removed so that
+// destinations configured by other links or by other means, such as an event
+// invoke config resource, are preserved.
+// No calls are made to Lambda when no destination types are provided.
+func RemoveFunctionDestination(
+	ctx context.Context,
+	functionARN string,
+	targetARN string,
+	recordedTypes []string,
+	lambdaService lambdaservice.Service,
+) error {
+	if len(recordedTypes) == 0 {
+		return nil
+	}
+
+	currentConfig, err := getFunctionDestinationConfig(ctx, functionARN, lambdaService)
+	if err != nil {
+		return err
+	}
+
+	if currentConfig == nil {
+		return nil
+	}
+
+	currentOnSuccess, currentOnFailure := destinationARNs(currentConfig)
+	onSuccess := removeRecordedDestination(
+		currentOnSuccess,
+		slices.Contains(recordedTypes, destinationTypeOnSuccess),
+		targetARN,
+	)
+	onFailure := removeRecordedDestination(
+		currentOnFailure,
+		slices.Contains(recordedTypes, destinationTypeOnFailure),
+		targetARN,
+	)
+	if onSuccess == currentOnSuccess && onFailure == currentOnFailure {
+		return nil
+	}
+
+	_, err = lambdaService.UpdateFunctionEventInvokeConfig(
+		ctx,
+		&lambda.UpdateFunctionEventInvokeConfigInput{
+			FunctionName:      aws.String(functionARN),
+			DestinationConfig: createDestinationConfig(onSuccess, onFailure),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update event invoke config for function %s: %w", functionARN, err)
+	}
+
+	return nil
+}
+
+func removeRecordedDestination(currentARN string, recorded bool, targetARN string) string {
+	if recorded && currentARN == targetARN {
+		return ""
+	}
+
+	return currentARN
+}
+
+// DestinationPermissionRetry determines how the configuration of a destination
+// is retried while a permission that was just granted to the execution role
+// of a function propagates.
+// Lambda checks that the execution role of a function is allowed to send to
+// a destination when the destination is configured, IAM is eventually consistent
+// so Lambda may reject the destination for a short time after the policy
+// granting permission to send to the destination has been added to the role.
+type DestinationPermissionRetry struct {
+	// InitialDelay is the delay before the second attempt,
+	// the delay is doubled for each subsequent attempt.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts.
+	MaxDelay time.Duration
+	// MaxAttempts is the maximum number of times the destination
+	// configuration will be attempted before giving up.
+	MaxAttempts int
+}
+
+// DefaultDestinationPermissionRetry returns the retry behaviour used by links
+// that configure destinations after granting permissions to the execution role
+// of a function, this allows for around a minute of IAM propagation delay.
+func DefaultDestinationPermissionRetry() *DestinationPermissionRetry {
+	return &DestinationPermissionRetry{
+		InitialDelay: 2 * time.Second,
+		MaxDelay:     10 * time.Second,
+		MaxAttempts:  8,
+	}
+}
+
+// ConfigureFunctionDestination makes the target ARN the destination for the
+// provided destination type in the same way as UpdateFunctionDestination,
+// retrying when Lambda rejects the destination because the execution role of
+// the function does not have permission to send to the target yet.
+// This should be used once the execution role has been granted permission
+// to send to the target.
+// A nil retry configures the destination once without retrying.
+func ConfigureFunctionDestination(
+	ctx context.Context,
+	functionARN string,
+	targetARN string,
+	destinationType string,
+	lambdaService lambdaservice.Service,
+	retry *DestinationPermissionRetry,
+) (*core.MappingNode, error) {
+	if retry == nil {
+		return UpdateFunctionDestination(ctx, functionARN, targetARN, destinationType, lambdaService)
+	}
+
+	var destinationConfig *core.MappingNode
+	var err error
+	for attempt := 0; attempt < retry.MaxAttempts; attempt += 1 {
+		if attempt > 0 {
+			sleepErr := retry.sleep(ctx, attempt)
+			if sleepErr != nil {
+				return nil, sleepErr
+			}
+		}
+
+		destinationConfig, err = UpdateFunctionDestination(
+			ctx,
+			functionARN,
+			targetARN,
+			destinationType,
+			lambdaService,
+		)
+		if !isMissingDestinationPermissionError(err) {
+			return destinationConfig, err
+		}
+	}
+
+	return nil, err
+}
+
+func (r *DestinationPermissionRetry) sleep(ctx context.Context, attempt int) error {
+	delay := r.InitialDelay << (attempt - 1)
+	if delay > r.MaxDelay || delay <= 0 {
+		delay = r.MaxDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// AddDestinationConfigLinkData adds the destination configuration managed
+// by a link to the link data for the linked from function.
+func AddDestinationConfigLinkData(
+	linkData *core.MappingNode,
+	functionResourceName string,
+	destinationConfig *core.MappingNode,
+) {
+	if destinationConfig == nil {
+		return
+	}
+
+	functionLinkData, hasFunctionLinkData := linkData.Fields[functionResourceName]
+	if !hasFunctionLinkData || functionLinkData.Fields == nil {
+		functionLinkData = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		}
+		linkData.Fields[functionResourceName] = functionLinkData
+	}
+
+	functionLinkData.Fields["destinationConfig"] = destinationConfig
+}
+
+// ExecutionRolePolicyName derives a deterministic name for an inline policy
+// added by a link to the execution role of a function so that the same policy
+// can be found and removed when the link is destroyed.
+func ExecutionRolePolicyName(functionARN string, action string, targetName string) string {
+	return inlinePolicyName(functionNameFromARN(functionARN), action, targetName)
+}
+
+func getFunctionDestinationConfig(
+	ctx context.Context,
+	functionARN string,
+	lambdaService lambdaservice.Service,
+) (*types.DestinationConfig, error) {
+	output, err := lambdaService.GetFunctionEventInvokeConfig(
+		ctx,
+		&lambda.GetFunctionEventInvokeConfigInput{
+			FunctionName: aws.String(functionARN),
+		},
+	)
+	if err != nil {
+		if isResourceNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event invoke config for function %s: %w", functionARN, err)
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	if output.DestinationConfig == nil {
+		return &types.DestinationConfig{}, nil
+	}
+
+	return output.DestinationConfig, nil
+}
+
+func resolveDestination(currentARN string, selected bool, targetARN string) string {
+	if selected {
+		return targetARN
+	}
+
+	if currentARN == targetARN {
+		return ""
+	}
+
+	return currentARN
+}
+
+func destinationARNs(config *types.DestinationConfig) (string, string) {
+	onSuccess := ""
+	if config.OnSuccess != nil {
+		onSuccess = aws.ToString(config.OnSuccess.Destination)
+	}
+
+	onFailure := ""
+	if config.OnFailure != nil {
+		onFailure = aws.ToString(config.OnFailure.Destination)
+	}
+
+	return onSuccess, onFailure
+}
+
+// createDestinationConfig creates a destination config where an empty
+// destination ARN removes the destination for the given type.
+func createDestinationConfig(onSuccessARN string, onFailureARN string) *types.DestinationConfig {
+	return &types.DestinationConfig{
+		OnSuccess: &types.OnSuccess{
+			Destination: optionalString(onSuccessARN),
+		},
+		OnFailure: &types.OnFailure{
+			Destination: optionalString(onFailureARN),
+		},
+	}
+}
+
+// DestinationConfigLinkData creates the destination configuration managed by
+// a link to be stored in the link data, this will be nil if the target
+// is not a destination.
+func DestinationConfigLinkData(destinationType string, targetARN string) *core.MappingNode {
+	if destinationType == "" {
+		return nil
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			destinationType: core.MappingNodeFromString(targetARN),
+		},
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return aws.String(value)
+}
+
+func isResourceNotFoundError(err error) bool {
+	var apiError smithy.APIError
+	return errors.As(err, &apiError) && apiError.ErrorCode() == "ResourceNotFoundException"
+}
+
+// isMissingDestinationPermissionError determines whether Lambda rejected
+// a destination because the execution role of the function is not allowed
+// to send to the destination, e.g. "The function's execution role does not have
+// permissions to call SendMessage on arn:aws:sqs:...".
+func isMissingDestinationPermissionError(err error) bool {
+	var apiError smithy.APIError
+	return errors.As(err, &apiError) &&
+		apiError.ErrorCode() == "InvalidParameterValueException" &&
+		strings.Contains(apiError.ErrorMessage(), "does not have permissions")
+}
@@ -0,0 +1,151 @@
+package lambdalinks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type FunctionDestinationsSuite struct {
+	suite.Suite
+}
+
+func (s *FunctionDestinationsSuite) Test_configure_destination_retries_while_permission_propagates() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigError(
+			&types.ResourceNotFoundException{
+				Message: aws.String("The function doesn't have an EventInvokeConfig"),
+			},
+		),
+		lambdamock.WithPutFunctionEventInvokeConfigError(
+			&types.InvalidParameterValueException{
+				Message: aws.String(
+					"The function execution role does not have permissions to call InvokeFunction on " +
+						testTargetFunctionARN,
+				),
+			},
+		),
+	)
+
+	_, err := ConfigureFunctionDestination(
+		context.Background(),
+		testCallerFunctionARN,
+		testTargetFunctionARN,
+		destinationTypeOnFailure,
+		service,
+		createTestDestinationPermissionRetry(),
+	)
+	s.Require().Error(err)
+	s.ErrorContains(err, "does not have permissions to call InvokeFunction")
+
+	expectedInput := &lambda.PutFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(testCallerFunctionARN),
+		DestinationConfig: &types.DestinationConfig{
+			OnSuccess: &types.OnSuccess{},
+			OnFailure: &types.OnFailure{
+				Destination: aws.String(testTargetFunctionARN),
+			},
+		},
+	}
+	for i := range 3 {
+		service.AssertCalledWith(
+			&s.Suite,
+			"PutFunctionEventInvokeConfig",
+			i,
+			plugintestutils.Any,
+			expectedInput,
+		)
+	}
+}
+
+func (s *FunctionDestinationsSuite) Test_configure_destination_does_not_retry_other_errors() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigError(
+			errors.New("throttled"),
+		),
+	)
+
+	_, err := ConfigureFunctionDestination(
+		context.Background(),
+		testCallerFunctionARN,
+		testTargetFunctionARN,
+		destinationTypeOnFailure,
+		service,
+		createTestDestinationPermissionRetry(),
+	)
+	s.Require().Error(err)
+	s.ErrorContains(err, "failed to get event invoke config for function")
+	service.AssertNotCalled(&s.Suite, "PutFunctionEventInvokeConfig")
+}
+
+func (s *FunctionDestinationsSuite) Test_recorded_destination_types_from_staged_link_changes() {
+	recordedTypes := RecordedDestinationTypes(&provider.LinkUpdateResourceInput{
+		LinkUpdateType: provider.LinkUpdateTypeUpdate,
+		ResourceInfo: &provider.ResourceInfo{
+			ResourceName: "ordersFunction",
+		},
+		OtherResourceInfo: &provider.ResourceInfo{
+			ResourceName: "logOrderEventsFunction",
+		},
+		Changes: &provider.LinkChanges{
+			RemovedFields: []string{
+				"[\"ordersFunction\"].destinationConfig.onFailure",
+			},
+		},
+	})
+	s.Equal([]string{destinationTypeOnFailure}, recordedTypes)
+}
+
+func (s *FunctionDestinationsSuite) Test_remove_destination_leaves_destinations_not_recorded_by_the_link() {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigOutput(
+			&lambda.GetFunctionEventInvokeConfigOutput{
+				DestinationConfig: &types.DestinationConfig{
+					OnFailure: &types.OnFailure{
+						Destination: aws.String(testTargetFunctionARN),
+					},
+				},
+			},
+		),
+	)
+
+	err := RemoveFunctionDestination(
+		context.Background(),
+		testCallerFunctionARN,
+		testTargetFunctionARN,
+		RecordedDestinationTypes(&provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeUpdate,
+			ResourceInfo: &provider.ResourceInfo{
+				ResourceName: "ordersFunction",
+			},
+			OtherResourceInfo: &provider.ResourceInfo{
+				ResourceName: "logOrderEventsFunction",
+			},
+		}),
+		service,
+	)
+	s.Require().NoError(err)
+	service.AssertNotCalled(&s.Suite, "GetFunctionEventInvokeConfig")
+	service.AssertNotCalled(&s.Suite, "UpdateFunctionEventInvokeConfig")
+}
+
+func createTestDestinationPermissionRetry() *DestinationPermissionRetry {
+	return &DestinationPermissionRetry{
+		InitialDelay: time.Millisecond,
+		MaxDelay:     time.Millisecond,
+		MaxAttempts:  3,
+	}
+}
+
+func TestFunctionDestinationsSuite(t *testing.T) {
+	suite.Run(t, new(FunctionDestinationsSuite))
+}
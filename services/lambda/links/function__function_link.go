@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
@@ -14,6 +15,10 @@ import (
 // a link from a lambda function to another lambda function.
 // The first lambda function will be configured with permissions
 // and environment variables to be able to invoke the second lambda function.
+//
+// The IAM service is used to manage an inline policy in the execution role
+// of the first lambda function that grants permission to invoke the second
+// lambda function, the execution role is treated as an intermediary resource.
 func FunctionFunctionLink(
 	linkServiceDeps pluginutils.LinkServiceDeps[
 		*aws.Config,
@@ -21,12 +26,16 @@ func FunctionFunctionLink(
 		*aws.Config,
 		lambdaservice.Service,
 	],
+	iamService pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service],
 ) provider.Link {
 	description, _ := descriptions.ReadFile("descriptions/function__function.md")
 
 	actions := &lambdaFunctionFunctionLinkActions{
 		lambdaServiceFactory: linkServiceDeps.ResourceAService.ServiceFactory,
 		awsConfigStore:       linkServiceDeps.ResourceAService.ConfigStore,
+		iamServiceFactory:    iamService.ServiceFactory,
+		iamConfigStore:       iamService.ConfigStore,
+		destinationRetry:     DefaultDestinationPermissionRetry(),
 	}
 
 	return &providerv1.LinkDefinition{
@@ -50,6 +59,9 @@ func FunctionFunctionLink(
 type lambdaFunctionFunctionLinkActions struct {
 	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
 	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	iamServiceFactory    pluginutils.ServiceFactory[*aws.Config, iamservice.Service]
+	iamConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	destinationRetry     *DestinationPermissionRetry
 }
 
 func (l *lambdaFunctionFunctionLinkActions) getLambdaService(
@@ -67,3 +79,19 @@ func (l *lambdaFunctionFunctionLinkActions) getLambdaService(
 
 	return l.lambdaServiceFactory(awsConfig, providerContext), nil
 }
+
+func (l *lambdaFunctionFunctionLinkActions) getIAMService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (iamservice.Service, error) {
+	awsConfig, err := l.iamConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.iamServiceFactory(awsConfig, providerContext), nil
+}
@@ -33,12 +33,18 @@ func lambdaFunctionFunctionLinkAnnotations() map[string]*provider.LinkAnnotation
 			Type:  core.ScalarTypeString,
 			Description: "The name of the environment variable to populate in the linked from lambda function " +
 				"in order to invoke the target lambda function. " +
-				"The default format for lambda function name environment variables is `AWS_LAMBDA_FUNCTION_<targetFunction>`.",
-			DefaultValue: core.ScalarFromBool(true),
+				"The default format for lambda function name environment variables is `AWS_LAMBDA_FUNCTION_<targetFunction>` " +
+				"where `<targetFunction>` is the name of the target function resource in upper snake case. " +
+				"The ARN of the target function will be populated in an environment variable with the same name " +
+				"and an `_ARN` suffix.",
 			Examples: []*core.ScalarValue{
 				core.ScalarFromString("AWS_LAMBDA_FUNCTION_ORDERS"),
 			},
 			Required: false,
 		},
+		"aws/lambda/function::aws.lambda.function.<targetFunction>.destination": DestinationAnnotationDefinition(
+			"<targetFunction>",
+			"Function",
+		),
 	}
 }
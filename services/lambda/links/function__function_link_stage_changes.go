@@ -2,7 +2,10 @@ package lambdalinks
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/linkhelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
 
@@ -10,5 +13,125 @@ func (l *lambdaFunctionFunctionLinkActions) StageChanges(
 	ctx context.Context,
 	input *provider.LinkStageChangesInput,
 ) (*provider.LinkStageChangesOutput, error) {
-	return nil, nil
+	changes := &provider.LinkChanges{}
+
+	functionResourceName := linkhelpers.GetResourceNameFromChanges(input.ResourceAChanges)
+	targetFunctionResourceName := linkhelpers.GetResourceNameFromChanges(input.ResourceBChanges)
+
+	currentLinkData := linkhelpers.GetLinkDataFromState(input.CurrentLinkState)
+
+	annotations := getAnnotationsFromChanges(input.ResourceAChanges)
+	linkConfig := functionFunctionLinkConfigFromAnnotations(
+		annotations,
+		targetFunctionResourceName,
+	)
+
+	err := l.collectEnvVarChanges(
+		functionResourceName,
+		linkConfig,
+		currentLinkData,
+		input.ResourceBChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CollectDestinationChanges(
+		functionResourceName,
+		destinationTypeFromAnnotations(annotations, targetFunctionResourceName),
+		currentLinkData,
+		input.ResourceBChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = linkhelpers.CollectChanges(
+		"$.spec.role",
+		"$.invokeFunctionPolicy.roleArn",
+		currentLinkData,
+		input.ResourceAChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = linkhelpers.CollectChanges(
+		"$.spec.arn",
+		"$.invokeFunctionPolicy.targetFunctionArn",
+		currentLinkData,
+		input.ResourceBChanges,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkStageChangesOutput{
+		Changes: changes,
+	}, nil
+}
+
+func (l *lambdaFunctionFunctionLinkActions) collectEnvVarChanges(
+	functionResourceName string,
+	linkConfig *functionFunctionLinkConfig,
+	currentLinkData *core.MappingNode,
+	targetFunctionChanges *provider.Changes,
+	changes *provider.LinkChanges,
+) error {
+	nameEnvVarLinkPath := fmt.Sprintf(
+		"$[%q].environmentVariables[%q]",
+		functionResourceName,
+		linkConfig.nameEnvVar,
+	)
+	arnEnvVarLinkPath := fmt.Sprintf(
+		"$[%q].environmentVariables[%q]",
+		functionResourceName,
+		linkConfig.arnEnvVar,
+	)
+
+	if !linkConfig.populateEnvVars {
+		// Passing a nil value will mark the environment variables recorded
+		// in the link data as removed, this includes environment variables
+		// populated under names that are no longer derived from the annotations.
+		for _, envVarName := range linkDataEnvVarNames(currentLinkData, functionResourceName) {
+			err := linkhelpers.CollectLinkDataChanges(
+				fmt.Sprintf(
+					"$[%q].environmentVariables[%q]",
+					functionResourceName,
+					envVarName,
+				),
+				currentLinkData,
+				changes,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err := linkhelpers.CollectChanges(
+		"$.spec.functionName",
+		nameEnvVarLinkPath,
+		currentLinkData,
+		targetFunctionChanges,
+		changes,
+	)
+	if err != nil {
+		return err
+	}
+
+	return linkhelpers.CollectChanges(
+		"$.spec.arn",
+		arnEnvVarLinkPath,
+		currentLinkData,
+		targetFunctionChanges,
+		changes,
+	)
 }
@@ -0,0 +1,462 @@
+package lambdalinks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type FunctionFunctionLinkStageChangesSuite struct {
+	suite.Suite
+}
+
+func (s *FunctionFunctionLinkStageChangesSuite) Test_stage_changes() {
+	loader := &testutils.MockAWSConfigLoader{}
+
+	testCases := []plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		createFunctionFunctionLinkChangesTestCase(),
+		createFunctionFunctionLinkChangesCustomEnvVarTestCase(),
+		createFunctionFunctionLinkChangesDisableEnvVarsTestCase(),
+		createFunctionFunctionLinkChangesDisableRenamedEnvVarsTestCase(),
+		createFunctionFunctionLinkChangesErrorTestCase(),
+	}
+
+	plugintestutils.RunLinkChangeStagingTestCases(
+		testCases,
+		createTestFunctionFunctionLink(
+			iammock.CreateIamServiceMockFactory(),
+			loader,
+		),
+		&s.Suite,
+	)
+}
+
+func createFunctionFunctionLinkChangesTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	roleARN := "arn:aws:iam::123456789012:role/orders-function-role"
+
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for a new link with default environment variables",
+		Input: &provider.LinkStageChangesInput{
+			// ResourceAChanges represents the changes in
+			// the caller function resource.
+			ResourceAChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"role": core.MappingNodeFromString(roleARN),
+							},
+						},
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.role",
+						NewValue:  core.MappingNodeFromString(roleARN),
+					},
+				},
+			},
+			// ResourceBChanges represents the changes in
+			// the target function resource.
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "logOrderEventsFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("log-order-events"),
+							},
+						},
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.functionName",
+						NewValue:  core.MappingNodeFromString("log-order-events"),
+					},
+				},
+				FieldChangesKnownOnDeploy: []string{
+					"spec.arn",
+				},
+			},
+			CurrentLinkState: &state.LinkState{
+				LinkID: "test-link",
+				Data:   map[string]*core.MappingNode{},
+			},
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				NewFields: []*provider.FieldChange{
+					{
+						FieldPath: "[\"ordersFunction\"].environmentVariables[\"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION\"]",
+						NewValue:  core.MappingNodeFromString("log-order-events"),
+					},
+					{
+						FieldPath: "invokeFunctionPolicy.roleArn",
+						NewValue:  core.MappingNodeFromString(roleARN),
+					},
+				},
+				FieldChangesKnownOnDeploy: []string{
+					"[\"ordersFunction\"].environmentVariables[\"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN\"]",
+					"invokeFunctionPolicy.targetFunctionArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionFunctionLinkChangesCustomEnvVarTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	roleARN := "arn:aws:iam::123456789012:role/orders-function-role"
+	targetFunctionARN := "arn:aws:lambda:us-west-2:123456789012:function:log-order-events"
+
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has no changes for an existing link with a custom environment variable name",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Metadata: &provider.ResolvedResourceMetadata{
+							Annotations: &core.MappingNode{
+								Fields: map[string]*core.MappingNode{
+									"aws.lambda.function.logOrderEventsFunction.envVarName": core.MappingNodeFromString(
+										"LOG_EVENTS_FUNCTION",
+									),
+								},
+							},
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"role": core.MappingNodeFromString(roleARN),
+							},
+						},
+					},
+				},
+			},
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "logOrderEventsFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("log-order-events"),
+								"arn":          core.MappingNodeFromString(targetFunctionARN),
+							},
+						},
+					},
+				},
+			},
+			CurrentLinkState: &state.LinkState{
+				LinkID: "test-link",
+				Data: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"environmentVariables": {
+								Fields: map[string]*core.MappingNode{
+									"LOG_EVENTS_FUNCTION":     core.MappingNodeFromString("log-order-events"),
+									"LOG_EVENTS_FUNCTION_ARN": core.MappingNodeFromString(targetFunctionARN),
+								},
+							},
+						},
+					},
+					"invokeFunctionPolicy": {
+						Fields: map[string]*core.MappingNode{
+							"roleArn":           core.MappingNodeFromString(roleARN),
+							"targetFunctionArn": core.MappingNodeFromString(targetFunctionARN),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				UnchangedFields: []string{
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION\"]",
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION_ARN\"]",
+					"invokeFunctionPolicy.roleArn",
+					"invokeFunctionPolicy.targetFunctionArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionFunctionLinkChangesDisableEnvVarsTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	roleARN := "arn:aws:iam::123456789012:role/orders-function-role"
+	targetFunctionARN := "arn:aws:lambda:us-west-2:123456789012:function:log-order-events"
+
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for removing environment variables when population is disabled",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Metadata: &provider.ResolvedResourceMetadata{
+							Annotations: &core.MappingNode{
+								Fields: map[string]*core.MappingNode{
+									"aws.lambda.function.populateEnvVars": core.MappingNodeFromBool(false),
+								},
+							},
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"role": core.MappingNodeFromString(roleARN),
+							},
+						},
+					},
+				},
+			},
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "logOrderEventsFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("log-order-events"),
+								"arn":          core.MappingNodeFromString(targetFunctionARN),
+							},
+						},
+					},
+				},
+			},
+			CurrentLinkState: &state.LinkState{
+				LinkID: "test-link",
+				Data: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"environmentVariables": {
+								Fields: map[string]*core.MappingNode{
+									"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION": core.MappingNodeFromString(
+										"log-order-events",
+									),
+									"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": core.MappingNodeFromString(
+										targetFunctionARN,
+									),
+								},
+							},
+						},
+					},
+					"invokeFunctionPolicy": {
+						Fields: map[string]*core.MappingNode{
+							"roleArn":           core.MappingNodeFromString(roleARN),
+							"targetFunctionArn": core.MappingNodeFromString(targetFunctionARN),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				RemovedFields: []string{
+					"[\"ordersFunction\"].environmentVariables[\"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION\"]",
+					"[\"ordersFunction\"].environmentVariables[\"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN\"]",
+				},
+				UnchangedFields: []string{
+					"invokeFunctionPolicy.roleArn",
+					"invokeFunctionPolicy.targetFunctionArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionFunctionLinkChangesDisableRenamedEnvVarsTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	roleARN := "arn:aws:iam::123456789012:role/orders-function-role"
+	targetFunctionARN := "arn:aws:lambda:us-west-2:123456789012:function:log-order-events"
+
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "has changes for removing environment variables recorded under a previous name when population is disabled",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Metadata: &provider.ResolvedResourceMetadata{
+							Annotations: &core.MappingNode{
+								Fields: map[string]*core.MappingNode{
+									"aws.lambda.function.populateEnvVars": core.MappingNodeFromBool(false),
+								},
+							},
+						},
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"role": core.MappingNodeFromString(roleARN),
+							},
+						},
+					},
+				},
+			},
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "logOrderEventsFunction",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Spec: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"functionName": core.MappingNodeFromString("log-order-events"),
+								"arn":          core.MappingNodeFromString(targetFunctionARN),
+							},
+						},
+					},
+				},
+			},
+			CurrentLinkState: &state.LinkState{
+				LinkID: "test-link",
+				Data: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"environmentVariables": {
+								Fields: map[string]*core.MappingNode{
+									"LOG_EVENTS_FUNCTION":     core.MappingNodeFromString("log-order-events"),
+									"LOG_EVENTS_FUNCTION_ARN": core.MappingNodeFromString(targetFunctionARN),
+								},
+							},
+						},
+					},
+					"invokeFunctionPolicy": {
+						Fields: map[string]*core.MappingNode{
+							"roleArn":           core.MappingNodeFromString(roleARN),
+							"targetFunctionArn": core.MappingNodeFromString(targetFunctionARN),
+						},
+					},
+				},
+			},
+		},
+		ExpectedOutput: &provider.LinkStageChangesOutput{
+			Changes: &provider.LinkChanges{
+				RemovedFields: []string{
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION\"]",
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION_ARN\"]",
+				},
+				UnchangedFields: []string{
+					"invokeFunctionPolicy.roleArn",
+					"invokeFunctionPolicy.targetFunctionArn",
+				},
+			},
+		},
+	}
+}
+
+func createFunctionFunctionLinkChangesErrorTestCase() plugintestutils.LinkChangeStagingTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	return plugintestutils.LinkChangeStagingTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name: "handles error when resourceBChanges is missing resolved resource",
+		Input: &provider.LinkStageChangesInput{
+			ResourceAChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+				},
+			},
+			ResourceBChanges: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceName: "logOrderEventsFunction",
+				},
+			},
+			CurrentLinkState: &state.LinkState{},
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: "missing resolved resource",
+	}
+}
+
+// createTestFunctionFunctionLink wraps the function to function link constructor
+// so the IAM service used to manage the execution role policy can be injected
+// alongside the lambda services provided by the test case runners.
+func createTestFunctionFunctionLink(
+	iamServiceFactory pluginutils.ServiceFactory[*aws.Config, iamservice.Service],
+	loader *testutils.MockAWSConfigLoader,
+) func(
+	pluginutils.LinkServiceDeps[*aws.Config, lambdaservice.Service, *aws.Config, lambdaservice.Service],
+) provider.Link {
+	return func(
+		linkServiceDeps pluginutils.LinkServiceDeps[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		],
+	) provider.Link {
+		return FunctionFunctionLink(
+			linkServiceDeps,
+			pluginutils.ServiceWithConfigStore[*aws.Config, iamservice.Service]{
+				ServiceFactory: iamServiceFactory,
+				ConfigStore: utils.NewAWSConfigStore(
+					[]string{},
+					utils.AWSConfigFromProviderContext,
+					loader,
+					utils.AWSConfigCacheKey,
+				),
+			},
+		)
+	}
+}
+
+func TestFunctionFunctionLinkStageChangesSuite(t *testing.T) {
+	suite.Run(t, new(FunctionFunctionLinkStageChangesSuite))
+}
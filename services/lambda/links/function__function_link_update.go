@@ -2,8 +2,17 @@ package lambdalinks
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
 	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -54,41 +63,200 @@ func (l *lambdaFunctionFunctionLinkActions) UpdateResourceA(
 		)
 	}
 
-	if input.LinkUpdateType == provider.LinkUpdateTypeDestroy {
-		return l.removeFunctionPermissionsAndEnvVars(
+	annotations := getAnnotationsFromResourceInfo(input.ResourceInfo)
+	targetFunctionResourceName := getResourceNameFromResourceInfo(input.OtherResourceInfo)
+	linkConfig := functionFunctionLinkConfigFromAnnotations(
+		annotations,
+		targetFunctionResourceName,
+	)
+	targetEnvVars := targetFunctionEnvVars(
+		linkConfig,
+		core.StringValue(otherFunctionARN),
+	)
+
+	var output *provider.LinkUpdateResourceOutput
+	if input.LinkUpdateType == provider.LinkUpdateTypeDestroy ||
+		!linkConfig.populateEnvVars {
+		output, err = l.removeFunctionEnvVars(
+			ctx,
+			core.StringValue(functionARN),
+			recordedEnvVarNames(
+				input.Changes,
+				getResourceNameFromResourceInfo(input.ResourceInfo),
+				linkConfig,
+			),
+			slices.Collect(maps.Values(targetEnvVars)),
+			lambdaService,
+		)
+	} else {
+		output, err = l.addFunctionEnvVars(
 			ctx,
+			input,
 			core.StringValue(functionARN),
-			core.StringValue(otherFunctionARN),
+			targetEnvVars,
 			lambdaService,
 		)
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return l.addFunctionPermissionsAndEnvVars(
+	destinationType := ""
+	if input.LinkUpdateType != provider.LinkUpdateTypeDestroy {
+		destinationType = destinationTypeFromAnnotations(annotations, targetFunctionResourceName)
+	}
+
+	if destinationType != "" {
+		// The target function is configured as a destination once the execution
+		// role has been granted permission to invoke the target function when the
+		// intermediary resources are updated, Lambda rejects a destination that
+		// the execution role is not allowed to invoke.
+		AddDestinationConfigLinkData(
+			output.LinkData,
+			getResourceNameFromResourceInfo(input.ResourceInfo),
+			DestinationConfigLinkData(destinationType, core.StringValue(otherFunctionARN)),
+		)
+		return output, nil
+	}
+
+	// Only a destination recorded in the link data is removed, a destination
+	// for the target function that was configured by other means is left in place.
+	err = RemoveFunctionDestination(
 		ctx,
-		input,
 		core.StringValue(functionARN),
 		core.StringValue(otherFunctionARN),
+		RecordedDestinationTypes(input),
 		lambdaService,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
 }
 
-func (l *lambdaFunctionFunctionLinkActions) addFunctionPermissionsAndEnvVars(
+func (l *lambdaFunctionFunctionLinkActions) addFunctionEnvVars(
 	ctx context.Context,
 	input *provider.LinkUpdateResourceInput,
 	functionARN string,
-	otherFunctionARN string,
+	targetEnvVars map[string]string,
 	lambdaService lambdaservice.Service,
 ) (*provider.LinkUpdateResourceOutput, error) {
-	return nil, nil
+	currentEnvVars, err := getFunctionEnvVars(ctx, functionARN, lambdaService)
+	if err != nil {
+		return nil, err
+	}
+
+	hasChanges := false
+	mergedEnvVars := maps.Clone(currentEnvVars)
+	for key, value := range targetEnvVars {
+		if currentValue, exists := currentEnvVars[key]; !exists || currentValue != value {
+			mergedEnvVars[key] = value
+			hasChanges = true
+		}
+	}
+
+	if hasChanges {
+		_, err = lambdaService.UpdateFunctionConfiguration(
+			ctx,
+			&lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(functionARN),
+				Environment: &types.Environment{
+					Variables: mergedEnvVars,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	functionResourceName := input.ResourceInfo.ResourceName
+	envVarsLinkData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{},
+	}
+	resourceDataMappings := map[string]string{}
+	for key, value := range targetEnvVars {
+		envVarsLinkData.Fields[key] = core.MappingNodeFromString(value)
+		resourceFieldPath := fmt.Sprintf(
+			"%s::spec.environment.variables.%s",
+			functionResourceName,
+			key,
+		)
+		linkFieldPath := fmt.Sprintf(
+			"%s.environmentVariables.%s",
+			functionResourceName,
+			key,
+		)
+		resourceDataMappings[resourceFieldPath] = linkFieldPath
+	}
+
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				functionResourceName: {
+					Fields: map[string]*core.MappingNode{
+						"environmentVariables": envVarsLinkData,
+					},
+				},
+			},
+		},
+		ResourceDataMappings: resourceDataMappings,
+	}, nil
 }
 
-func (l *lambdaFunctionFunctionLinkActions) removeFunctionPermissionsAndEnvVars(
+func (l *lambdaFunctionFunctionLinkActions) removeFunctionEnvVars(
 	ctx context.Context,
 	functionARN string,
-	linkedToFunctionARN string,
+	envVarNames []string,
+	linkValues []string,
 	lambdaService lambdaservice.Service,
 ) (*provider.LinkUpdateResourceOutput, error) {
-	return nil, nil
+	if len(envVarNames) == 0 {
+		return &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		}, nil
+	}
+
+	currentEnvVars, err := getFunctionEnvVars(ctx, functionARN, lambdaService)
+	if err != nil {
+		return nil, err
+	}
+
+	hasChanges := false
+	remainingEnvVars := maps.Clone(currentEnvVars)
+	for _, key := range envVarNames {
+		// Only remove environment variables that hold the values populated
+		// by the link, this avoids removing environment variables with the same
+		// name that have been set in the function spec or by other means.
+		if currentValue, exists := currentEnvVars[key]; exists && slices.Contains(linkValues, currentValue) {
+			delete(remainingEnvVars, key)
+			hasChanges = true
+		}
+	}
+
+	if hasChanges {
+		_, err = lambdaService.UpdateFunctionConfiguration(
+			ctx,
+			&lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(functionARN),
+				Environment: &types.Environment{
+					Variables: remainingEnvVars,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &provider.LinkUpdateResourceOutput{
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}, nil
 }
 
 func (l *lambdaFunctionFunctionLinkActions) UpdateResourceB(
@@ -109,8 +277,170 @@ func (l *lambdaFunctionFunctionLinkActions) UpdateIntermediaryResources(
 	ctx context.Context,
 	input *provider.LinkUpdateIntermediaryResourcesInput,
 ) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
-	// There are no intermediary resources to update
-	// for the lambda function to code signing config link.
+	iamService, err := l.getIAMService(
+		ctx,
+		provider.NewProviderContextFromLinkContext(
+			input.LinkContext,
+			"aws",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	functionSpec := pluginutils.GetCurrentStateSpecDataFromResourceInfo(
+		input.ResourceAInfo,
+	)
+	roleARN, hasRoleARN := pluginutils.GetValueByPath(
+		"$.role",
+		functionSpec,
+	)
+	if !hasRoleARN {
+		return nil, fmt.Errorf(
+			"execution role ARN could not be retrieved from the linked from %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceAInfo),
+		)
+	}
+
+	functionARN, hasFunctionARN := pluginutils.GetValueByPath(
+		"$.arn",
+		functionSpec,
+	)
+	if !hasFunctionARN {
+		return nil, fmt.Errorf(
+			"function ARN could not be retrieved from the linked from %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceAInfo),
+		)
+	}
+
+	otherFunctionSpec := pluginutils.GetCurrentStateSpecDataFromResourceInfo(
+		input.ResourceBInfo,
+	)
+	otherFunctionARN, hasOtherFunctionARN := pluginutils.GetValueByPath(
+		"$.arn",
+		otherFunctionSpec,
+	)
+	if !hasOtherFunctionARN {
+		return nil, fmt.Errorf(
+			"function ARN could not be retrieved from the linked to %q function resource",
+			getResourceNameFromResourceInfo(input.ResourceBInfo),
+		)
+	}
+
+	roleName := RoleNameFromARN(core.StringValue(roleARN))
+	policyName := invokeFunctionPolicyName(
+		functionNameFromARN(core.StringValue(functionARN)),
+		functionNameFromARN(core.StringValue(otherFunctionARN)),
+	)
+
+	if input.LinkUpdateType == provider.LinkUpdateTypeDestroy {
+		return l.removeInvokeFunctionPolicy(
+			ctx,
+			roleName,
+			policyName,
+			iamService,
+		)
+	}
+
+	output, err := l.addInvokeFunctionPolicy(
+		ctx,
+		core.StringValue(roleARN),
+		roleName,
+		policyName,
+		core.StringValue(otherFunctionARN),
+		iamService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationType := DestinationTypeFromResourceInfo(
+		input.ResourceAInfo,
+		getResourceNameFromResourceInfo(input.ResourceBInfo),
+	)
+	if destinationType == "" {
+		return output, nil
+	}
+
+	lambdaService, err := l.getLambdaService(
+		ctx,
+		provider.NewProviderContextFromLinkContext(
+			input.LinkContext,
+			"aws",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ConfigureFunctionDestination(
+		ctx,
+		core.StringValue(functionARN),
+		core.StringValue(otherFunctionARN),
+		destinationType,
+		lambdaService,
+		l.destinationRetry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *lambdaFunctionFunctionLinkActions) addInvokeFunctionPolicy(
+	ctx context.Context,
+	roleARN string,
+	roleName string,
+	policyName string,
+	targetFunctionARN string,
+	iamService iamservice.Service,
+) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
+	_, err := iamService.PutRolePolicy(
+		ctx,
+		&iam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String(policyName),
+			PolicyDocument: aws.String(invokeFunctionPolicyDocument(targetFunctionARN)),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkUpdateIntermediaryResourcesOutput{
+		IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+		LinkData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"invokeFunctionPolicy": {
+					Fields: map[string]*core.MappingNode{
+						"roleArn":           core.MappingNodeFromString(roleARN),
+						"targetFunctionArn": core.MappingNodeFromString(targetFunctionARN),
+						"policyName":        core.MappingNodeFromString(policyName),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (l *lambdaFunctionFunctionLinkActions) removeInvokeFunctionPolicy(
+	ctx context.Context,
+	roleName string,
+	policyName string,
+	iamService iamservice.Service,
+) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
+	_, err := iamService.DeleteRolePolicy(
+		ctx,
+		&iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(policyName),
+		},
+	)
+	if err != nil && !isNoSuchEntityError(err) {
+		return nil, err
+	}
+
 	return &provider.LinkUpdateIntermediaryResourcesOutput{
 		IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
 		LinkData: &core.MappingNode{
@@ -119,6 +449,45 @@ func (l *lambdaFunctionFunctionLinkActions) UpdateIntermediaryResources(
 	}, nil
 }
 
+func targetFunctionEnvVars(
+	linkConfig *functionFunctionLinkConfig,
+	targetFunctionARN string,
+) map[string]string {
+	return map[string]string{
+		linkConfig.nameEnvVar: functionNameFromARN(targetFunctionARN),
+		linkConfig.arnEnvVar:  targetFunctionARN,
+	}
+}
+
+func getFunctionEnvVars(
+	ctx context.Context,
+	functionARN string,
+	lambdaService lambdaservice.Service,
+) (map[string]string, error) {
+	functionOutput, err := lambdaService.GetFunction(
+		ctx,
+		&lambda.GetFunctionInput{
+			FunctionName: aws.String(functionARN),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if functionOutput.Configuration == nil ||
+		functionOutput.Configuration.Environment == nil ||
+		functionOutput.Configuration.Environment.Variables == nil {
+		return map[string]string{}, nil
+	}
+
+	return functionOutput.Configuration.Environment.Variables, nil
+}
+
+func isNoSuchEntityError(err error) bool {
+	var apiError smithy.APIError
+	return errors.As(err, &apiError) && apiError.ErrorCode() == "NoSuchEntity"
+}
+
 func getResourceNameFromResourceInfo(resourceInfo *provider.ResourceInfo) string {
 	if resourceInfo == nil {
 		return "unknown"
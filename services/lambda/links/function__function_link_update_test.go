@@ -0,0 +1,1071 @@
+package lambdalinks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	iammock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/iam_mock"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	iamservice "github.com/newstack-cloud/bluelink-provider-aws/services/iam/service"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testCallerFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:orders"
+	testTargetFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:log-order-events"
+	testCallerRoleARN     = "arn:aws:iam::123456789012:role/service-role/orders-function-role"
+)
+
+type FunctionFunctionLinkUpdateSuite struct {
+	suite.Suite
+}
+
+// Each intermediary resources test case needs its own IAM service mock
+// as the IAM service is not a part of the link service dependencies
+// provided by the test case runner.
+// The lambda service mock calls are checked separately as the test case
+// runner only checks the calls made to the intermediaries service mock.
+type functionFunctionIntermediariesTestCase struct {
+	iamService             iamservice.Service
+	lambdaServiceMockCalls *plugintestutils.MockCalls
+	lambdaActionsCalled    map[string]any
+	testCase               plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) Test_link_update_resources() {
+	loader := &testutils.MockAWSConfigLoader{}
+	linkCtx := createFunctionFunctionTestLinkContext()
+
+	testCases := []plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		s.createUpdateLinkAddEnvVarsTestCase(linkCtx, loader),
+		s.createUpdateLinkEnvVarsUnchangedTestCase(linkCtx, loader),
+		s.createUpdateLinkRemoveEnvVarsTestCase(linkCtx, loader),
+		s.createUpdateLinkDisabledEnvVarsTestCase(linkCtx, loader),
+		s.createUpdateLinkDisabledRecordedEnvVarsTestCase(linkCtx, loader),
+		s.createUpdateLinkTargetFunctionTestCase(linkCtx, loader),
+		s.createUpdateLinkDestinationTestCase(linkCtx, loader),
+		s.createUpdateLinkErrorTargetMissingARNTestCase(linkCtx, loader),
+		s.createUpdateLinkErrorUpdateConfigTestCase(linkCtx, loader),
+	}
+
+	plugintestutils.RunLinkUpdateResourceTestCases(
+		testCases,
+		createTestFunctionFunctionLink(
+			iammock.CreateIamServiceMockFactory(),
+			loader,
+		),
+		&s.Suite,
+	)
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) Test_link_update_intermediary_resources() {
+	loader := &testutils.MockAWSConfigLoader{}
+	linkCtx := createFunctionFunctionTestLinkContext()
+
+	testCases := []functionFunctionIntermediariesTestCase{
+		s.createUpdateIntermediariesPutPolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesConfigureDestinationTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesDeletePolicyTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesPolicyAlreadyRemovedTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesMissingRoleTestCase(linkCtx, loader),
+		s.createUpdateIntermediariesPutPolicyErrorTestCase(linkCtx, loader),
+	}
+
+	for _, tc := range testCases {
+		iamService := tc.iamService
+		plugintestutils.RunLinkUpdateIntermediaryResourcesTestCases(
+			[]plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+				*aws.Config,
+				lambdaservice.Service,
+				*aws.Config,
+				lambdaservice.Service,
+			]{tc.testCase},
+			createTestFunctionFunctionLink(
+				func(awsConfig *aws.Config, providerContext provider.Context) iamservice.Service {
+					return iamService
+				},
+				loader,
+			),
+			&s.Suite,
+		)
+
+		for methodName, expectedInput := range tc.lambdaActionsCalled {
+			tc.lambdaServiceMockCalls.AssertCalledWith(
+				&s.Suite,
+				methodName,
+				0,
+				plugintestutils.Any,
+				expectedInput,
+			)
+		}
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkAddEnvVarsTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{
+				"LOG_LEVEL": "info",
+			}),
+		),
+		lambdamock.WithUpdateFunctionConfigurationOutput(
+			&lambda.UpdateFunctionConfigurationOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Merges target function environment variables into the caller function",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeCreate,
+			ResourceInfo:      createTestCallerFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"environmentVariables": {
+								Fields: map[string]*core.MappingNode{
+									"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION": core.MappingNodeFromString(
+										"log-order-events",
+									),
+									"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": core.MappingNodeFromString(
+										testTargetFunctionARN,
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+			ResourceDataMappings: map[string]string{
+				"ordersFunction::spec.environment.variables.AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION":     "ordersFunction.environmentVariables.AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION",
+				"ordersFunction::spec.environment.variables.AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": "ordersFunction.environmentVariables.AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN",
+			},
+		},
+		UpdateActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(testCallerFunctionARN),
+				Environment: &types.Environment{
+					Variables: map[string]string{
+						"LOG_LEVEL": "info",
+						"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION":     "log-order-events",
+						"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": testTargetFunctionARN,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkEnvVarsUnchangedTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{
+				"LOG_EVENTS":     "log-order-events",
+				"LOG_EVENTS_ARN": testTargetFunctionARN,
+			}),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Does not update the caller function when environment variables are already set",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeUpdate,
+			ResourceInfo: createTestCallerFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.logOrderEventsFunction.envVarName": core.MappingNodeFromString(
+						"LOG_EVENTS",
+					),
+				},
+			),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"environmentVariables": {
+								Fields: map[string]*core.MappingNode{
+									"LOG_EVENTS":     core.MappingNodeFromString("log-order-events"),
+									"LOG_EVENTS_ARN": core.MappingNodeFromString(testTargetFunctionARN),
+								},
+							},
+						},
+					},
+				},
+			},
+			ResourceDataMappings: map[string]string{
+				"ordersFunction::spec.environment.variables.LOG_EVENTS":     "ordersFunction.environmentVariables.LOG_EVENTS",
+				"ordersFunction::spec.environment.variables.LOG_EVENTS_ARN": "ordersFunction.environmentVariables.LOG_EVENTS_ARN",
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkRemoveEnvVarsTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{
+				"LOG_LEVEL": "info",
+				"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION": "log-order-events",
+				// The ARN environment variable has been overridden outside of the link
+				// so should be left in place when the link is destroyed.
+				"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": "custom-value",
+			}),
+		),
+		lambdamock.WithUpdateFunctionConfigurationOutput(
+			&lambda.UpdateFunctionConfigurationOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Removes environment variables added by the link from the caller function",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeDestroy,
+			ResourceInfo:      createTestCallerFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(testCallerFunctionARN),
+				Environment: &types.Environment{
+					Variables: map[string]string{
+						"LOG_LEVEL": "info",
+						"AWS_LAMBDA_FUNCTION_LOG_ORDER_EVENTS_FUNCTION_ARN": "custom-value",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkDisabledEnvVarsTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{
+				"LOG_LEVEL": "info",
+			}),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Does not populate environment variables when disabled for the target function",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeCreate,
+			ResourceInfo: createTestCallerFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.populateEnvVars":                        core.MappingNodeFromBool(true),
+					"aws.lambda.function.logOrderEventsFunction.populateEnvVars": core.MappingNodeFromBool(false),
+				},
+			),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkDisabledRecordedEnvVarsTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{
+				"LOG_LEVEL":               "info",
+				"LOG_EVENTS_FUNCTION":     "log-order-events",
+				"LOG_EVENTS_FUNCTION_ARN": testTargetFunctionARN,
+			}),
+		),
+		lambdamock.WithUpdateFunctionConfigurationOutput(
+			&lambda.UpdateFunctionConfigurationOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Removes environment variables recorded in the link data when population is disabled",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeUpdate,
+			// The environment variables were populated under a custom name
+			// that is no longer set in the annotations.
+			ResourceInfo: createTestCallerFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.populateEnvVars": core.MappingNodeFromBool(false),
+				},
+			),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+			Changes: &provider.LinkChanges{
+				RemovedFields: []string{
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION\"]",
+					"[\"ordersFunction\"].environmentVariables[\"LOG_EVENTS_FUNCTION_ARN\"]",
+				},
+			},
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsCalled: map[string]any{
+			"UpdateFunctionConfiguration": &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String(testCallerFunctionARN),
+				Environment: &types.Environment{
+					Variables: map[string]string{
+						"LOG_LEVEL": "info",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkTargetFunctionTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Returns empty link data as the target function is not updated for the link",
+		Resource:                plugintestutils.LinkUpdateResourceB,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeCreate,
+			ResourceInfo:      createTestTargetFunctionResourceInfo(),
+			OtherResourceInfo: createTestCallerFunctionResourceInfo(nil),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{},
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"GetFunction",
+			"UpdateFunctionConfiguration",
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(map[string]string{}),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Defers configuring the target function as a destination until permission has been granted",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeUpdate,
+			ResourceInfo: createTestCallerFunctionResourceInfo(
+				map[string]*core.MappingNode{
+					"aws.lambda.function.populateEnvVars":                    core.MappingNodeFromBool(false),
+					"aws.lambda.function.logOrderEventsFunction.destination": core.MappingNodeFromString("onSuccess"),
+				},
+			),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectedOutput: &provider.LinkUpdateResourceOutput{
+			LinkData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"destinationConfig": {
+								Fields: map[string]*core.MappingNode{
+									"onSuccess": core.MappingNodeFromString(testTargetFunctionARN),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		UpdateActionsNotCalled: []string{
+			"UpdateFunctionConfiguration",
+			"GetFunctionEventInvokeConfig",
+			"PutFunctionEventInvokeConfig",
+			"UpdateFunctionEventInvokeConfig",
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkErrorTargetMissingARNTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Fails when the target function ARN is missing",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType: provider.LinkUpdateTypeCreate,
+			ResourceInfo:   createTestCallerFunctionResourceInfo(nil),
+			OtherResourceInfo: &provider.ResourceInfo{
+				ResourceName: "logOrderEventsFunction",
+				CurrentResourceState: &state.ResourceState{
+					SpecData: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{},
+					},
+				},
+			},
+			LinkContext: linkCtx,
+		},
+		ExpectError: true,
+		ExpectedErrorMessage: "function ARN could not be retrieved from the linked to " +
+			"\"logOrderEventsFunction\" function resource",
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateLinkErrorUpdateConfigTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.LinkUpdateResourceTestCase[
+	*aws.Config,
+	lambdaservice.Service,
+	*aws.Config,
+	lambdaservice.Service,
+] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionOutput(
+			createTestFunctionOutputWithEnvVars(nil),
+		),
+		lambdamock.WithUpdateFunctionConfigurationError(
+			errors.New("failed to update function configuration"),
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(service, loader)
+
+	return plugintestutils.LinkUpdateResourceTestCase[
+		*aws.Config,
+		lambdaservice.Service,
+		*aws.Config,
+		lambdaservice.Service,
+	]{
+		Name:                    "Fails when the caller function configuration update fails",
+		Resource:                plugintestutils.LinkUpdateResourceA,
+		ServiceFactoryA:         serviceFactory,
+		ConfigStoreA:            configStore,
+		ServiceFactoryB:         serviceFactory,
+		ConfigStoreB:            configStore,
+		CurrentServiceMockCalls: &service.MockCalls,
+		Input: &provider.LinkUpdateResourceInput{
+			LinkUpdateType:    provider.LinkUpdateTypeCreate,
+			ResourceInfo:      createTestCallerFunctionResourceInfo(nil),
+			OtherResourceInfo: createTestTargetFunctionResourceInfo(),
+			LinkContext:       linkCtx,
+		},
+		ExpectError:          true,
+		ExpectedErrorMessage: "failed to update function configuration",
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesPutPolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithPutRolePolicyOutput(&iam.PutRolePolicyOutput{}),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService: iamService,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Adds invoke function policy to the caller function execution role",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestCallerFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"invokeFunctionPolicy": {
+							Fields: map[string]*core.MappingNode{
+								"roleArn":           core.MappingNodeFromString(testCallerRoleARN),
+								"targetFunctionArn": core.MappingNodeFromString(testTargetFunctionARN),
+								"policyName":        core.MappingNodeFromString("bluelink-link-orders-invoke-log-order-events"),
+							},
+						},
+					},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"PutRolePolicy": &iam.PutRolePolicyInput{
+					RoleName:   aws.String("orders-function-role"),
+					PolicyName: aws.String("bluelink-link-orders-invoke-log-order-events"),
+					PolicyDocument: aws.String(
+						`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["lambda:InvokeFunction"],` +
+							`"Resource":["arn:aws:lambda:us-west-2:123456789012:function:log-order-events",` +
+							`"arn:aws:lambda:us-west-2:123456789012:function:log-order-events:*"]}]}`,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesConfigureDestinationTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithPutRolePolicyOutput(&iam.PutRolePolicyOutput{}),
+	)
+	lambdaService := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithGetFunctionEventInvokeConfigOutput(
+			&lambda.GetFunctionEventInvokeConfigOutput{
+				FunctionArn:              aws.String(testCallerFunctionARN),
+				MaximumRetryAttempts:     aws.Int32(1),
+				MaximumEventAgeInSeconds: aws.Int32(3600),
+			},
+		),
+		lambdamock.WithUpdateFunctionEventInvokeConfigOutput(
+			&lambda.UpdateFunctionEventInvokeConfigOutput{},
+		),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(lambdaService, loader)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService:             iamService,
+		lambdaServiceMockCalls: &lambdaService.MockCalls,
+		lambdaActionsCalled: map[string]any{
+			"UpdateFunctionEventInvokeConfig": &lambda.UpdateFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(testCallerFunctionARN),
+				DestinationConfig: &types.DestinationConfig{
+					OnSuccess: &types.OnSuccess{
+						Destination: aws.String(testTargetFunctionARN),
+					},
+					OnFailure: &types.OnFailure{},
+				},
+			},
+		},
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Configures the target function as a destination after granting permission to invoke it",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo: createTestCallerFunctionResourceInfo(
+					map[string]*core.MappingNode{
+						"aws.lambda.function.logOrderEventsFunction.destination": core.MappingNodeFromString("onSuccess"),
+					},
+				),
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"invokeFunctionPolicy": {
+							Fields: map[string]*core.MappingNode{
+								"roleArn":           core.MappingNodeFromString(testCallerRoleARN),
+								"targetFunctionArn": core.MappingNodeFromString(testTargetFunctionARN),
+								"policyName":        core.MappingNodeFromString("bluelink-link-orders-invoke-log-order-events"),
+							},
+						},
+					},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"PutRolePolicy": &iam.PutRolePolicyInput{
+					RoleName:   aws.String("orders-function-role"),
+					PolicyName: aws.String("bluelink-link-orders-invoke-log-order-events"),
+					PolicyDocument: aws.String(
+						`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["lambda:InvokeFunction"],` +
+							`"Resource":["arn:aws:lambda:us-west-2:123456789012:function:log-order-events",` +
+							`"arn:aws:lambda:us-west-2:123456789012:function:log-order-events:*"]}]}`,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesDeletePolicyTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithDeleteRolePolicyOutput(&iam.DeleteRolePolicyOutput{}),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService: iamService,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Removes invoke function policy from the caller function execution role",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestCallerFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeDestroy,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{},
+				},
+			},
+			UpdateActionsCalled: map[string]any{
+				"DeleteRolePolicy": &iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("orders-function-role"),
+					PolicyName: aws.String("bluelink-link-orders-invoke-log-order-events"),
+				},
+			},
+			UpdateActionsNotCalled: []string{
+				"PutRolePolicy",
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesPolicyAlreadyRemovedTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithDeleteRolePolicyError(&smithy.GenericAPIError{
+			Code:    "NoSuchEntity",
+			Message: "The role policy cannot be found.",
+		}),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService: iamService,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Succeeds when the invoke function policy has already been removed",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestCallerFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeDestroy,
+				LinkContext:    linkCtx,
+			},
+			ExpectedOutput: &provider.LinkUpdateIntermediaryResourcesOutput{
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{},
+				LinkData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{},
+				},
+			},
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesMissingRoleTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock()
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService: iamService,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Fails when the caller function execution role ARN is missing",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo: &provider.ResourceInfo{
+					ResourceName: "ordersFunction",
+					CurrentResourceState: &state.ResourceState{
+						SpecData: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"arn": core.MappingNodeFromString(testCallerFunctionARN),
+							},
+						},
+					},
+				},
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectError: true,
+			ExpectedErrorMessage: "execution role ARN could not be retrieved from the linked from " +
+				"\"ordersFunction\" function resource",
+		},
+	}
+}
+
+func (s *FunctionFunctionLinkUpdateSuite) createUpdateIntermediariesPutPolicyErrorTestCase(
+	linkCtx provider.LinkContext,
+	loader *testutils.MockAWSConfigLoader,
+) functionFunctionIntermediariesTestCase {
+	iamService := iammock.CreateIamServiceMock(
+		iammock.WithPutRolePolicyError(errors.New("failed to put role policy")),
+	)
+	serviceFactory, configStore := createLambdaServiceDeps(
+		lambdamock.CreateLambdaServiceMock(),
+		loader,
+	)
+
+	return functionFunctionIntermediariesTestCase{
+		iamService: iamService,
+		testCase: plugintestutils.LinkUpdateIntermediaryResourcesTestCase[
+			*aws.Config,
+			lambdaservice.Service,
+			*aws.Config,
+			lambdaservice.Service,
+		]{
+			Name:                           "Fails when adding the invoke function policy fails",
+			ServiceFactoryA:                serviceFactory,
+			ConfigStoreA:                   configStore,
+			ServiceFactoryB:                serviceFactory,
+			ConfigStoreB:                   configStore,
+			IntermediariesServiceMockCalls: &iamService.MockCalls,
+			Input: &provider.LinkUpdateIntermediaryResourcesInput{
+				ResourceAInfo:  createTestCallerFunctionResourceInfo(nil),
+				ResourceBInfo:  createTestTargetFunctionResourceInfo(),
+				LinkUpdateType: provider.LinkUpdateTypeCreate,
+				LinkContext:    linkCtx,
+			},
+			ExpectError:          true,
+			ExpectedErrorMessage: "failed to put role policy",
+		},
+	}
+}
+
+func createFunctionFunctionTestLinkContext() provider.LinkContext {
+	return plugintestutils.NewTestLinkContext(
+		map[string]map[string]*core.ScalarValue{
+			"aws": {
+				"region": core.ScalarFromString("us-west-2"),
+			},
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+}
+
+func createLambdaServiceDeps(
+	service lambdaservice.Service,
+	loader *testutils.MockAWSConfigLoader,
+) (
+	func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service,
+	*utils.AWSConfigStore,
+) {
+	configStore := utils.NewAWSConfigStore(
+		[]string{},
+		utils.AWSConfigFromProviderContext,
+		loader,
+		utils.AWSConfigCacheKey,
+	)
+	serviceFactory := func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+		return service
+	}
+	return serviceFactory, configStore
+}
+
+func createTestCallerFunctionResourceInfo(
+	annotations map[string]*core.MappingNode,
+) *provider.ResourceInfo {
+	resourceInfo := &provider.ResourceInfo{
+		ResourceName: "ordersFunction",
+		CurrentResourceState: &state.ResourceState{
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":  core.MappingNodeFromString(testCallerFunctionARN),
+					"role": core.MappingNodeFromString(testCallerRoleARN),
+				},
+			},
+		},
+	}
+
+	if annotations != nil {
+		resourceInfo.ResourceWithResolvedSubs = &provider.ResolvedResource{
+			Metadata: &provider.ResolvedResourceMetadata{
+				Annotations: &core.MappingNode{
+					Fields: annotations,
+				},
+			},
+		}
+	}
+
+	return resourceInfo
+}
+
+func createTestTargetFunctionResourceInfo() *provider.ResourceInfo {
+	return &provider.ResourceInfo{
+		ResourceName: "logOrderEventsFunction",
+		CurrentResourceState: &state.ResourceState{
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString(testTargetFunctionARN),
+					"functionName": core.MappingNodeFromString("log-order-events"),
+				},
+			},
+		},
+	}
+}
+
+func createTestFunctionOutputWithEnvVars(envVars map[string]string) *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionArn: aws.String(testCallerFunctionARN),
+			Environment: &types.EnvironmentResponse{
+				Variables: envVars,
+			},
+		},
+	}
+}
+
+func TestFunctionFunctionLinkUpdateSuite(t *testing.T) {
+	suite.Run(t, new(FunctionFunctionLinkUpdateSuite))
+}
@@ -0,0 +1,239 @@
+package lambdalinks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+const (
+	populateEnvVarsAnnotation = "aws.lambda.function.populateEnvVars"
+	// The maximum length of the name of an inline policy for an IAM role.
+	maxInlinePolicyNameLength = 128
+)
+
+// Configuration derived from the annotations of the linked from function
+// for a specific target function.
+type functionFunctionLinkConfig struct {
+	populateEnvVars bool
+	nameEnvVar      string
+	arnEnvVar       string
+}
+
+func functionFunctionLinkConfigFromAnnotations(
+	annotations *core.MappingNode,
+	targetFunctionResourceName string,
+) *functionFunctionLinkConfig {
+	populateEnvVars := true
+	if value, hasValue := getAnnotationValue(
+		annotations,
+		populateEnvVarsAnnotation,
+	); hasValue {
+		populateEnvVars = core.BoolValue(value)
+	}
+
+	// An annotation for a specific target function takes precedence
+	// over the annotation for all target functions.
+	if value, hasValue := getAnnotationValue(
+		annotations,
+		fmt.Sprintf("aws.lambda.function.%s.populateEnvVars", targetFunctionResourceName),
+	); hasValue {
+		populateEnvVars = core.BoolValue(value)
+	}
+
+	nameEnvVar := fmt.Sprintf(
+		"AWS_LAMBDA_FUNCTION_%s",
+		toUpperSnakeCase(targetFunctionResourceName),
+	)
+	if value, hasValue := getAnnotationValue(
+		annotations,
+		fmt.Sprintf("aws.lambda.function.%s.envVarName", targetFunctionResourceName),
+	); hasValue && core.StringValue(value) != "" {
+		nameEnvVar = core.StringValue(value)
+	}
+
+	return &functionFunctionLinkConfig{
+		populateEnvVars: populateEnvVars,
+		nameEnvVar:      nameEnvVar,
+		arnEnvVar:       fmt.Sprintf("%s_ARN", nameEnvVar),
+	}
+}
+
+// linkDataEnvVarNames returns the names of the environment variables
+// recorded in the link data for the linked from function in a stable order.
+func linkDataEnvVarNames(linkData *core.MappingNode, functionResourceName string) []string {
+	envVars, _ := core.GetPathValue(
+		fmt.Sprintf("$[%q].environmentVariables", functionResourceName),
+		linkData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if core.IsNilMappingNode(envVars) || envVars.Fields == nil {
+		return []string{}
+	}
+
+	return slices.Sorted(maps.Keys(envVars.Fields))
+}
+
+// recordedEnvVarNames returns the names of the environment variables that
+// have been recorded in the link data as populated by the link.
+// The link data is read from the link changes that were collected from the
+// current link data when the changes were staged, when there are no staged
+// changes, the names derived from the annotations of the linked from function
+// are used instead.
+func recordedEnvVarNames(
+	changes *provider.LinkChanges,
+	functionResourceName string,
+	linkConfig *functionFunctionLinkConfig,
+) []string {
+	if changes == nil {
+		return []string{linkConfig.nameEnvVar, linkConfig.arnEnvVar}
+	}
+
+	fieldPaths := slices.Concat(changes.RemovedFields, changes.UnchangedFields)
+	for _, fieldChange := range changes.ModifiedFields {
+		fieldPaths = append(fieldPaths, fieldChange.FieldPath)
+	}
+
+	envVarPathPrefix := fmt.Sprintf("[%q].environmentVariables[", functionResourceName)
+	envVarNames := []string{}
+	for _, fieldPath := range fieldPaths {
+		quotedName, isEnvVarPath := strings.CutPrefix(fieldPath, envVarPathPrefix)
+		if !isEnvVarPath {
+			continue
+		}
+
+		envVarName, err := strconv.Unquote(strings.TrimSuffix(quotedName, "]"))
+		if err == nil && !slices.Contains(envVarNames, envVarName) {
+			envVarNames = append(envVarNames, envVarName)
+		}
+	}
+
+	return envVarNames
+}
+
+func getAnnotationValue(annotations *core.MappingNode, name string) (*core.MappingNode, bool) {
+	if annotations == nil || annotations.Fields == nil {
+		return nil, false
+	}
+
+	value, hasValue := annotations.Fields[name]
+	return value, hasValue && !core.IsNilMappingNode(value)
+}
+
+func getAnnotationsFromChanges(changes *provider.Changes) *core.MappingNode {
+	if changes == nil {
+		return nil
+	}
+
+	return getAnnotationsFromResolvedResource(
+		changes.AppliedResourceInfo.ResourceWithResolvedSubs,
+	)
+}
+
+func getAnnotationsFromResourceInfo(resourceInfo *provider.ResourceInfo) *core.MappingNode {
+	if resourceInfo == nil {
+		return nil
+	}
+
+	return getAnnotationsFromResolvedResource(resourceInfo.ResourceWithResolvedSubs)
+}
+
+func getAnnotationsFromResolvedResource(resolvedResource *provider.ResolvedResource) *core.MappingNode {
+	if resolvedResource == nil || resolvedResource.Metadata == nil {
+		return nil
+	}
+
+	return resolvedResource.Metadata.Annotations
+}
+
+// toUpperSnakeCase converts a resource name such as "logOrderEventsFunction"
+// or "log-order-events" to a form that can be used in an environment variable name
+// such as "LOG_ORDER_EVENTS_FUNCTION" or "LOG_ORDER_EVENTS".
+func toUpperSnakeCase(name string) string {
+	sb := strings.Builder{}
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sb.WriteRune('_')
+			continue
+		}
+
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			sb.WriteRune('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+
+	return sb.String()
+}
+
+// invokeFunctionPolicyName derives a deterministic name for the inline policy
+// added to the execution role of the caller function so that the same policy
+// can be found and removed when the link is destroyed.
+func invokeFunctionPolicyName(callerFunctionName string, targetFunctionName string) string {
+	return inlinePolicyName(callerFunctionName, "invoke", targetFunctionName)
+}
+
+// inlinePolicyName derives a deterministic name in the form
+// bluelink-link-{functionName}-{action}-{targetName} for an inline policy added to the execution
+// role of a function, long names are truncated and suffixed with a hash
+// to stay within the inline policy name length limit.
+// The prefix marks the policy as managed by a link so that it is not removed
+// from roles with exclusive policy management enabled.
+func inlinePolicyName(functionName string, action string, targetName string) string {
+	policyName := fmt.Sprintf(
+		"%s%s-%s-%s",
+		utils.LinkInlinePolicyNamePrefix,
+		functionName,
+		action,
+		targetName,
+	)
+	if len(policyName) <= maxInlinePolicyNameLength {
+		return policyName
+	}
+
+	hash := sha256.Sum256([]byte(policyName))
+	hashSuffix := hex.EncodeToString(hash[:])[:16]
+	return fmt.Sprintf("%s-%s", policyName[:maxInlinePolicyNameLength-len(hashSuffix)-1], hashSuffix)
+}
+
+func invokeFunctionPolicyDocument(targetFunctionARN string) string {
+	return fmt.Sprintf(
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["lambda:InvokeFunction"],"Resource":[%q,%q]}]}`,
+		targetFunctionARN,
+		// Allow invoking published versions and aliases of the target function.
+		fmt.Sprintf("%s:*", targetFunctionARN),
+	)
+}
+
+// Extracts the function name from a lambda function ARN of the form
+// arn:aws:lambda:{region}:{account}:function:{functionName}[:{qualifier}].
+func functionNameFromARN(functionARN string) string {
+	parts := strings.Split(functionARN, ":")
+	if len(parts) < 7 {
+		return functionARN
+	}
+
+	return parts[6]
+}
+
+// RoleNameFromARN extracts the role name from an IAM role ARN of the form
+// arn:aws:iam::{account}:role/{path}{roleName}.
+func RoleNameFromARN(roleARN string) string {
+	lastSlashIndex := strings.LastIndex(roleARN, "/")
+	if lastSlashIndex == -1 {
+		return roleARN
+	}
+
+	return roleARN[lastSlashIndex+1:]
+}
@@ -0,0 +1,145 @@
+package lambda
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+var accountRootPrincipalPattern = regexp.MustCompile(`^arn:aws[a-zA-Z-]*:iam::(\d{12}):root$`)
+
+// The subset of a resource-based function policy document
+// that is needed to determine the current state of a permission.
+type functionPolicyDocument struct {
+	Statement []*functionPolicyStatement `json:"Statement"`
+}
+
+type functionPolicyStatement struct {
+	Sid       string                    `json:"Sid"`
+	Effect    string                    `json:"Effect"`
+	Principal any                       `json:"Principal"`
+	Action    any                       `json:"Action"`
+	Resource  any                       `json:"Resource"`
+	Condition map[string]map[string]any `json:"Condition"`
+}
+
+// findFunctionPolicyStatement parses a function policy document
+// and returns the statement with the provided statement ID,
+// nil is returned if there is no statement with the given ID.
+func findFunctionPolicyStatement(
+	policy string,
+	statementId string,
+) (*functionPolicyStatement, error) {
+	document := &functionPolicyDocument{}
+	err := json.Unmarshal([]byte(policy), document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse function policy: %w", err)
+	}
+
+	for _, statement := range document.Statement {
+		if statement.Sid == statementId {
+			return statement, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// permissionSpecFromStatement extracts permission spec fields from a function
+// policy statement.
+// The current spec is used to preserve the form of the principal as it was
+// provided in the blueprint, account IDs are expanded to account root ARNs
+// in function policies.
+func permissionSpecFromStatement(
+	statement *functionPolicyStatement,
+	currentSpec *core.MappingNode,
+) map[string]*core.MappingNode {
+	fields := map[string]*core.MappingNode{}
+
+	action := firstPolicyValue(statement.Action)
+	if action != "" {
+		fields["action"] = core.MappingNodeFromString(action)
+	}
+
+	principal := statementPrincipal(statement.Principal)
+	if principal != "" {
+		fields["principal"] = core.MappingNodeFromString(
+			normalisePrincipal(principal, core.StringValue(currentSpec.Fields["principal"])),
+		)
+	}
+
+	for _, conditionValues := range statement.Condition {
+		for conditionKey, conditionValue := range conditionValues {
+			fieldName, isPermissionField := conditionKeyToPermissionField(conditionKey)
+			value := firstPolicyValue(conditionValue)
+			if isPermissionField && value != "" {
+				fields[fieldName] = core.MappingNodeFromString(value)
+			}
+		}
+	}
+
+	return fields
+}
+
+func conditionKeyToPermissionField(conditionKey string) (string, bool) {
+	switch strings.ToLower(conditionKey) {
+	case "aws:sourcearn":
+		return "sourceArn", true
+	case "aws:sourceaccount":
+		return "sourceAccount", true
+	case "aws:principalorgid":
+		return "principalOrgID", true
+	case "lambda:functionurlauthtype":
+		return "functionUrlAuthType", true
+	default:
+		return "", false
+	}
+}
+
+func statementPrincipal(principal any) string {
+	switch typedPrincipal := principal.(type) {
+	case string:
+		return typedPrincipal
+	case map[string]any:
+		for _, key := range []string{"Service", "AWS", "Federated", "CanonicalUser"} {
+			if value := firstPolicyValue(typedPrincipal[key]); value != "" {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
+
+func normalisePrincipal(principal string, specPrincipal string) string {
+	if principal == specPrincipal {
+		return principal
+	}
+
+	matches := accountRootPrincipalPattern.FindStringSubmatch(principal)
+	if len(matches) == 2 && matches[1] == specPrincipal {
+		return specPrincipal
+	}
+
+	return principal
+}
+
+// Policy element values can be provided as a single string
+// or an array of strings.
+func firstPolicyValue(value any) string {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue
+	case []any:
+		if len(typedValue) > 0 {
+			if strValue, isString := typedValue[0].(string); isString {
+				return strValue
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,70 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// PermissionResource returns a resource implementation for an AWS Lambda Permission.
+func PermissionResource(
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	basicExample, _ := examples.ReadFile("examples/resources/lambda_permission_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/lambda_permission_jsonc.md")
+	completeExample, _ := examples.ReadFile("examples/resources/lambda_permission_complete.md")
+
+	lambdaPermissionActions := &lambdaPermissionResourceActions{
+		lambdaServiceFactory,
+		awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/lambda/permission",
+		Label:            "AWS Lambda Permission",
+		PlainTextSummary: "A resource for managing statements in the resource-based policy of an AWS Lambda function.",
+		FormattedDescription: "The resource type used to define a [Lambda permission](https://docs.aws.amazon.com/lambda/latest/api/API_AddPermission.html) " +
+			"that grants an AWS service, AWS account or organization permission to use a function. " +
+			"Each permission is a statement in the [resource-based policy](https://docs.aws.amazon.com/lambda/latest/dg/access-control-resource-based.html) " +
+			"of the function, version or alias, identified by its statement ID.",
+		Schema:         lambdaPermissionResourceSchema(),
+		IDField:        "id",
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(basicExample),
+			string(jsoncExample),
+			string(completeExample),
+		},
+		GetExternalStateFunc: lambdaPermissionActions.GetExternalState,
+		CreateFunc:           lambdaPermissionActions.Create,
+		UpdateFunc:           lambdaPermissionActions.Update,
+		DestroyFunc:          lambdaPermissionActions.Destroy,
+		StabilisedFunc:       lambdaPermissionActions.Stabilised,
+	}
+}
+
+type lambdaPermissionResourceActions struct {
+	lambdaServiceFactory pluginutils.ServiceFactory[*aws.Config, lambdaservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *lambdaPermissionResourceActions) getLambdaService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (lambdaservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	return l.lambdaServiceFactory(awsConfig, providerContext), nil
+}
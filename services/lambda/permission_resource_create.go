@@ -0,0 +1,48 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
+		&permissionAdd{},
+	}
+
+	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSavedValues {
+		return nil, fmt.Errorf("no values were saved during permission creation")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
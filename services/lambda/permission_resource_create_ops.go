@@ -0,0 +1,148 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+type permissionAdd struct {
+	input *lambda.AddPermissionInput
+}
+
+func (u *permissionAdd) Name() string {
+	return "add permission"
+}
+
+func (u *permissionAdd) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues, err := changesToAddPermissionInput(specData)
+	if err != nil {
+		return false, saveOpCtx, err
+	}
+	u.input = input
+	return hasValues && permissionHasChanges(saveOpCtx), saveOpCtx, nil
+}
+
+func (u *permissionAdd) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService lambdaservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	addPermissionOutput, err := lambdaService.AddPermission(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = permissionID(
+		aws.ToString(u.input.FunctionName),
+		aws.ToString(u.input.Qualifier),
+		aws.ToString(u.input.StatementId),
+	)
+	newSaveOpCtx.Data["addPermissionOutput"] = addPermissionOutput
+
+	return newSaveOpCtx, nil
+}
+
+func changesToAddPermissionInput(
+	specData *core.MappingNode,
+) (*lambda.AddPermissionInput, bool, error) {
+	input := &lambda.AddPermissionInput{}
+
+	functionName := core.StringValue(specData.Fields["functionName"])
+	if functionName == "" {
+		return nil, false, fmt.Errorf("functionName is required")
+	}
+	input.FunctionName = aws.String(functionName)
+
+	statementId := core.StringValue(specData.Fields["statementId"])
+	if statementId == "" {
+		return nil, false, fmt.Errorf("statementId is required")
+	}
+	input.StatementId = aws.String(statementId)
+
+	valueSetters := []*pluginutils.ValueSetter[*lambda.AddPermissionInput]{
+		pluginutils.NewValueSetter(
+			"$.action",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Action = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.principal",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Principal = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.sourceArn",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.SourceArn = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.sourceAccount",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.SourceAccount = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.principalOrgID",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.PrincipalOrgID = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.functionUrlAuthType",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.FunctionUrlAuthType = types.FunctionUrlAuthType(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.qualifier",
+			func(value *core.MappingNode, input *lambda.AddPermissionInput) {
+				input.Qualifier = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	hasValuesToSave := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValuesToSave = hasValuesToSave || valueSetter.DidSet()
+	}
+
+	return input, hasValuesToSave, nil
+}
+
+// permissionHasChanges determines whether the permission statement needs to be
+// saved, this is always the case on creation and is determined by the changes
+// to the resource for updates.
+func permissionHasChanges(saveOpCtx pluginutils.SaveOperationContext) bool {
+	hasChanges, isUpdate := saveOpCtx.Data["permissionHasChanges"].(bool)
+	return !isUpdate || hasChanges
+}
+
+// permissionID derives a unique identifier for a permission statement
+// in the form {functionName}[:{qualifier}]#{statementId}.
+func permissionID(functionName string, qualifier string, statementId string) string {
+	if qualifier == "" {
+		return fmt.Sprintf("%s#%s", functionName, statementId)
+	}
+
+	return fmt.Sprintf("%s:%s#%s", functionName, qualifier, statementId)
+}
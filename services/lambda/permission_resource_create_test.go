@@ -0,0 +1,260 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceCreateSuite) Test_create_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		createServicePrincipalPermissionTestCase(providerCtx, loader),
+		createQualifiedFunctionUrlPermissionTestCase(providerCtx, loader),
+		createPermissionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func createServicePrincipalPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithAddPermissionOutput(&lambda.AddPermissionOutput{
+			Statement: aws.String(
+				`{"Sid":"allow-s3-invoke","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction"}`,
+			),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":  core.MappingNodeFromString("test-function"),
+			"statementId":   core.MappingNodeFromString("allow-s3-invoke"),
+			"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+			"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::test-bucket"),
+			"sourceAccount": core.MappingNodeFromString("123456789012"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create permission for a service principal",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+				NewFields: []provider.FieldChange{
+					{FieldPath: "spec.functionName"},
+					{FieldPath: "spec.statementId"},
+					{FieldPath: "spec.action"},
+					{FieldPath: "spec.principal"},
+					{FieldPath: "spec.sourceArn"},
+					{FieldPath: "spec.sourceAccount"},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function#allow-s3-invoke"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName:  aws.String("test-function"),
+				StatementId:   aws.String("allow-s3-invoke"),
+				Action:        aws.String("lambda:InvokeFunction"),
+				Principal:     aws.String("s3.amazonaws.com"),
+				SourceArn:     aws.String("arn:aws:s3:::test-bucket"),
+				SourceAccount: aws.String("123456789012"),
+			},
+		},
+	}
+}
+
+func createQualifiedFunctionUrlPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithAddPermissionOutput(&lambda.AddPermissionOutput{}),
+	)
+
+	functionARN := "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName":        core.MappingNodeFromString(functionARN),
+			"qualifier":           core.MappingNodeFromString("live"),
+			"statementId":         core.MappingNodeFromString("allow-public-url"),
+			"action":              core.MappingNodeFromString("lambda:InvokeFunctionUrl"),
+			"principal":           core.MappingNodeFromString("*"),
+			"functionUrlAuthType": core.MappingNodeFromString("NONE"),
+			"principalOrgID":      core.MappingNodeFromString("o-abc123defg"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create permission for a function URL of an alias",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString(functionARN + ":live#allow-public-url"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName:        aws.String(functionARN),
+				Qualifier:           aws.String("live"),
+				StatementId:         aws.String("allow-public-url"),
+				Action:              aws.String("lambda:InvokeFunctionUrl"),
+				Principal:           aws.String("*"),
+				FunctionUrlAuthType: types.FunctionUrlAuthTypeNone,
+				PrincipalOrgID:      aws.String("o-abc123defg"),
+			},
+		},
+	}
+}
+
+func createPermissionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithAddPermissionError(errors.New("failed to add permission")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"statementId":  core.MappingNodeFromString("allow-sns-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "create permission failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+		SaveActionsCalled: map[string]any{
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName: aws.String("test-function"),
+				StatementId:  aws.String("allow-sns-invoke"),
+				Action:       aws.String("lambda:InvokeFunction"),
+				Principal:    aws.String("sns.amazonaws.com"),
+			},
+		},
+	}
+}
+
+func TestLambdaPermissionResourceCreate(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceCreateSuite))
+}
@@ -0,0 +1,25 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *lambdaPermissionResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return err
+	}
+
+	removeInput, err := specToRemovePermissionInput(input.ResourceState.SpecData)
+	if err != nil {
+		return fmt.Errorf("failed to prepare permission removal: %w", err)
+	}
+
+	return removePermission(ctx, lambdaService, removeInput)
+}
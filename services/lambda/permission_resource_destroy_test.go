@@ -0,0 +1,180 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceDestroySuite) Test_destroy_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service]{
+		destroyPermissionTestCase(providerCtx, loader),
+		destroyPermissionAlreadyRemovedTestCase(providerCtx, loader),
+		destroyPermissionFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func destroyPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithRemovePermissionOutput(&lambda.RemovePermissionOutput{}),
+	)
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "destroy permission",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"functionName": core.MappingNodeFromString("test-function"),
+						"qualifier":    core.MappingNodeFromString("live"),
+						"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+						"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+						"principal":    core.MappingNodeFromString("s3.amazonaws.com"),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		DestroyActionsCalled: map[string]any{
+			"RemovePermission": &lambda.RemovePermissionInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("live"),
+				StatementId:  aws.String("allow-s3-invoke"),
+			},
+		},
+	}
+}
+
+func destroyPermissionAlreadyRemovedTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithRemovePermissionError(&smithy.GenericAPIError{
+			Code:    "ResourceNotFoundException",
+			Message: "The resource you requested does not exist.",
+		}),
+	)
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "destroy permission that has already been removed",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"functionName": core.MappingNodeFromString("test-function"),
+						"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		DestroyActionsCalled: map[string]any{
+			"RemovePermission": &lambda.RemovePermissionInput{
+				FunctionName: aws.String("test-function"),
+				StatementId:  aws.String("allow-s3-invoke"),
+			},
+		},
+	}
+}
+
+func destroyPermissionFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithRemovePermissionError(errors.New("failed to remove permission")),
+	)
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "destroy permission failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"functionName": core.MappingNodeFromString("test-function"),
+						"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
+
+func TestLambdaPermissionResourceDestroy(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceDestroySuite))
+}
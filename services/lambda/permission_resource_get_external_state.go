@@ -0,0 +1,84 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *lambdaPermissionResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := core.StringValue(input.CurrentResourceSpec.Fields["functionName"])
+	statementId := core.StringValue(input.CurrentResourceSpec.Fields["statementId"])
+	if functionName == "" || statementId == "" {
+		return nil, fmt.Errorf("functionName and statementId are required")
+	}
+	qualifier := core.StringValue(input.CurrentResourceSpec.Fields["qualifier"])
+
+	getPolicyInput := &lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		getPolicyInput.Qualifier = aws.String(qualifier)
+	}
+
+	getPolicyOutput, err := lambdaService.GetPolicy(ctx, getPolicyInput)
+	if err != nil {
+		var apiError smithy.APIError
+		if errors.As(err, &apiError) && apiError.ErrorCode() == "ResourceNotFoundException" {
+			return emptyPermissionExternalState(), nil
+		}
+		return nil, fmt.Errorf("failed to get function policy: %w", err)
+	}
+
+	statement, err := findFunctionPolicyStatement(
+		aws.ToString(getPolicyOutput.Policy),
+		statementId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if statement == nil {
+		// The statement has been removed from the function policy
+		// outside of the blueprint.
+		return emptyPermissionExternalState(), nil
+	}
+
+	resourceSpecState := &core.MappingNode{
+		Fields: permissionSpecFromStatement(statement, input.CurrentResourceSpec),
+	}
+	resourceSpecState.Fields["functionName"] = core.MappingNodeFromString(functionName)
+	resourceSpecState.Fields["statementId"] = core.MappingNodeFromString(statementId)
+	if qualifier != "" {
+		resourceSpecState.Fields["qualifier"] = core.MappingNodeFromString(qualifier)
+	}
+	resourceSpecState.Fields["id"] = core.MappingNodeFromString(
+		permissionID(functionName, qualifier, statementId),
+	)
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func emptyPermissionExternalState() *provider.ResourceGetExternalStateOutput {
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}
+}
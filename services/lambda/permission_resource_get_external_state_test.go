@@ -0,0 +1,271 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceGetExternalStateSuite) Test_get_external_state_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		getExternalStateServicePermissionTestCase(providerCtx, loader),
+		getExternalStateAccountPermissionTestCase(providerCtx, loader),
+		getExternalStatePermissionStatementMissingTestCase(providerCtx, loader),
+		getExternalStatePermissionPolicyNotFoundTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func getExternalStateServicePermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Id": "default",
+		"Statement": [
+			{
+				"Sid": "allow-sns-invoke",
+				"Effect": "Allow",
+				"Principal": {"Service": "sns.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function:live"
+			},
+			{
+				"Sid": "allow-s3-invoke",
+				"Effect": "Allow",
+				"Principal": {"Service": "s3.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function:live",
+				"Condition": {
+					"StringEquals": {"AWS:SourceAccount": "123456789012"},
+					"ArnLike": {"AWS:SourceArn": "arn:aws:s3:::updated-bucket"}
+				}
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "get external state for a service principal permission with drift",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy:     aws.String(policy),
+				RevisionId: aws.String("revision-123"),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":  core.MappingNodeFromString("test-function"),
+					"qualifier":     core.MappingNodeFromString("live"),
+					"statementId":   core.MappingNodeFromString("allow-s3-invoke"),
+					"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+					"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::test-bucket"),
+					"sourceAccount": core.MappingNodeFromString("123456789012"),
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":  core.MappingNodeFromString("test-function"),
+					"qualifier":     core.MappingNodeFromString("live"),
+					"statementId":   core.MappingNodeFromString("allow-s3-invoke"),
+					"action":        core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":     core.MappingNodeFromString("s3.amazonaws.com"),
+					"sourceArn":     core.MappingNodeFromString("arn:aws:s3:::updated-bucket"),
+					"sourceAccount": core.MappingNodeFromString("123456789012"),
+					"id":            core.MappingNodeFromString("test-function:live#allow-s3-invoke"),
+				},
+			},
+		},
+	}
+}
+
+func getExternalStateAccountPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "allow-account-invoke",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::210987654321:root"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function",
+				"Condition": {
+					"StringEquals": {"aws:PrincipalOrgID": "o-abc123defg"}
+				}
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "get external state for an account principal permission",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":   core.MappingNodeFromString("test-function"),
+					"statementId":    core.MappingNodeFromString("allow-account-invoke"),
+					"action":         core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":      core.MappingNodeFromString("210987654321"),
+					"principalOrgID": core.MappingNodeFromString("o-abc123defg"),
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName":   core.MappingNodeFromString("test-function"),
+					"statementId":    core.MappingNodeFromString("allow-account-invoke"),
+					"action":         core.MappingNodeFromString("lambda:InvokeFunction"),
+					"principal":      core.MappingNodeFromString("210987654321"),
+					"principalOrgID": core.MappingNodeFromString("o-abc123defg"),
+					"id":             core.MappingNodeFromString("test-function#allow-account-invoke"),
+				},
+			},
+		},
+	}
+}
+
+func getExternalStatePermissionStatementMissingTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "other-statement",
+				"Effect": "Allow",
+				"Principal": {"Service": "sns.amazonaws.com"},
+				"Action": "lambda:InvokeFunction",
+				"Resource": "arn:aws:lambda:us-west-2:123456789012:function:test-function"
+			}
+		]
+	}`
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "get external state for a permission removed from the function policy",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(policy),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+		},
+	}
+}
+
+func getExternalStatePermissionPolicyNotFoundTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "get external state for a function without a policy",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyError(&smithy.GenericAPIError{
+				Code:    "ResourceNotFoundException",
+				Message: "The resource you requested does not exist.",
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"functionName": core.MappingNodeFromString("test-function"),
+					"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+		},
+	}
+}
+
+func TestLambdaPermissionResourceGetExternalState(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceGetExternalStateSuite))
+}
@@ -0,0 +1,94 @@
+package lambda
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func lambdaPermissionResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LambdaPermissionDefinition",
+		Description: "The definition of a statement in the resource-based policy of an AWS Lambda function.",
+		Required:    []string{"functionName", "statementId", "action", "principal"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"functionName": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name or ARN of the Lambda function, version, or alias.",
+				FormattedDescription: "The name or ARN of the Lambda function, version, or alias.\n\n" +
+					"**Name formats**\n" +
+					"- **Function name** – `my-function` (name-only), `my-function:v1` (with alias).\n" +
+					"- **Function ARN** – `arn:aws:lambda:us-west-2:123456789012:function:my-function`.\n" +
+					"- **Partial ARN** – `123456789012:function:my-function`.",
+				Pattern:      "^(arn:(aws[a-zA-Z-]*)?:lambda:)?([a-z]{2}((-gov)|(-iso([a-z]?)))?-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?$",
+				MinLength:    1,
+				MaxLength:    140,
+				MustRecreate: true,
+			},
+			"statementId": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "A statement identifier that differentiates the statement from others in the same policy.",
+				Pattern:      "^([a-zA-Z0-9-_]+)$",
+				MinLength:    1,
+				MaxLength:    100,
+				MustRecreate: true,
+			},
+			"action": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The action that the principal can use on the function. For example, `lambda:InvokeFunction` or `lambda:GetFunction`.",
+				Pattern:     "^(lambda:[*]|lambda:[a-zA-Z]+|[*])$",
+			},
+			"principal": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The AWS service, AWS account, IAM user, or IAM role that invokes the function. " +
+					"If you specify a service, use sourceArn or sourceAccount to limit who can invoke the function through that service.",
+				Pattern: "^[^\\s]+$",
+			},
+			"sourceArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "For AWS services, the ARN of the AWS resource that invokes the function. " +
+					"For example, an Amazon S3 bucket or Amazon SNS topic.",
+				FormattedDescription: "For AWS services, the ARN of the AWS resource that invokes the function. " +
+					"For example, an Amazon S3 bucket or Amazon SNS topic.\n\n" +
+					"Note that Lambda configures the comparison using the `StringLike` operator.",
+				Pattern: "^arn:(aws[a-zA-Z0-9-]*):([a-zA-Z0-9\\-])+:([a-z]{2}((-gov)|(-iso([a-z]?)))?-[a-z]+-\\d{1})?:(\\d{12})?:(.*)$",
+			},
+			"sourceAccount": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "For AWS services, the ID of the AWS account that owns the resource. " +
+					"Use this together with sourceArn to ensure that the specified account owns the resource.",
+				Pattern:   "^\\d{12}$",
+				MaxLength: 12,
+			},
+			"principalOrgID": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The identifier for your organization in AWS Organizations. " +
+					"Use this to grant permissions to all the AWS accounts under this organization.",
+				Pattern:   "^o-[a-z0-9]{10,32}$",
+				MinLength: 12,
+				MaxLength: 34,
+			},
+			"functionUrlAuthType": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The type of authentication that your function URL uses. " +
+					"Set to AWS_IAM if you want to restrict access to authenticated users only. " +
+					"Set to NONE if you want to bypass IAM authentication to create a public endpoint.",
+				Pattern: "^(AWS_IAM|NONE)$",
+			},
+			"qualifier": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "Specify a version or alias to add permissions to a published version of the function.",
+				Pattern:      "^(|[a-zA-Z0-9$_-]+)$",
+				MinLength:    1,
+				MaxLength:    128,
+				MustRecreate: true,
+			},
+			"id": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The computed unique identifier combining the function name, qualifier and statement ID.",
+				FormattedDescription: "The computed unique identifier combining the function name, qualifier and statement ID. " +
+					"An example of this would be `arn:aws:lambda:us-west-2:123456789012:function:my-function:live#allow-s3-invoke`",
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	functionName, hasFunctionName := pluginutils.GetValueByPath(
+		"$.functionName",
+		input.ResourceSpec,
+	)
+	statementId, hasStatementId := pluginutils.GetValueByPath(
+		"$.statementId",
+		input.ResourceSpec,
+	)
+	if !hasFunctionName || !hasStatementId {
+		return nil, fmt.Errorf("functionName and statementId must be defined in the resource spec")
+	}
+
+	getPolicyInput := &lambda.GetPolicyInput{
+		FunctionName: aws.String(core.StringValue(functionName)),
+	}
+	qualifier, hasQualifier := pluginutils.GetValueByPath(
+		"$.qualifier",
+		input.ResourceSpec,
+	)
+	if hasQualifier && core.StringValue(qualifier) != "" {
+		getPolicyInput.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	getPolicyOutput, err := lambdaService.GetPolicy(ctx, getPolicyInput)
+	if err != nil {
+		var apiError smithy.APIError
+		if errors.As(err, &apiError) && apiError.ErrorCode() == "ResourceNotFoundException" {
+			// The function policy may not be visible immediately after
+			// the permission has been added.
+			return &provider.ResourceHasStabilisedOutput{
+				Stabilised: false,
+			}, nil
+		}
+		return nil, err
+	}
+
+	statement, err := findFunctionPolicyStatement(
+		aws.ToString(getPolicyOutput.Policy),
+		core.StringValue(statementId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: statement != nil,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceStabilisedSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceStabilisedSuite) Test_stabilised_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		stabilisedPermissionTestCase(providerCtx, loader),
+		stabilisedPermissionStatementMissingTestCase(providerCtx, loader),
+		stabilisedPermissionPolicyNotFoundTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func stabilisedPermissionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "permission is stabilised when the statement is in the function policy",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(
+					`{"Version":"2012-10-17","Statement":[{"Sid":"allow-s3-invoke","Effect":"Allow",` +
+						`"Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction"}]}`,
+				),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			InstanceID:      "test-instance-id",
+			ResourceID:      "test-permission-id",
+			ResourceSpec:    createTestPermissionSpec(),
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: true,
+		},
+	}
+}
+
+func stabilisedPermissionStatementMissingTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "permission is not stabilised when the statement is not yet in the function policy",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyOutput(&lambda.GetPolicyOutput{
+				Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			InstanceID:      "test-instance-id",
+			ResourceID:      "test-permission-id",
+			ResourceSpec:    createTestPermissionSpec(),
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		},
+	}
+}
+
+func stabilisedPermissionPolicyNotFoundTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service] {
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "permission is not stabilised when the function policy is not yet available",
+		ServiceFactory: lambdamock.CreateLambdaServiceMockFactory(
+			lambdamock.WithGetPolicyError(&smithy.GenericAPIError{
+				Code:    "ResourceNotFoundException",
+				Message: "The resource you requested does not exist.",
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			InstanceID:      "test-instance-id",
+			ResourceID:      "test-permission-id",
+			ResourceSpec:    createTestPermissionSpec(),
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceHasStabilisedOutput{
+			Stabilised: false,
+		},
+	}
+}
+
+func createTestPermissionSpec() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"statementId":  core.MappingNodeFromString("allow-s3-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("s3.amazonaws.com"),
+		},
+	}
+}
+
+func TestLambdaPermissionResourceStabilised(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceStabilisedSuite))
+}
@@ -0,0 +1,71 @@
+package lambda
+
+import (
+	"context"
+
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *lambdaPermissionResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	lambdaService, err := l.getLambdaService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Statements in a function policy can not be modified in place,
+	// so the existing statement is removed and a new statement is added
+	// with the same statement ID.
+	// The function name, qualifier and statement ID can not be changed
+	// without recreating the resource.
+	updateOperations := []pluginutils.SaveOperation[lambdaservice.Service]{
+		&permissionRemove{},
+		&permissionAdd{},
+	}
+
+	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{
+				"permissionHasChanges": permissionChangesPresent(input.Changes),
+			},
+		},
+		updateOperations,
+		input,
+		lambdaService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSavedValues {
+		currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+		idValue, _ := pluginutils.GetValueByPath("$.id", currentStateSpecData)
+		return &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": idValue,
+			},
+		}, nil
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
+
+func permissionChangesPresent(changes *provider.Changes) bool {
+	if changes == nil {
+		return false
+	}
+
+	return len(changes.ModifiedFields) > 0 ||
+		len(changes.NewFields) > 0 ||
+		len(changes.RemovedFields) > 0
+}
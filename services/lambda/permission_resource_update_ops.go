@@ -0,0 +1,89 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/smithy-go"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+type permissionRemove struct {
+	input *lambda.RemovePermissionInput
+}
+
+func (u *permissionRemove) Name() string {
+	return "remove permission"
+}
+
+func (u *permissionRemove) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, err := specToRemovePermissionInput(specData)
+	if err != nil {
+		return false, saveOpCtx, err
+	}
+	u.input = input
+	return permissionHasChanges(saveOpCtx), saveOpCtx, nil
+}
+
+func (u *permissionRemove) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	lambdaService lambdaservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	err := removePermission(ctx, lambdaService, u.input)
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	return saveOpCtx, nil
+}
+
+func specToRemovePermissionInput(
+	specData *core.MappingNode,
+) (*lambda.RemovePermissionInput, error) {
+	functionName, hasFunctionName := pluginutils.GetValueByPath("$.functionName", specData)
+	statementId, hasStatementId := pluginutils.GetValueByPath("$.statementId", specData)
+	if !hasFunctionName || !hasStatementId {
+		return nil, errors.New("functionName and statementId are required")
+	}
+
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(core.StringValue(functionName)),
+		StatementId:  aws.String(core.StringValue(statementId)),
+	}
+
+	qualifier, hasQualifier := pluginutils.GetValueByPath("$.qualifier", specData)
+	if hasQualifier && core.StringValue(qualifier) != "" {
+		input.Qualifier = aws.String(core.StringValue(qualifier))
+	}
+
+	return input, nil
+}
+
+// removePermission removes a statement from a function policy,
+// a statement that has already been removed is not treated as an error.
+func removePermission(
+	ctx context.Context,
+	lambdaService lambdaservice.Service,
+	input *lambda.RemovePermissionInput,
+) error {
+	_, err := lambdaService.RemovePermission(ctx, input)
+	if err != nil {
+		var apiError smithy.APIError
+		if errors.As(err, &apiError) && apiError.ErrorCode() == "ResourceNotFoundException" {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
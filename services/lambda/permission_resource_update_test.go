@@ -0,0 +1,219 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	lambdamock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/lambda_mock"
+	lambdaservice "github.com/newstack-cloud/bluelink-provider-aws/services/lambda/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LambdaPermissionResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LambdaPermissionResourceUpdateSuite) Test_update_lambda_permission() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		updatePermissionSourceArnTestCase(providerCtx, loader),
+		updatePermissionNoChangesTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		PermissionResource,
+		&s.Suite,
+	)
+}
+
+func updatePermissionSourceArnTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock(
+		lambdamock.WithRemovePermissionOutput(&lambda.RemovePermissionOutput{}),
+		lambdamock.WithAddPermissionOutput(&lambda.AddPermissionOutput{}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"qualifier":    core.MappingNodeFromString("live"),
+			"statementId":  core.MappingNodeFromString("allow-sns-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+			"sourceArn":    core.MappingNodeFromString("arn:aws:sns:us-west-2:123456789012:new-topic"),
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"qualifier":    core.MappingNodeFromString("live"),
+			"statementId":  core.MappingNodeFromString("allow-sns-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+			"sourceArn":    core.MappingNodeFromString("arn:aws:sns:us-west-2:123456789012:old-topic"),
+			"id":           core.MappingNodeFromString("test-function:live#allow-sns-invoke"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update permission source ARN replaces the statement",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-permission-id",
+						Name:       "TestPermission",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.sourceArn",
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function:live#allow-sns-invoke"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"RemovePermission": &lambda.RemovePermissionInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("live"),
+				StatementId:  aws.String("allow-sns-invoke"),
+			},
+			"AddPermission": &lambda.AddPermissionInput{
+				FunctionName: aws.String("test-function"),
+				Qualifier:    aws.String("live"),
+				StatementId:  aws.String("allow-sns-invoke"),
+				Action:       aws.String("lambda:InvokeFunction"),
+				Principal:    aws.String("sns.amazonaws.com"),
+				SourceArn:    aws.String("arn:aws:sns:us-west-2:123456789012:new-topic"),
+			},
+		},
+	}
+}
+
+func updatePermissionNoChangesTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service] {
+	service := lambdamock.CreateLambdaServiceMock()
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"statementId":  core.MappingNodeFromString("allow-sns-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+		},
+	}
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"functionName": core.MappingNodeFromString("test-function"),
+			"statementId":  core.MappingNodeFromString("allow-sns-invoke"),
+			"action":       core.MappingNodeFromString("lambda:InvokeFunction"),
+			"principal":    core.MappingNodeFromString("sns.amazonaws.com"),
+			"id":           core.MappingNodeFromString("test-function#allow-sns-invoke"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, lambdaservice.Service]{
+		Name: "update permission with no changes",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) lambdaservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDeployInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-permission-id",
+			Changes: &provider.Changes{
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceID:   "test-permission-id",
+					ResourceName: "TestPermission",
+					InstanceID:   "test-instance-id",
+					CurrentResourceState: &state.ResourceState{
+						ResourceID: "test-permission-id",
+						Name:       "TestPermission",
+						InstanceID: "test-instance-id",
+						SpecData:   currentStateSpecData,
+					},
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{
+							Value: "aws/lambda/permission",
+						},
+						Spec: specData,
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString("test-function#allow-sns-invoke"),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"RemovePermission",
+			"AddPermission",
+		},
+	}
+}
+
+func TestLambdaPermissionResourceUpdate(t *testing.T) {
+	suite.Run(t, new(LambdaPermissionResourceUpdateSuite))
+}
@@ -0,0 +1,217 @@
+package lambda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// runtimeLifecycle holds the dates at which AWS deprecates a Lambda runtime
+// and then blocks creating and updating functions that use it.
+// A zero date means that AWS has not yet announced the date.
+type runtimeLifecycle struct {
+	runtime     string
+	deprecation time.Time
+	blockCreate time.Time
+	blockUpdate time.Time
+}
+
+// runtimeDeprecationWarningWindow is how long before the deprecation date
+// a runtime starts to be reported as approaching deprecation,
+// this matches the notice period that AWS gives for runtime deprecations.
+const runtimeDeprecationWarningWindow = 180 * 24 * time.Hour
+
+// lambdaRuntimes is the lifecycle table for all Lambda runtime identifiers,
+// sourced from https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html.
+// This must be updated when AWS adds a runtime or announces or changes
+// deprecation dates.
+var lambdaRuntimes = []runtimeLifecycle{
+	{runtime: "nodejs", deprecation: runtimeDate(2016, 10, 31), blockCreate: runtimeDate(2016, 10, 31), blockUpdate: runtimeDate(2016, 10, 31)},
+	{runtime: "nodejs4.3", deprecation: runtimeDate(2020, 3, 5), blockCreate: runtimeDate(2020, 3, 5), blockUpdate: runtimeDate(2020, 3, 5)},
+	{runtime: "nodejs4.3-edge", deprecation: runtimeDate(2019, 4, 30), blockCreate: runtimeDate(2019, 4, 30), blockUpdate: runtimeDate(2019, 4, 30)},
+	{runtime: "nodejs6.10", deprecation: runtimeDate(2019, 8, 12), blockCreate: runtimeDate(2019, 8, 12), blockUpdate: runtimeDate(2019, 8, 12)},
+	{runtime: "nodejs8.10", deprecation: runtimeDate(2020, 3, 6), blockCreate: runtimeDate(2020, 3, 6), blockUpdate: runtimeDate(2020, 3, 6)},
+	{runtime: "nodejs10.x", deprecation: runtimeDate(2021, 7, 30), blockCreate: runtimeDate(2021, 7, 30), blockUpdate: runtimeDate(2022, 2, 14)},
+	{runtime: "nodejs12.x", deprecation: runtimeDate(2023, 3, 31), blockCreate: runtimeDate(2023, 3, 31), blockUpdate: runtimeDate(2023, 4, 30)},
+	{runtime: "nodejs14.x", deprecation: runtimeDate(2023, 12, 4), blockCreate: runtimeDate(2024, 1, 9), blockUpdate: runtimeDate(2024, 2, 8)},
+	{runtime: "nodejs16.x", deprecation: runtimeDate(2024, 6, 12), blockCreate: runtimeDate(2025, 10, 1), blockUpdate: runtimeDate(2025, 11, 1)},
+	{runtime: "nodejs18.x", deprecation: runtimeDate(2025, 9, 1), blockCreate: runtimeDate(2026, 2, 3), blockUpdate: runtimeDate(2026, 3, 9)},
+	{runtime: "nodejs20.x", deprecation: runtimeDate(2026, 4, 30), blockCreate: runtimeDate(2026, 6, 1), blockUpdate: runtimeDate(2026, 7, 1)},
+	{runtime: "nodejs22.x", deprecation: runtimeDate(2027, 4, 30), blockCreate: runtimeDate(2027, 6, 1), blockUpdate: runtimeDate(2027, 7, 1)},
+	{runtime: "java8", deprecation: runtimeDate(2024, 1, 8), blockCreate: runtimeDate(2024, 2, 8), blockUpdate: runtimeDate(2025, 2, 28)},
+	{runtime: "java8.al2", deprecation: runtimeDate(2026, 6, 30), blockCreate: runtimeDate(2026, 7, 31), blockUpdate: runtimeDate(2026, 8, 31)},
+	{runtime: "java11", deprecation: runtimeDate(2026, 6, 30), blockCreate: runtimeDate(2026, 7, 31), blockUpdate: runtimeDate(2026, 8, 31)},
+	{runtime: "java17", deprecation: runtimeDate(2026, 6, 30), blockCreate: runtimeDate(2026, 7, 31), blockUpdate: runtimeDate(2026, 8, 31)},
+	{runtime: "java21", deprecation: runtimeDate(2029, 6, 30), blockCreate: runtimeDate(2029, 7, 31), blockUpdate: runtimeDate(2029, 8, 31)},
+	{runtime: "python2.7", deprecation: runtimeDate(2021, 7, 15), blockCreate: runtimeDate(2021, 7, 15), blockUpdate: runtimeDate(2022, 5, 30)},
+	{runtime: "python3.6", deprecation: runtimeDate(2022, 7, 18), blockCreate: runtimeDate(2022, 7, 18), blockUpdate: runtimeDate(2022, 8, 29)},
+	{runtime: "python3.7", deprecation: runtimeDate(2023, 12, 4), blockCreate: runtimeDate(2024, 1, 9), blockUpdate: runtimeDate(2024, 2, 8)},
+	{runtime: "python3.8", deprecation: runtimeDate(2024, 10, 14), blockCreate: runtimeDate(2025, 2, 28), blockUpdate: runtimeDate(2025, 3, 31)},
+	{runtime: "python3.9", deprecation: runtimeDate(2025, 12, 15), blockCreate: runtimeDate(2026, 6, 1), blockUpdate: runtimeDate(2026, 7, 1)},
+	{runtime: "python3.10", deprecation: runtimeDate(2026, 6, 30), blockCreate: runtimeDate(2026, 7, 31), blockUpdate: runtimeDate(2026, 8, 31)},
+	{runtime: "python3.11", deprecation: runtimeDate(2027, 6, 30), blockCreate: runtimeDate(2027, 7, 31), blockUpdate: runtimeDate(2027, 8, 31)},
+	{runtime: "python3.12", deprecation: runtimeDate(2028, 10, 31), blockCreate: runtimeDate(2028, 11, 30), blockUpdate: runtimeDate(2029, 1, 10)},
+	{runtime: "python3.13", deprecation: runtimeDate(2029, 6, 30), blockCreate: runtimeDate(2029, 7, 31), blockUpdate: runtimeDate(2029, 8, 31)},
+	{runtime: "dotnetcore1.0", deprecation: runtimeDate(2019, 7, 30), blockCreate: runtimeDate(2019, 7, 30), blockUpdate: runtimeDate(2019, 7, 30)},
+	{runtime: "dotnetcore2.0", deprecation: runtimeDate(2019, 5, 30), blockCreate: runtimeDate(2019, 5, 30), blockUpdate: runtimeDate(2019, 5, 30)},
+	{runtime: "dotnetcore2.1", deprecation: runtimeDate(2022, 1, 5), blockCreate: runtimeDate(2022, 1, 5), blockUpdate: runtimeDate(2022, 4, 13)},
+	{runtime: "dotnetcore3.1", deprecation: runtimeDate(2023, 4, 3), blockCreate: runtimeDate(2023, 4, 3), blockUpdate: runtimeDate(2023, 5, 3)},
+	{runtime: "dotnet6", deprecation: runtimeDate(2024, 12, 20), blockCreate: runtimeDate(2025, 2, 10), blockUpdate: runtimeDate(2025, 3, 12)},
+	{runtime: "dotnet7", deprecation: runtimeDate(2024, 5, 14), blockCreate: runtimeDate(2024, 6, 14), blockUpdate: runtimeDate(2024, 7, 15)},
+	{runtime: "dotnet8", deprecation: runtimeDate(2026, 11, 10), blockCreate: runtimeDate(2026, 12, 10), blockUpdate: runtimeDate(2027, 1, 11)},
+	{runtime: "go1.x", deprecation: runtimeDate(2024, 1, 8), blockCreate: runtimeDate(2024, 2, 8), blockUpdate: runtimeDate(2025, 2, 28)},
+	{runtime: "ruby2.5", deprecation: runtimeDate(2021, 7, 30), blockCreate: runtimeDate(2021, 7, 30), blockUpdate: runtimeDate(2022, 3, 31)},
+	{runtime: "ruby2.7", deprecation: runtimeDate(2023, 12, 7), blockCreate: runtimeDate(2024, 1, 9), blockUpdate: runtimeDate(2024, 2, 8)},
+	{runtime: "ruby3.2", deprecation: runtimeDate(2026, 3, 31), blockCreate: runtimeDate(2026, 6, 1), blockUpdate: runtimeDate(2026, 7, 1)},
+	{runtime: "ruby3.3", deprecation: runtimeDate(2027, 3, 31), blockCreate: runtimeDate(2027, 4, 30), blockUpdate: runtimeDate(2027, 5, 31)},
+	{runtime: "ruby3.4", deprecation: runtimeDate(2028, 3, 31), blockCreate: runtimeDate(2028, 4, 30), blockUpdate: runtimeDate(2028, 5, 31)},
+	{runtime: "provided", deprecation: runtimeDate(2024, 1, 8), blockCreate: runtimeDate(2024, 2, 8), blockUpdate: runtimeDate(2025, 2, 28)},
+	{runtime: "provided.al2", deprecation: runtimeDate(2026, 6, 30), blockCreate: runtimeDate(2026, 7, 31), blockUpdate: runtimeDate(2026, 8, 31)},
+	{runtime: "provided.al2023", deprecation: runtimeDate(2029, 6, 30), blockCreate: runtimeDate(2029, 7, 31), blockUpdate: runtimeDate(2029, 8, 31)},
+}
+
+func runtimeDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func lambdaRuntimeAllowedValues() []*core.MappingNode {
+	allowedValues := make([]*core.MappingNode, 0, len(lambdaRuntimes))
+	for _, lifecycle := range lambdaRuntimes {
+		allowedValues = append(allowedValues, core.MappingNodeFromString(lifecycle.runtime))
+	}
+	return allowedValues
+}
+
+func getRuntimeLifecycle(runtime string) (runtimeLifecycle, bool) {
+	for _, lifecycle := range lambdaRuntimes {
+		if lifecycle.runtime == runtime {
+			return lifecycle, true
+		}
+	}
+	return runtimeLifecycle{}, false
+}
+
+// validateRuntimeLifecycle creates a validation function that reports
+// a warning for runtimes that are deprecated or will be deprecated soon
+// and an error for runtimes that AWS no longer allows new functions
+// or layers to be created with.
+func validateRuntimeLifecycle(
+	clock core.Clock,
+) func(string, *core.MappingNode, *schema.Resource) []*core.Diagnostic {
+	return func(
+		path string,
+		value *core.MappingNode,
+		resource *schema.Resource,
+	) []*core.Diagnostic {
+		runtime := core.StringValue(value)
+		lifecycle, hasLifecycle := getRuntimeLifecycle(runtime)
+		if !hasLifecycle || lifecycle.deprecation.IsZero() {
+			return []*core.Diagnostic{}
+		}
+
+		diagnostic := runtimeLifecycleDiagnostic(path, runtime, lifecycle, clock.Now())
+		if diagnostic == nil {
+			return []*core.Diagnostic{}
+		}
+
+		diagnostic.Range = core.DiagnosticRangeFromSourceMeta(value.SourceMeta, nil)
+		return []*core.Diagnostic{diagnostic}
+	}
+}
+
+func runtimeLifecycleDiagnostic(
+	path string,
+	runtime string,
+	lifecycle runtimeLifecycle,
+	now time.Time,
+) *core.Diagnostic {
+	if isLifecycleDateReached(lifecycle.blockUpdate, now) {
+		return &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s runtime in %s was deprecated on %s, Lambda has blocked "+
+					"creating and updating functions that use it since %s. "+
+					"Upgrade to a supported runtime.",
+				runtime,
+				path,
+				formatLifecycleDate(lifecycle.deprecation),
+				formatLifecycleDate(lifecycle.blockUpdate),
+			),
+		}
+	}
+
+	if isLifecycleDateReached(lifecycle.blockCreate, now) {
+		return &core.Diagnostic{
+			Level: core.DiagnosticLevelError,
+			Message: fmt.Sprintf(
+				"The %s runtime in %s was deprecated on %s, Lambda has blocked "+
+					"creating functions and layers that use it since %s%s. "+
+					"Upgrade to a supported runtime.",
+				runtime,
+				path,
+				formatLifecycleDate(lifecycle.deprecation),
+				formatLifecycleDate(lifecycle.blockCreate),
+				blockUpdateSuffix(lifecycle),
+			),
+		}
+	}
+
+	if isLifecycleDateReached(lifecycle.deprecation, now) {
+		return &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"The %s runtime in %s was deprecated on %s and no longer receives "+
+					"security patches%s.",
+				runtime,
+				path,
+				formatLifecycleDate(lifecycle.deprecation),
+				blockCreateSuffix(lifecycle),
+			),
+		}
+	}
+
+	if now.Add(runtimeDeprecationWarningWindow).After(lifecycle.deprecation) {
+		return &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"The %s runtime in %s will be deprecated on %s%s.",
+				runtime,
+				path,
+				formatLifecycleDate(lifecycle.deprecation),
+				blockCreateSuffix(lifecycle),
+			),
+		}
+	}
+
+	return nil
+}
+
+func blockCreateSuffix(lifecycle runtimeLifecycle) string {
+	if lifecycle.blockCreate.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(
+		", creating functions and layers that use it will be blocked from %s",
+		formatLifecycleDate(lifecycle.blockCreate),
+	)
+}
+
+func blockUpdateSuffix(lifecycle runtimeLifecycle) string {
+	if lifecycle.blockUpdate.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(
+		" and will block updating functions that use it from %s",
+		formatLifecycleDate(lifecycle.blockUpdate),
+	)
+}
+
+func isLifecycleDateReached(lifecycleDate time.Time, now time.Time) bool {
+	return !lifecycleDate.IsZero() && !now.Before(lifecycleDate)
+}
+
+func formatLifecycleDate(lifecycleDate time.Time) string {
+	return lifecycleDate.Format(time.DateOnly)
+}
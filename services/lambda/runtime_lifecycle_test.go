@@ -0,0 +1,113 @@
+package lambda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type RuntimeLifecycleSuite struct {
+	suite.Suite
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func (c fixedClock) Since(t time.Time) time.Duration {
+	return c.now.Sub(t)
+}
+
+func (s *RuntimeLifecycleSuite) Test_validate_runtime_lifecycle() {
+	testCases := []struct {
+		name            string
+		now             time.Time
+		runtime         string
+		expectedLevel   core.DiagnosticLevel
+		expectedMessage string
+	}{
+		{
+			name:          "reports an error when updates are blocked",
+			now:           runtimeDate(2026, 10, 17),
+			runtime:       "nodejs18.x",
+			expectedLevel: core.DiagnosticLevelError,
+			expectedMessage: "The nodejs18.x runtime in $.runtime was deprecated on 2025-09-01, " +
+				"Lambda has blocked creating and updating functions that use it since 2026-03-09. " +
+				"Upgrade to a supported runtime.",
+		},
+		{
+			name:          "reports an error when creates are blocked",
+			now:           runtimeDate(2026, 2, 10),
+			runtime:       "nodejs18.x",
+			expectedLevel: core.DiagnosticLevelError,
+			expectedMessage: "The nodejs18.x runtime in $.runtime was deprecated on 2025-09-01, " +
+				"Lambda has blocked creating functions and layers that use it since 2026-02-03 " +
+				"and will block updating functions that use it from 2026-03-09. " +
+				"Upgrade to a supported runtime.",
+		},
+		{
+			name:          "reports a warning when the runtime is deprecated",
+			now:           runtimeDate(2025, 9, 1),
+			runtime:       "nodejs18.x",
+			expectedLevel: core.DiagnosticLevelWarning,
+			expectedMessage: "The nodejs18.x runtime in $.runtime was deprecated on 2025-09-01 and no longer " +
+				"receives security patches, creating functions and layers that use it will be blocked from 2026-02-03.",
+		},
+		{
+			name:          "reports a warning when deprecation is approaching",
+			now:           runtimeDate(2025, 6, 1),
+			runtime:       "nodejs18.x",
+			expectedLevel: core.DiagnosticLevelWarning,
+			expectedMessage: "The nodejs18.x runtime in $.runtime will be deprecated on 2025-09-01, " +
+				"creating functions and layers that use it will be blocked from 2026-02-03.",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			validate := validateRuntimeLifecycle(fixedClock{now: tc.now})
+			diagnostics := validate(
+				"$.runtime",
+				core.MappingNodeFromString(tc.runtime),
+				&schema.Resource{},
+			)
+			s.Require().Len(diagnostics, 1)
+			s.Equal(tc.expectedLevel, diagnostics[0].Level)
+			s.Equal(tc.expectedMessage, diagnostics[0].Message)
+		})
+	}
+}
+
+func (s *RuntimeLifecycleSuite) Test_does_not_report_supported_runtimes() {
+	validate := validateRuntimeLifecycle(fixedClock{now: runtimeDate(2025, 1, 1)})
+
+	for _, runtime := range []string{"nodejs22.x", "python3.13", "java21", "an-unknown-runtime"} {
+		diagnostics := validate(
+			"$.compatibleRuntimes[0]",
+			core.MappingNodeFromString(runtime),
+			&schema.Resource{},
+		)
+		s.Empty(diagnostics, runtime)
+	}
+}
+
+func (s *RuntimeLifecycleSuite) Test_allowed_values_match_lifecycle_table() {
+	allowedValues := lambdaRuntimeAllowedValues()
+	s.Len(allowedValues, len(lambdaRuntimes))
+	for i, lifecycle := range lambdaRuntimes {
+		s.Equal(lifecycle.runtime, core.StringValue(allowedValues[i]))
+		s.False(lifecycle.blockCreate.Before(lifecycle.deprecation), lifecycle.runtime)
+		s.False(lifecycle.blockUpdate.Before(lifecycle.blockCreate), lifecycle.runtime)
+	}
+}
+
+func TestRuntimeLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(RuntimeLifecycleSuite))
+}
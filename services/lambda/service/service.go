@@ -23,6 +23,30 @@ type Service interface {
 		params *lambda.GetFunctionInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.GetFunctionOutput, error)
+	// Returns a list of Lambda functions, with the version-specific configuration of
+	// each. Lambda returns up to 50 functions per call.
+	//
+	// Set FunctionVersion to ALL to include all published versions of each function
+	// in addition to the unpublished version.
+	//
+	// The ListFunctions operation returns a subset of the FunctionConfiguration fields. To get the
+	// additional fields (State, StateReasonCode, StateReason, LastUpdateStatus,
+	// LastUpdateStatusReason, LastUpdateStatusReasonCode, RuntimeVersionConfig) for a
+	// function or version, use GetFunction.
+	ListFunctions(
+		ctx context.Context,
+		params *lambda.ListFunctionsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListFunctionsOutput, error)
+	// Returns a list of [versions], with the version-specific configuration of each.
+	// Lambda returns up to 50 versions per call.
+	//
+	// [versions]: https://docs.aws.amazon.com/lambda/latest/dg/versioning-aliases.html
+	ListVersionsByFunction(
+		ctx context.Context,
+		params *lambda.ListVersionsByFunctionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListVersionsByFunctionOutput, error)
 	// Deletes a Lambda function. To delete a specific function version, use the
 	// Qualifier parameter. Otherwise, all versions and aliases are deleted. This
 	// doesn't require the user to have explicit permissions for DeleteAlias.
@@ -62,6 +86,15 @@ type Service interface {
 		params *lambda.GetFunctionConcurrencyInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.GetFunctionConcurrencyOutput, error)
+	// Retrieves details about your account's [limits] and usage in an Amazon Web
+	// Services Region.
+	//
+	// [limits]: https://docs.aws.amazon.com/lambda/latest/dg/limits.html
+	GetAccountSettings(
+		ctx context.Context,
+		params *lambda.GetAccountSettingsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetAccountSettingsOutput, error)
 	// Modify the version-specific settings of a Lambda function.
 	//
 	// When you update a function, Lambda provisions an instance of the function and
@@ -279,6 +312,12 @@ type Service interface {
 		params *lambda.PutProvisionedConcurrencyConfigInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.PutProvisionedConcurrencyConfigOutput, error)
+	// Retrieves a list of provisioned concurrency configurations for a function.
+	ListProvisionedConcurrencyConfigs(
+		ctx context.Context,
+		params *lambda.ListProvisionedConcurrencyConfigsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListProvisionedConcurrencyConfigsOutput, error)
 	// Creates an alias for a Lambda function version. Use aliases to provide clients
 	// with a function identifier that you can update to invoke a different version.
 	// You can also map an alias to split invocation requests between two versions.
@@ -305,6 +344,14 @@ type Service interface {
 		params *lambda.DeleteAliasInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.DeleteAliasOutput, error)
+	// Returns a list of [aliases] for a Lambda function.
+	//
+	// [aliases]: https://docs.aws.amazon.com/lambda/latest/dg/configuration-aliases.html
+	ListAliases(
+		ctx context.Context,
+		params *lambda.ListAliasesInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListAliasesOutput, error)
 	// Creates a code signing configuration. A code signing configuration defines a list of allowed signing profiles and defines the code-signing validation policy (action to be taken if deployment validation checks fail).
 	CreateCodeSigningConfig(
 		ctx context.Context,
@@ -353,6 +400,12 @@ type Service interface {
 		params *lambda.UpdateEventSourceMappingInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.UpdateEventSourceMappingOutput, error)
+	// Lists event source mappings. Specify an EventSourceArn to show only event source mappings for a single event source.
+	ListEventSourceMappings(
+		ctx context.Context,
+		params *lambda.ListEventSourceMappingsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListEventSourceMappingsOutput, error)
 	// Deletes an event source mapping. You can get the identifier of a mapping from the output of ListEventSourceMappings.
 	DeleteEventSourceMapping(
 		ctx context.Context,
@@ -399,6 +452,18 @@ type Service interface {
 		params *lambda.GetLayerVersionInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.GetLayerVersionOutput, error)
+	// Lists the versions of an [Lambda layer]. Versions that have been deleted aren't
+	// listed. Specify a [runtime identifier] to list only versions that indicate that
+	// they're compatible with that runtime. Specify a compatible architecture to
+	// include only layer versions that are compatible with that architecture.
+	//
+	// [runtime identifier]: https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html
+	// [Lambda layer]: https://docs.aws.amazon.com/lambda/latest/dg/invocation-layers.html
+	ListLayerVersions(
+		ctx context.Context,
+		params *lambda.ListLayerVersionsInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.ListLayerVersionsOutput, error)
 	// Deletes a version of an AWS Lambda layer. Deleted versions can no longer be
 	// viewed or added to functions. To avoid breaking functions, a copy of the
 	// version remains in Lambda until no functions refer to it.
@@ -427,6 +492,30 @@ type Service interface {
 		params *lambda.RemoveLayerVersionPermissionInput,
 		optFns ...func(*lambda.Options),
 	) (*lambda.RemoveLayerVersionPermissionOutput, error)
+	// Grants a principal permission to use a function. You can apply the policy at the
+	// function level, or specify a qualifier to restrict access to a single version
+	// or alias. If you use a qualifier, the invoker must use the full Amazon Resource
+	// Name (ARN) of that version or alias to invoke the function.
+	AddPermission(
+		ctx context.Context,
+		params *lambda.AddPermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.AddPermissionOutput, error)
+	// Revokes function-use permission from an Amazon Web Services service or another
+	// Amazon Web Services account. You can get the ID of the statement from the output of GetPolicy.
+	RemovePermission(
+		ctx context.Context,
+		params *lambda.RemovePermissionInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.RemovePermissionOutput, error)
+	// Returns the [resource-based IAM policy] for a function, version, or alias.
+	//
+	// [resource-based IAM policy]: https://docs.aws.amazon.com/lambda/latest/dg/access-control-resource-based.html
+	GetPolicy(
+		ctx context.Context,
+		params *lambda.GetPolicyInput,
+		optFns ...func(*lambda.Options),
+	) (*lambda.GetPolicyOutput, error)
 	// Configures options for asynchronous invocation on a function, version, or alias. If a configuration already exists for a function, version, or alias, this operation overwrites it. If you exclude any settings, they are removed. To set one option without affecting existing settings for other options, use UpdateFunctionEventInvokeConfig.
 	PutFunctionEventInvokeConfig(
 		ctx context.Context,
@@ -112,6 +112,9 @@ type functionRuntimeManagementConfigUpdate struct {
 	path                 string
 	fieldChangesPathRoot string
 	input                *lambda.PutRuntimeManagementConfigInput
+	// waiter is optional and is used to sequence the operation
+	// after other in-progress updates to the function.
+	waiter *functionUpdateWaiter
 }
 
 func (u *functionRuntimeManagementConfigUpdate) Name() string {
@@ -144,7 +147,15 @@ func (u *functionRuntimeManagementConfigUpdate) Execute(
 	saveOpCtx pluginutils.SaveOperationContext,
 	lambdaService lambdaservice.Service,
 ) (pluginutils.SaveOperationContext, error) {
-	_, err := lambdaService.PutRuntimeManagementConfig(ctx, u.input)
+	err := u.waiter.retryOnConflict(
+		ctx,
+		lambdaService,
+		aws.ToString(u.input.FunctionName),
+		func() error {
+			_, err := lambdaService.PutRuntimeManagementConfig(ctx, u.input)
+			return err
+		},
+	)
 	return saveOpCtx, err
 }
 
@@ -253,6 +264,12 @@ func extractComputedFieldsFromFunctionConfig(
 			aws.ToString(functionConfiguration.FunctionArn),
 		)
 
+		if functionConfiguration.CodeSha256 != nil {
+			fields["spec.codeSha256"] = core.MappingNodeFromString(
+				aws.ToString(functionConfiguration.CodeSha256),
+			)
+		}
+
 		if functionConfiguration.SnapStart != nil {
 			fields["spec.snapStartResponseApplyOn"] = core.MappingNodeFromString(
 				string(functionConfiguration.SnapStart.ApplyOn),
@@ -0,0 +1,6 @@
+package logs
+
+import "embed"
+
+//go:embed examples/*
+var examples embed.FS
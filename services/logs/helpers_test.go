@@ -0,0 +1,112 @@
+package logs
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	testLogGroupName = "/orders/api"
+	testLogGroupARN  = "arn:aws:logs:us-west-2:123456789012:log-group:/orders/api"
+	testKMSKeyARN    = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+)
+
+func createTestDeployInput(
+	resourceType string,
+	resourceName string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	input := &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-resource-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-resource-id",
+				ResourceName: resourceName,
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: resourceType,
+					},
+					Spec: specData,
+				},
+			},
+		},
+		ProviderContext: providerCtx,
+	}
+
+	if currentStateSpecData != nil {
+		input.Changes.AppliedResourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-resource-id",
+			Name:       resourceName,
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+	}
+
+	return input
+}
+
+func createTestDescribeLogGroupsOutput(logGroup types.LogGroup) *cloudwatchlogs.DescribeLogGroupsOutput {
+	return &cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []types.LogGroup{
+			{
+				// A log group that shares the prefix of the requested log group
+				// to ensure the log group with the exact name is selected.
+				LogGroupName: aws.String(aws.ToString(logGroup.LogGroupName) + "-archive"),
+				LogGroupArn:  aws.String(aws.ToString(logGroup.LogGroupArn) + "-archive"),
+			},
+			logGroup,
+		},
+	}
+}
+
+func createTestMetricFilterSpecData(filterPattern string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName":  core.MappingNodeFromString(testLogGroupName),
+			"filterName":    core.MappingNodeFromString("ErrorCount"),
+			"filterPattern": core.MappingNodeFromString(filterPattern),
+			"metricTransformations": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"metricName":      core.MappingNodeFromString("Errors"),
+							"metricNamespace": core.MappingNodeFromString("Orders/API"),
+							"metricValue":     core.MappingNodeFromString("1"),
+							"defaultValue":    core.MappingNodeFromFloat(0),
+							"unit":            core.MappingNodeFromString("Count"),
+							"dimensions": {
+								Fields: map[string]*core.MappingNode{
+									"Operation": core.MappingNodeFromString("$.operation"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestMetricTransformations() []types.MetricTransformation {
+	return []types.MetricTransformation{
+		{
+			MetricName:      aws.String("Errors"),
+			MetricNamespace: aws.String("Orders/API"),
+			MetricValue:     aws.String("1"),
+			DefaultValue:    aws.Float64(0),
+			Unit:            types.StandardUnitCount,
+			Dimensions: map[string]string{
+				"Operation": "$.operation",
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+)
+
+// findLogGroup retrieves the log group with the exact provided name,
+// DescribeLogGroups only supports filtering by a name prefix so the results
+// are paged through until the log group with a matching name is found.
+func findLogGroup(
+	ctx context.Context,
+	logsService logsservice.Service,
+	logGroupName string,
+) (*types.LogGroup, error) {
+	var nextToken *string
+	for {
+		output, err := logsService.DescribeLogGroups(
+			ctx,
+			&cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: aws.String(logGroupName),
+				NextToken:          nextToken,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe log group %s: %w", logGroupName, err)
+		}
+
+		for _, logGroup := range output.LogGroups {
+			if aws.ToString(logGroup.LogGroupName) == logGroupName {
+				return &logGroup, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return nil, fmt.Errorf("log group %s was not found", logGroupName)
+}
+
+// EnsureLogGroupRetention creates a log group if it does not already exist
+// and sets the number of days that log events in the log group are retained.
+// This is used to manage the retention of log groups that are created
+// implicitly by AWS services, such as the default log group of a Lambda function
+// that is created when the function is first invoked.
+func EnsureLogGroupRetention(
+	ctx context.Context,
+	logsService logsservice.Service,
+	logGroupName string,
+	retentionInDays int32,
+) error {
+	_, err := logsService.CreateLogGroup(
+		ctx,
+		&cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(logGroupName),
+		},
+	)
+	if err != nil && !isResourceAlreadyExistsError(err) {
+		return fmt.Errorf("failed to create log group %s: %w", logGroupName, err)
+	}
+
+	_, err = logsService.PutRetentionPolicy(
+		ctx,
+		&cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(logGroupName),
+			RetentionInDays: aws.Int32(retentionInDays),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set retention policy for log group %s: %w", logGroupName, err)
+	}
+
+	return nil
+}
+
+func isResourceAlreadyExistsError(err error) bool {
+	var alreadyExistsErr *types.ResourceAlreadyExistsException
+	return errors.As(err, &alreadyExistsErr)
+}
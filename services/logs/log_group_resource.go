@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// LogGroupResource returns a resource implementation for an Amazon CloudWatch Logs log group.
+func LogGroupResource(
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	basicExample, _ := examples.ReadFile("examples/resources/logs_log_group_basic.md")
+	completeExample, _ := examples.ReadFile("examples/resources/logs_log_group_complete.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/logs_log_group_jsonc.md")
+
+	logGroupActions := &logsLogGroupResourceActions{
+		logsServiceFactory: logsServiceFactory,
+		awsConfigStore:     awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/logs/logGroup",
+		Label:            "Amazon CloudWatch Logs Log Group",
+		PlainTextSummary: "A resource for managing an Amazon CloudWatch Logs log group.",
+		FormattedDescription: "The resource type used to define an [Amazon CloudWatch Logs log group](https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/Working-with-log-groups-and-streams.html) " +
+			"that is deployed to AWS. Log groups that are created by AWS services such as Lambda do not expire, " +
+			"defining the log group as a resource allows the retention period, encryption key and log class to be managed.",
+		Schema:  logsLogGroupResourceSchema(),
+		IDField: "arn",
+		// A log group is not a terminal resource as it is written to by other resources
+		// such as Lambda functions and can have metric filters.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(basicExample),
+			string(completeExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: logGroupActions.GetExternalState,
+		CreateFunc:           logGroupActions.Create,
+		UpdateFunc:           logGroupActions.Update,
+		DestroyFunc:          logGroupActions.Destroy,
+		StabilisedFunc:       logGroupActions.Stabilised,
+	}
+}
+
+type logsLogGroupResourceActions struct {
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service]
+	awsConfigStore     pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *logsLogGroupResourceActions) getLogsService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (logsservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.logsServiceFactory(awsConfig, providerContext), nil
+}
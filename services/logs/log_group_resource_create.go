@@ -0,0 +1,58 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *logsLogGroupResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[logsservice.Service]{
+		&logGroupCreate{},
+		&retentionPolicyUpdate{},
+	}
+
+	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		createOperations,
+		input,
+		logsService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSavedValues {
+		return nil, fmt.Errorf("no values were saved during log group creation")
+	}
+
+	logGroup, ok := saveOpCtx.Data["logGroup"].(*types.LogGroup)
+	if !ok {
+		return nil, fmt.Errorf("logGroup not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(
+				aws.ToString(logGroup.LogGroupArn),
+			),
+		},
+	}, nil
+}
@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+type logGroupCreate struct {
+	input *cloudwatchlogs.CreateLogGroupInput
+}
+
+func (u *logGroupCreate) Name() string {
+	return "create log group"
+}
+
+func (u *logGroupCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToCreateLogGroupInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *logGroupCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	logsService logsservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	_, err := logsService.CreateLogGroup(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to create log group: %w", err)
+	}
+
+	// CreateLogGroup does not return the ARN of the new log group.
+	logGroup, err := findLogGroup(ctx, logsService, aws.ToString(u.input.LogGroupName))
+	if err != nil {
+		return saveOpCtx, err
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(logGroup.LogGroupArn)
+	newSaveOpCtx.Data["logGroup"] = logGroup
+
+	return newSaveOpCtx, nil
+}
+
+func changesToCreateLogGroupInput(
+	specData *core.MappingNode,
+) (*cloudwatchlogs.CreateLogGroupInput, bool) {
+	input := &cloudwatchlogs.CreateLogGroupInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*cloudwatchlogs.CreateLogGroupInput]{
+		pluginutils.NewValueSetter(
+			"$.logGroupName",
+			func(value *core.MappingNode, input *cloudwatchlogs.CreateLogGroupInput) {
+				input.LogGroupName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.kmsKeyId",
+			func(value *core.MappingNode, input *cloudwatchlogs.CreateLogGroupInput) {
+				input.KmsKeyId = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.logGroupClass",
+			func(value *core.MappingNode, input *cloudwatchlogs.CreateLogGroupInput) {
+				input.LogGroupClass = types.LogGroupClass(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.tags",
+			func(value *core.MappingNode, input *cloudwatchlogs.CreateLogGroupInput) {
+				input.Tags = logsTagsFromValue(value)
+			},
+		),
+	}
+
+	hasValuesToSave := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValuesToSave = hasValuesToSave || valueSetter.DidSet()
+	}
+
+	return input, hasValuesToSave
+}
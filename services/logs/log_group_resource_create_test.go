@@ -0,0 +1,214 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsLogGroupResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LogsLogGroupResourceCreateSuite) Test_create_log_group() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		createLogGroupTestCase(providerCtx, loader),
+		createLogGroupWithoutRetentionTestCase(providerCtx, loader),
+		createLogGroupFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		LogGroupResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsLogGroupResourceCreateSuite(t *testing.T) {
+	suite.Run(t, new(LogsLogGroupResourceCreateSuite))
+}
+
+func createLogGroupTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock(
+		logsmock.WithDescribeLogGroupsOutput(createTestDescribeLogGroupsOutput(types.LogGroup{
+			LogGroupName: aws.String(testLogGroupName),
+			LogGroupArn:  aws.String(testLogGroupARN),
+		})),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+			"retentionInDays": core.MappingNodeFromInt(30),
+			"kmsKeyId":        core.MappingNodeFromString(testKMSKeyARN),
+			"logGroupClass":   core.MappingNodeFromString("INFREQUENT_ACCESS"),
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("Environment"),
+							"value": core.MappingNodeFromString("Production"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "create log group",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			nil,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(testLogGroupARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateLogGroup": &cloudwatchlogs.CreateLogGroupInput{
+				LogGroupName:  aws.String(testLogGroupName),
+				KmsKeyId:      aws.String(testKMSKeyARN),
+				LogGroupClass: types.LogGroupClassInfrequentAccess,
+				Tags: map[string]string{
+					"Environment": "Production",
+				},
+			},
+			"DescribeLogGroups": &cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: aws.String(testLogGroupName),
+			},
+			"PutRetentionPolicy": &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    aws.String(testLogGroupName),
+				RetentionInDays: aws.Int32(30),
+			},
+		},
+	}
+}
+
+func createLogGroupWithoutRetentionTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock(
+		logsmock.WithDescribeLogGroupsOutput(createTestDescribeLogGroupsOutput(types.LogGroup{
+			LogGroupName: aws.String(testLogGroupName),
+			LogGroupArn:  aws.String(testLogGroupARN),
+		})),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName": core.MappingNodeFromString(testLogGroupName),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "create log group without a retention policy",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			nil,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(testLogGroupARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"CreateLogGroup": &cloudwatchlogs.CreateLogGroupInput{
+				LogGroupName: aws.String(testLogGroupName),
+			},
+		},
+		SaveActionsNotCalled: []string{"PutRetentionPolicy", "DeleteRetentionPolicy"},
+	}
+}
+
+func createLogGroupFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock(
+		logsmock.WithCreateLogGroupError(errors.New("ResourceAlreadyExistsException")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+			"retentionInDays": core.MappingNodeFromInt(30),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "handles create log group failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			nil,
+			providerCtx,
+		),
+		SaveActionsNotCalled: []string{"PutRetentionPolicy"},
+		ExpectError:          true,
+	}
+}
@@ -0,0 +1,34 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *logsLogGroupResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return fmt.Errorf("failed to get CloudWatch Logs service: %w", err)
+	}
+
+	logGroupName := core.StringValue(input.ResourceState.SpecData.Fields["logGroupName"])
+	_, err = logsService.DeleteLogGroup(
+		ctx,
+		&cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: aws.String(logGroupName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete log group: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsLogGroupResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LogsLogGroupResourceDestroySuite) Test_destroy_log_group() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service]{
+		destroyLogGroupTestCase(providerCtx, loader),
+		destroyLogGroupFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		LogGroupResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsLogGroupResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LogsLogGroupResourceDestroySuite))
+}
+
+func destroyLogGroupTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock()
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service]{
+		Name: "deletes log group",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-resource-id",
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn":          core.MappingNodeFromString(testLogGroupARN),
+						"logGroupName": core.MappingNodeFromString(testLogGroupName),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		DestroyActionsCalled: map[string]any{
+			"DeleteLogGroup": &cloudwatchlogs.DeleteLogGroupInput{
+				LogGroupName: aws.String(testLogGroupName),
+			},
+		},
+	}
+}
+
+func destroyLogGroupFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock(
+		logsmock.WithDeleteLogGroupError(errors.New("failed to delete log group")),
+	)
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service]{
+		Name: "handles log group deletion failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-resource-id",
+			ResourceState: &state.ResourceState{
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn":          core.MappingNodeFromString(testLogGroupARN),
+						"logGroupName": core.MappingNodeFromString(testLogGroupName),
+					},
+				},
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,94 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *logsLogGroupResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CloudWatch Logs service: %w", err)
+	}
+
+	logGroupName := core.StringValue(input.CurrentResourceSpec.Fields["logGroupName"])
+	logGroup, err := findLogGroup(ctx, logsService, logGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsOutput, err := logsService.ListTagsForResource(
+		ctx,
+		&cloudwatchlogs.ListTagsForResourceInput{
+			ResourceArn: logGroup.LogGroupArn,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags for log group: %w", err)
+	}
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":           core.MappingNodeFromString(aws.ToString(logGroup.LogGroupArn)),
+			"logGroupName":  core.MappingNodeFromString(aws.ToString(logGroup.LogGroupName)),
+			"logGroupClass": core.MappingNodeFromString(string(logGroup.LogGroupClass)),
+		},
+	}
+
+	err = l.addOptionalConfigurationsToSpec(logGroup, resourceSpecState.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tagsOutput.Tags) > 0 {
+		resourceSpecState.Fields["tags"] = extractLogsTags(tagsOutput.Tags)
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (l *logsLogGroupResourceActions) addOptionalConfigurationsToSpec(
+	logGroup *types.LogGroup,
+	specFields map[string]*core.MappingNode,
+) error {
+	extractors := []pluginutils.OptionalValueExtractor[*types.LogGroup]{
+		{
+			Name: "retentionInDays",
+			Condition: func(logGroup *types.LogGroup) bool {
+				return logGroup.RetentionInDays != nil
+			},
+			Fields: []string{"retentionInDays"},
+			Values: func(logGroup *types.LogGroup) ([]*core.MappingNode, error) {
+				return []*core.MappingNode{
+					core.MappingNodeFromInt(int(aws.ToInt32(logGroup.RetentionInDays))),
+				}, nil
+			},
+		},
+		{
+			Name: "kmsKeyId",
+			Condition: func(logGroup *types.LogGroup) bool {
+				return logGroup.KmsKeyId != nil
+			},
+			Fields: []string{"kmsKeyId"},
+			Values: func(logGroup *types.LogGroup) ([]*core.MappingNode, error) {
+				return []*core.MappingNode{
+					core.MappingNodeFromString(aws.ToString(logGroup.KmsKeyId)),
+				}, nil
+			},
+		},
+	}
+
+	return pluginutils.RunOptionalValueExtractors(logGroup, specFields, extractors)
+}
@@ -0,0 +1,150 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsLogGroupResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LogsLogGroupResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		getLogGroupExternalStateTestCase(providerCtx, loader),
+		getLogGroupExternalStateNotFoundTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		LogGroupResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsLogGroupResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LogsLogGroupResourceGetExternalStateSuite))
+}
+
+func getLogGroupExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		Name: "successfully gets log group state",
+		ServiceFactory: logsmock.CreateLogsServiceMockFactory(
+			logsmock.WithDescribeLogGroupsOutput(createTestDescribeLogGroupsOutput(types.LogGroup{
+				LogGroupName:    aws.String(testLogGroupName),
+				LogGroupArn:     aws.String(testLogGroupARN),
+				LogGroupClass:   types.LogGroupClassStandard,
+				RetentionInDays: aws.Int32(14),
+				KmsKeyId:        aws.String(testKMSKeyARN),
+			})),
+			logsmock.WithListTagsForResourceOutput(&cloudwatchlogs.ListTagsForResourceOutput{
+				Tags: map[string]string{
+					"Team":        "Orders",
+					"Environment": "Production",
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString(testLogGroupARN),
+					"logGroupName": core.MappingNodeFromString(testLogGroupName),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":             core.MappingNodeFromString(testLogGroupARN),
+					"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+					"logGroupClass":   core.MappingNodeFromString("STANDARD"),
+					"retentionInDays": core.MappingNodeFromInt(14),
+					"kmsKeyId":        core.MappingNodeFromString(testKMSKeyARN),
+					"tags": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("Environment"),
+									"value": core.MappingNodeFromString("Production"),
+								},
+							},
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("Team"),
+									"value": core.MappingNodeFromString("Orders"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getLogGroupExternalStateNotFoundTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		Name: "handles log group that no longer exists",
+		ServiceFactory: logsmock.CreateLogsServiceMockFactory(
+			logsmock.WithDescribeLogGroupsOutput(&cloudwatchlogs.DescribeLogGroupsOutput{
+				LogGroups: []types.LogGroup{
+					{
+						LogGroupName: aws.String(testLogGroupName + "-archive"),
+						LogGroupArn:  aws.String(testLogGroupARN + "-archive"),
+					},
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":          core.MappingNodeFromString(testLogGroupARN),
+					"logGroupName": core.MappingNodeFromString(testLogGroupName),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
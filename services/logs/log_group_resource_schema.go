@@ -0,0 +1,80 @@
+package logs
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func logsLogGroupResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LogsLogGroupDefinition",
+		Description: "The definition of an Amazon CloudWatch Logs log group.",
+		Required:    []string{"logGroupName"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"logGroupName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name of the log group. " +
+					"Lambda functions write to a log group named /aws/lambda/{functionName} by default.",
+				FormattedDescription: "The name of the log group. " +
+					"Lambda functions write to a log group named `/aws/lambda/{functionName}` by default.",
+				Pattern:      `^[\.\-_/#A-Za-z0-9]{1,512}$`,
+				MustRecreate: true,
+			},
+			"retentionInDays": {
+				Type: provider.ResourceDefinitionsSchemaTypeInteger,
+				Description: "The number of days to retain the log events in the log group. " +
+					"If not set, log events never expire.",
+				AllowedValues: RetentionInDaysAllowedValues(),
+			},
+			"kmsKeyId": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the KMS key to use when encrypting log data. " +
+					"The key policy must allow the CloudWatch Logs service principal to use the key.",
+				FormattedDescription: "The Amazon Resource Name (ARN) of the KMS key to use when encrypting log data. " +
+					"The [key policy](https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html) " +
+					"must allow the CloudWatch Logs service principal to use the key.",
+				MaxLength: 256,
+			},
+			"logGroupClass": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The log class of the log group. The INFREQUENT_ACCESS class has a lower ingestion cost " +
+					"with a reduced set of features, the DELIVERY class is only for logs delivered to other AWS services.",
+				FormattedDescription: "The [log class](https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/CloudWatch_Logs_Log_Classes.html) " +
+					"of the log group. The `INFREQUENT_ACCESS` class has a lower ingestion cost with a reduced set of features, " +
+					"the `DELIVERY` class is only for logs delivered to other AWS services.",
+				AllowedValues: []*core.MappingNode{
+					core.MappingNodeFromString("STANDARD"),
+					core.MappingNodeFromString("INFREQUENT_ACCESS"),
+					core.MappingNodeFromString("DELIVERY"),
+				},
+				Default:      core.MappingNodeFromString("STANDARD"),
+				MustRecreate: true,
+			},
+			"tags": logsSchemaTags("log group"),
+
+			// Computed fields returned by AWS
+			"arn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the log group, " +
+					"this does not include the trailing :* that is used in IAM policies.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// RetentionInDaysAllowedValues returns the retention periods in days
+// that are supported by CloudWatch Logs.
+func RetentionInDaysAllowedValues() []*core.MappingNode {
+	days := []int{
+		1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545,
+		731, 1096, 1827, 2192, 2557, 2922, 3288, 3653,
+	}
+
+	allowedValues := make([]*core.MappingNode, len(days))
+	for i, value := range days {
+		allowedValues[i] = core.MappingNodeFromInt(value)
+	}
+	return allowedValues
+}
@@ -0,0 +1,17 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *logsLogGroupResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// Log groups are ready to receive log events as soon as they have been created.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package logs
+
+import (
+	"context"
+
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *logsLogGroupResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changes to the log group name or log class cause the log group to be replaced.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	arn, _ := pluginutils.GetValueByPath("$.arn", currentStateSpecData)
+
+	updateOperations := []pluginutils.SaveOperation[logsservice.Service]{
+		&retentionPolicyUpdate{},
+		&kmsKeyUpdate{},
+		&tagsUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(arn),
+			Data:               map[string]any{},
+		},
+		updateOperations,
+		input,
+		logsService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": arn,
+		},
+	}, nil
+}
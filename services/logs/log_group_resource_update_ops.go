@@ -0,0 +1,144 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// retentionPolicyUpdate sets or removes the retention policy of a log group
+// when the retention period in the spec differs from the current state,
+// this is used when creating and updating a log group as CreateLogGroup
+// does not accept a retention period.
+type retentionPolicyUpdate struct {
+	logGroupName    string
+	retentionInDays *int32
+}
+
+func (u *retentionPolicyUpdate) Name() string {
+	return "update retention policy"
+}
+
+func (u *retentionPolicyUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	logGroupName, _ := pluginutils.GetValueByPath("$.logGroupName", specData)
+	u.logGroupName = core.StringValue(logGroupName)
+
+	retentionInDays, hasRetention := pluginutils.GetValueByPath("$.retentionInDays", specData)
+	currentRetentionInDays, hasCurrentRetention := pluginutils.GetValueByPath(
+		"$.retentionInDays",
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+	)
+
+	if hasRetention {
+		u.retentionInDays = aws.Int32(int32(core.IntValue(retentionInDays)))
+		return !hasCurrentRetention ||
+			core.IntValue(retentionInDays) != core.IntValue(currentRetentionInDays), saveOpCtx, nil
+	}
+
+	return hasCurrentRetention, saveOpCtx, nil
+}
+
+func (u *retentionPolicyUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	logsService logsservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	if u.retentionInDays == nil {
+		_, err := logsService.DeleteRetentionPolicy(
+			ctx,
+			&cloudwatchlogs.DeleteRetentionPolicyInput{
+				LogGroupName: aws.String(u.logGroupName),
+			},
+		)
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to remove retention policy: %w", err)
+		}
+		return saveOpCtx, nil
+	}
+
+	_, err := logsService.PutRetentionPolicy(
+		ctx,
+		&cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(u.logGroupName),
+			RetentionInDays: u.retentionInDays,
+		},
+	)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to set retention policy: %w", err)
+	}
+
+	return saveOpCtx, nil
+}
+
+// kmsKeyUpdate associates a new KMS key with a log group or disassociates
+// the current key when the key is removed from the spec.
+type kmsKeyUpdate struct {
+	logGroupName string
+	kmsKeyID     string
+}
+
+func (u *kmsKeyUpdate) Name() string {
+	return "update KMS key"
+}
+
+func (u *kmsKeyUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	logGroupName, _ := pluginutils.GetValueByPath("$.logGroupName", specData)
+	u.logGroupName = core.StringValue(logGroupName)
+
+	kmsKeyID, _ := pluginutils.GetValueByPath("$.kmsKeyId", specData)
+	currentKMSKeyID, _ := pluginutils.GetValueByPath(
+		"$.kmsKeyId",
+		pluginutils.GetCurrentResourceStateSpecData(changes),
+	)
+	u.kmsKeyID = core.StringValue(kmsKeyID)
+
+	return u.kmsKeyID != core.StringValue(currentKMSKeyID), saveOpCtx, nil
+}
+
+func (u *kmsKeyUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	logsService logsservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	if u.kmsKeyID == "" {
+		// Log events that were ingested while the key was associated
+		// remain encrypted with the key.
+		_, err := logsService.DisassociateKmsKey(
+			ctx,
+			&cloudwatchlogs.DisassociateKmsKeyInput{
+				LogGroupName: aws.String(u.logGroupName),
+			},
+		)
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to disassociate KMS key: %w", err)
+		}
+		return saveOpCtx, nil
+	}
+
+	_, err := logsService.AssociateKmsKey(
+		ctx,
+		&cloudwatchlogs.AssociateKmsKeyInput{
+			LogGroupName: aws.String(u.logGroupName),
+			KmsKeyId:     aws.String(u.kmsKeyID),
+		},
+	)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to associate KMS key: %w", err)
+	}
+
+	return saveOpCtx, nil
+}
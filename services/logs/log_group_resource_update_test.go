@@ -0,0 +1,251 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsLogGroupResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *LogsLogGroupResourceUpdateSuite) Test_update_log_group() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		updateLogGroupRetentionAndKMSKeyTestCase(providerCtx, loader),
+		updateLogGroupRemoveRetentionAndKMSKeyTestCase(providerCtx, loader),
+		updateLogGroupTagsTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		LogGroupResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsLogGroupResourceUpdateSuite(t *testing.T) {
+	suite.Run(t, new(LogsLogGroupResourceUpdateSuite))
+}
+
+func updateLogGroupRetentionAndKMSKeyTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock()
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":             core.MappingNodeFromString(testLogGroupARN),
+			"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+			"retentionInDays": core.MappingNodeFromInt(30),
+		},
+	}
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+			"retentionInDays": core.MappingNodeFromInt(90),
+			"kmsKeyId":        core.MappingNodeFromString(testKMSKeyARN),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "update log group retention period and KMS key",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			currentStateSpecData,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(testLogGroupARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutRetentionPolicy": &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    aws.String(testLogGroupName),
+				RetentionInDays: aws.Int32(90),
+			},
+			"AssociateKmsKey": &cloudwatchlogs.AssociateKmsKeyInput{
+				LogGroupName: aws.String(testLogGroupName),
+				KmsKeyId:     aws.String(testKMSKeyARN),
+			},
+		},
+		SaveActionsNotCalled: []string{
+			"CreateLogGroup",
+			"DeleteRetentionPolicy",
+			"DisassociateKmsKey",
+			"TagResource",
+			"UntagResource",
+		},
+	}
+}
+
+func updateLogGroupRemoveRetentionAndKMSKeyTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock()
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":             core.MappingNodeFromString(testLogGroupARN),
+			"logGroupName":    core.MappingNodeFromString(testLogGroupName),
+			"retentionInDays": core.MappingNodeFromInt(30),
+			"kmsKeyId":        core.MappingNodeFromString(testKMSKeyARN),
+		},
+	}
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName": core.MappingNodeFromString(testLogGroupName),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "remove log group retention policy and KMS key",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			currentStateSpecData,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(testLogGroupARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"DeleteRetentionPolicy": &cloudwatchlogs.DeleteRetentionPolicyInput{
+				LogGroupName: aws.String(testLogGroupName),
+			},
+			"DisassociateKmsKey": &cloudwatchlogs.DisassociateKmsKeyInput{
+				LogGroupName: aws.String(testLogGroupName),
+			},
+		},
+		SaveActionsNotCalled: []string{"PutRetentionPolicy", "AssociateKmsKey"},
+	}
+}
+
+func updateLogGroupTagsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock()
+
+	currentStateSpecData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":          core.MappingNodeFromString(testLogGroupARN),
+			"logGroupName": core.MappingNodeFromString(testLogGroupName),
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("Team"),
+							"value": core.MappingNodeFromString("Orders"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"logGroupName": core.MappingNodeFromString(testLogGroupName),
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("Environment"),
+							"value": core.MappingNodeFromString("Production"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "update log group tags",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/logGroup",
+			"OrdersLogGroup",
+			specData,
+			currentStateSpecData,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn": core.MappingNodeFromString(testLogGroupARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"TagResource": &cloudwatchlogs.TagResourceInput{
+				ResourceArn: aws.String(testLogGroupARN),
+				Tags: map[string]string{
+					"Environment": "Production",
+				},
+			},
+			"UntagResource": &cloudwatchlogs.UntagResourceInput{
+				ResourceArn: aws.String(testLogGroupARN),
+				TagKeys:     []string{"Team"},
+			},
+		},
+		SaveActionsNotCalled: []string{"PutRetentionPolicy", "DeleteRetentionPolicy"},
+	}
+}
@@ -0,0 +1,85 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// MetricFilterResource returns a resource implementation for an Amazon CloudWatch Logs metric filter.
+func MetricFilterResource(
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	basicExample, _ := examples.ReadFile("examples/resources/logs_metric_filter_basic.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/logs_metric_filter_jsonc.md")
+
+	metricFilterActions := &logsMetricFilterResourceActions{
+		logsServiceFactory: logsServiceFactory,
+		awsConfigStore:     awsConfigStore,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/logs/metricFilter",
+		Label:            "Amazon CloudWatch Logs Metric Filter",
+		PlainTextSummary: "A resource for managing a metric filter for an Amazon CloudWatch Logs log group.",
+		FormattedDescription: "The resource type used to define a [CloudWatch Logs metric filter](https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/MonitoringLogData.html) " +
+			"that extracts metric data from the log events ingested into a log group, " +
+			"the resulting CloudWatch metrics can be used to create alarms.",
+		Schema:         logsMetricFilterResourceSchema(),
+		IDField:        "id",
+		CommonTerminal: true,
+		FormattedExamples: []string{
+			string(basicExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: metricFilterActions.GetExternalState,
+		CreateFunc:           metricFilterActions.Create,
+		UpdateFunc:           metricFilterActions.Update,
+		DestroyFunc:          metricFilterActions.Destroy,
+		StabilisedFunc:       metricFilterActions.Stabilised,
+	}
+}
+
+type logsMetricFilterResourceActions struct {
+	logsServiceFactory pluginutils.ServiceFactory[*aws.Config, logsservice.Service]
+	awsConfigStore     pluginutils.ServiceConfigStore[*aws.Config]
+}
+
+func (l *logsMetricFilterResourceActions) getLogsService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (logsservice.Service, error) {
+	awsConfig, err := l.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.logsServiceFactory(awsConfig, providerContext), nil
+}
+
+// metricFilterID creates the unique identifier of a metric filter,
+// metric filter names are only unique within a log group and neither
+// log group names nor metric filter names can contain a colon.
+func metricFilterID(logGroupName string, filterName string) string {
+	return fmt.Sprintf("%s:%s", logGroupName, filterName)
+}
+
+func parseMetricFilterID(id string) (string, string, error) {
+	logGroupName, filterName, found := strings.Cut(id, ":")
+	if !found || logGroupName == "" || filterName == "" {
+		return "", "", fmt.Errorf("invalid metric filter ID format: %s", id)
+	}
+
+	return logGroupName, filterName, nil
+}
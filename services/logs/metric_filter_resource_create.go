@@ -0,0 +1,46 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *logsMetricFilterResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		[]pluginutils.SaveOperation[logsservice.Service]{
+			&metricFilterPut{},
+		},
+		input,
+		logsService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSavedValues {
+		return nil, fmt.Errorf("no values were saved during metric filter creation")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
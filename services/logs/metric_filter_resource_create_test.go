@@ -0,0 +1,121 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsMetricFilterResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *LogsMetricFilterResourceCreateSuite) Test_create_metric_filter() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		createMetricFilterTestCase(providerCtx, loader),
+		createMetricFilterFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		MetricFilterResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsMetricFilterResourceCreateSuite(t *testing.T) {
+	suite.Run(t, new(LogsMetricFilterResourceCreateSuite))
+}
+
+func createMetricFilterTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock()
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "create metric filter",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/metricFilter",
+			"ErrorCountFilter",
+			createTestMetricFilterSpecData(`{ $.level = "ERROR" }`),
+			nil,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.id": core.MappingNodeFromString(testLogGroupName + ":ErrorCount"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutMetricFilter": &cloudwatchlogs.PutMetricFilterInput{
+				LogGroupName:          aws.String(testLogGroupName),
+				FilterName:            aws.String("ErrorCount"),
+				FilterPattern:         aws.String(`{ $.level = "ERROR" }`),
+				MetricTransformations: createTestMetricTransformations(),
+			},
+		},
+	}
+}
+
+func createMetricFilterFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service] {
+	service := logsmock.CreateLogsServiceMock(
+		logsmock.WithPutMetricFilterError(errors.New("InvalidParameterException")),
+	)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, logsservice.Service]{
+		Name: "handles put metric filter failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/logs/metricFilter",
+			"ErrorCountFilter",
+			createTestMetricFilterSpecData(`{ $.level = "ERROR" }`),
+			nil,
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
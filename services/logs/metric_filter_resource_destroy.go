@@ -0,0 +1,41 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *logsMetricFilterResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return fmt.Errorf("failed to get CloudWatch Logs service: %w", err)
+	}
+
+	logGroupName, filterName, err := parseMetricFilterID(
+		core.StringValue(input.ResourceState.SpecData.Fields["id"]),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = logsService.DeleteMetricFilter(
+		ctx,
+		&cloudwatchlogs.DeleteMetricFilterInput{
+			LogGroupName: aws.String(logGroupName),
+			FilterName:   aws.String(filterName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete metric filter: %w", err)
+	}
+
+	return nil
+}
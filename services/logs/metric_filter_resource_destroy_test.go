@@ -0,0 +1,80 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsMetricFilterResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *LogsMetricFilterResourceDestroySuite) Test_destroy_metric_filter() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	service := logsmock.CreateLogsServiceMock()
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, logsservice.Service]{
+		{
+			Name: "deletes metric filter",
+			ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) logsservice.Service {
+				return service
+			},
+			ServiceMockCalls: &service.MockCalls,
+			ConfigStore: utils.NewAWSConfigStore(
+				[]string{},
+				utils.AWSConfigFromProviderContext,
+				loader,
+				utils.AWSConfigCacheKey,
+			),
+			Input: &provider.ResourceDestroyInput{
+				InstanceID: "test-instance-id",
+				ResourceID: "test-resource-id",
+				ResourceState: &state.ResourceState{
+					SpecData: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"id": core.MappingNodeFromString(testLogGroupName + ":ErrorCount"),
+						},
+					},
+				},
+				ProviderContext: providerCtx,
+			},
+			DestroyActionsCalled: map[string]any{
+				"DeleteMetricFilter": &cloudwatchlogs.DeleteMetricFilterInput{
+					LogGroupName: aws.String(testLogGroupName),
+					FilterName:   aws.String("ErrorCount"),
+				},
+			},
+		},
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		MetricFilterResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsMetricFilterResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(LogsMetricFilterResourceDestroySuite))
+}
@@ -0,0 +1,97 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *logsMetricFilterResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CloudWatch Logs service: %w", err)
+	}
+
+	id := core.StringValue(input.CurrentResourceSpec.Fields["id"])
+	logGroupName, filterName, err := parseMetricFilterID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := logsService.DescribeMetricFilters(
+		ctx,
+		&cloudwatchlogs.DescribeMetricFiltersInput{
+			LogGroupName:     aws.String(logGroupName),
+			FilterNamePrefix: aws.String(filterName),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe metric filters: %w", err)
+	}
+
+	var metricFilter *types.MetricFilter
+	for _, filter := range output.MetricFilters {
+		if aws.ToString(filter.FilterName) == filterName {
+			metricFilter = &filter
+			break
+		}
+	}
+	if metricFilter == nil {
+		return nil, fmt.Errorf("metric filter %s was not found in log group %s", filterName, logGroupName)
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"id":                     core.MappingNodeFromString(id),
+				"logGroupName":           core.MappingNodeFromString(aws.ToString(metricFilter.LogGroupName)),
+				"filterName":             core.MappingNodeFromString(aws.ToString(metricFilter.FilterName)),
+				"filterPattern":          core.MappingNodeFromString(aws.ToString(metricFilter.FilterPattern)),
+				"applyOnTransformedLogs": core.MappingNodeFromBool(metricFilter.ApplyOnTransformedLogs),
+				"metricTransformations": metricTransformationsToMappingNode(
+					metricFilter.MetricTransformations,
+				),
+			},
+		},
+	}, nil
+}
+
+func metricTransformationsToMappingNode(
+	transformations []types.MetricTransformation,
+) *core.MappingNode {
+	items := make([]*core.MappingNode, len(transformations))
+	for i, transformation := range transformations {
+		fields := map[string]*core.MappingNode{
+			"metricName":      core.MappingNodeFromString(aws.ToString(transformation.MetricName)),
+			"metricNamespace": core.MappingNodeFromString(aws.ToString(transformation.MetricNamespace)),
+			"metricValue":     core.MappingNodeFromString(aws.ToString(transformation.MetricValue)),
+		}
+
+		if transformation.DefaultValue != nil {
+			fields["defaultValue"] = core.MappingNodeFromFloat(aws.ToFloat64(transformation.DefaultValue))
+		}
+
+		if transformation.Unit != "" {
+			fields["unit"] = core.MappingNodeFromString(string(transformation.Unit))
+		}
+
+		if len(transformation.Dimensions) > 0 {
+			dimensions := map[string]*core.MappingNode{}
+			for key, value := range transformation.Dimensions {
+				dimensions[key] = core.MappingNodeFromString(value)
+			}
+			fields["dimensions"] = &core.MappingNode{Fields: dimensions}
+		}
+
+		items[i] = &core.MappingNode{Fields: fields}
+	}
+	return &core.MappingNode{Items: items}
+}
@@ -0,0 +1,123 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	logsmock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/logs_mock"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LogsMetricFilterResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *LogsMetricFilterResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		getMetricFilterExternalStateTestCase(providerCtx, loader),
+		getMetricFilterExternalStateNotFoundTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		MetricFilterResource,
+		&s.Suite,
+	)
+}
+
+func TestLogsMetricFilterResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(LogsMetricFilterResourceGetExternalStateSuite))
+}
+
+func getMetricFilterExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service] {
+	expectedSpecData := createTestMetricFilterSpecData(`{ $.level = "ERROR" }`)
+	expectedSpecData.Fields["id"] = core.MappingNodeFromString(testLogGroupName + ":ErrorCount")
+	expectedSpecData.Fields["applyOnTransformedLogs"] = core.MappingNodeFromBool(false)
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		Name: "successfully gets metric filter state",
+		ServiceFactory: logsmock.CreateLogsServiceMockFactory(
+			logsmock.WithDescribeMetricFiltersOutput(&cloudwatchlogs.DescribeMetricFiltersOutput{
+				MetricFilters: []types.MetricFilter{
+					{
+						LogGroupName:  aws.String(testLogGroupName),
+						FilterName:    aws.String("ErrorCountByService"),
+						FilterPattern: aws.String(`{ $.level = "ERROR" && $.service = * }`),
+					},
+					{
+						LogGroupName:          aws.String(testLogGroupName),
+						FilterName:            aws.String("ErrorCount"),
+						FilterPattern:         aws.String(`{ $.level = "ERROR" }`),
+						MetricTransformations: createTestMetricTransformations(),
+					},
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id": core.MappingNodeFromString(testLogGroupName + ":ErrorCount"),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: expectedSpecData,
+		},
+	}
+}
+
+func getMetricFilterExternalStateNotFoundTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, logsservice.Service]{
+		Name: "handles metric filter that no longer exists",
+		ServiceFactory: logsmock.CreateLogsServiceMockFactory(
+			logsmock.WithDescribeMetricFiltersOutput(&cloudwatchlogs.DescribeMetricFiltersOutput{}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"id": core.MappingNodeFromString(testLogGroupName + ":ErrorCount"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,129 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+// metricFilterPut creates or replaces a metric filter,
+// PutMetricFilter is used for both creating and updating metric filters.
+type metricFilterPut struct {
+	input *cloudwatchlogs.PutMetricFilterInput
+}
+
+func (u *metricFilterPut) Name() string {
+	return "put metric filter"
+}
+
+func (u *metricFilterPut) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToPutMetricFilterInput(specData)
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *metricFilterPut) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	logsService logsservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	_, err := logsService.PutMetricFilter(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to put metric filter: %w", err)
+	}
+
+	return pluginutils.SaveOperationContext{
+		ProviderUpstreamID: metricFilterID(
+			aws.ToString(u.input.LogGroupName),
+			aws.ToString(u.input.FilterName),
+		),
+		Data: saveOpCtx.Data,
+	}, nil
+}
+
+func changesToPutMetricFilterInput(
+	specData *core.MappingNode,
+) (*cloudwatchlogs.PutMetricFilterInput, bool) {
+	input := &cloudwatchlogs.PutMetricFilterInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*cloudwatchlogs.PutMetricFilterInput]{
+		pluginutils.NewValueSetter(
+			"$.logGroupName",
+			func(value *core.MappingNode, input *cloudwatchlogs.PutMetricFilterInput) {
+				input.LogGroupName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.filterName",
+			func(value *core.MappingNode, input *cloudwatchlogs.PutMetricFilterInput) {
+				input.FilterName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.filterPattern",
+			func(value *core.MappingNode, input *cloudwatchlogs.PutMetricFilterInput) {
+				input.FilterPattern = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.applyOnTransformedLogs",
+			func(value *core.MappingNode, input *cloudwatchlogs.PutMetricFilterInput) {
+				input.ApplyOnTransformedLogs = core.BoolValue(value)
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.metricTransformations",
+			func(value *core.MappingNode, input *cloudwatchlogs.PutMetricFilterInput) {
+				input.MetricTransformations = metricTransformationsFromValue(value)
+			},
+		),
+	}
+
+	hasValuesToSave := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValuesToSave = hasValuesToSave || valueSetter.DidSet()
+	}
+
+	return input, hasValuesToSave
+}
+
+func metricTransformationsFromValue(value *core.MappingNode) []types.MetricTransformation {
+	transformations := make([]types.MetricTransformation, len(value.Items))
+	for i, item := range value.Items {
+		transformation := types.MetricTransformation{
+			MetricName:      aws.String(core.StringValue(item.Fields["metricName"])),
+			MetricNamespace: aws.String(core.StringValue(item.Fields["metricNamespace"])),
+			MetricValue:     aws.String(core.StringValue(item.Fields["metricValue"])),
+		}
+
+		if defaultValue, hasDefaultValue := item.Fields["defaultValue"]; hasDefaultValue {
+			transformation.DefaultValue = aws.Float64(core.FloatValue(defaultValue))
+		}
+
+		if unit, hasUnit := item.Fields["unit"]; hasUnit {
+			transformation.Unit = types.StandardUnit(core.StringValue(unit))
+		}
+
+		if dimensions, hasDimensions := item.Fields["dimensions"]; hasDimensions {
+			transformation.Dimensions = map[string]string{}
+			for key, dimensionValue := range dimensions.Fields {
+				transformation.Dimensions[key] = core.StringValue(dimensionValue)
+			}
+		}
+
+		transformations[i] = transformation
+	}
+	return transformations
+}
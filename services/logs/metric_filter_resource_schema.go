@@ -0,0 +1,111 @@
+package logs
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func logsMetricFilterResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "LogsMetricFilterDefinition",
+		Description: "The definition of an Amazon CloudWatch Logs metric filter.",
+		Required:    []string{"logGroupName", "filterName", "filterPattern", "metricTransformations"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"logGroupName": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "The name of the log group that the metric filter is applied to.",
+				Pattern:      `^[\.\-_/#A-Za-z0-9]{1,512}$`,
+				MustRecreate: true,
+			},
+			"filterName": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "The name of the metric filter, this must be unique within the log group.",
+				Pattern:      `^[^:*]{1,512}$`,
+				MustRecreate: true,
+			},
+			"filterPattern": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The filter pattern that log events must match for the metric to be published.",
+				FormattedDescription: "The [filter pattern](https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html) " +
+					"that log events must match for the metric to be published.",
+				MaxLength: 1024,
+			},
+			"applyOnTransformedLogs": {
+				Type: provider.ResourceDefinitionsSchemaTypeBoolean,
+				Description: "Whether the metric filter is applied to the transformed version of the log events " +
+					"when the log group has a log transformer.",
+				Default: core.MappingNodeFromBool(false),
+			},
+			"metricTransformations": {
+				Type:        provider.ResourceDefinitionsSchemaTypeArray,
+				Description: "The metric to publish for log events that match the filter pattern.",
+				MinLength:   1,
+				MaxLength:   1,
+				Items: &provider.ResourceDefinitionsSchema{
+					Type:        provider.ResourceDefinitionsSchemaTypeObject,
+					Label:       "MetricTransformation",
+					Description: "Describes how to transform matching log events into a CloudWatch metric.",
+					Required:    []string{"metricName", "metricNamespace", "metricValue"},
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"metricName": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The name of the CloudWatch metric.",
+							MaxLength:   255,
+						},
+						"metricNamespace": {
+							Type:        provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The namespace of the CloudWatch metric.",
+							MaxLength:   255,
+						},
+						"metricValue": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+							Description: "The value to publish to the metric for each matching log event, " +
+								"this can be a number or a field in the log event such as $.latency.",
+							FormattedDescription: "The value to publish to the metric for each matching log event, " +
+								"this can be a number or a field in the log event such as `$.latency`.",
+							MaxLength: 100,
+						},
+						"defaultValue": {
+							Type: provider.ResourceDefinitionsSchemaTypeFloat,
+							Description: "The value to publish to the metric when a log event does not match the filter pattern. " +
+								"This can not be set when dimensions are set.",
+						},
+						"unit": {
+							Type:          provider.ResourceDefinitionsSchemaTypeString,
+							Description:   "The unit to assign to the metric.",
+							AllowedValues: standardUnitAllowedValues(),
+						},
+						"dimensions": {
+							Type: provider.ResourceDefinitionsSchemaTypeMap,
+							Description: "The fields in the log events to use as dimensions for the metric, " +
+								"up to three dimensions can be set for a metric.",
+							MapValues: &provider.ResourceDefinitionsSchema{
+								Type: provider.ResourceDefinitionsSchemaTypeString,
+							},
+						},
+					},
+				},
+			},
+
+			// Computed fields
+			"id": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The computed unique identifier combining the log group name and filter name.",
+				FormattedDescription: "The computed unique identifier combining the log group name and filter name. " +
+					"An example of this would be `/aws/lambda/process-order:ProcessOrderErrors`",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func standardUnitAllowedValues() []*core.MappingNode {
+	units := types.StandardUnit("").Values()
+	allowedValues := make([]*core.MappingNode, len(units))
+	for i, unit := range units {
+		allowedValues[i] = core.MappingNodeFromString(string(unit))
+	}
+	return allowedValues
+}
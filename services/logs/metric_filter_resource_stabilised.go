@@ -0,0 +1,17 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (l *logsMetricFilterResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// Metric filters are applied to new log events as soon as they have been saved.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package logs
+
+import (
+	"context"
+
+	logsservice "github.com/newstack-cloud/bluelink-provider-aws/services/logs/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (l *logsMetricFilterResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	logsService, err := l.getLogsService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// PutMetricFilter replaces the configuration of an existing metric filter,
+	// changes to the log group or filter name cause the metric filter to be replaced.
+	_, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{},
+		},
+		[]pluginutils.SaveOperation[logsservice.Service]{
+			&metricFilterPut{},
+		},
+		input,
+		logsService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString(saveOpCtx.ProviderUpstreamID),
+		},
+	}, nil
+}
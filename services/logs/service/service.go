@@ -0,0 +1,184 @@
+package logsservice
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the Amazon CloudWatch Logs service
+// used by resource implementations that manage log groups and metric filters,
+// including the log groups that Lambda functions write to.
+type Service interface {
+	// Creates a log group with the specified name. You can create up to 1,000,000 log
+	// groups per Region per account.
+	//
+	// When you create a log group, by default the log events in the log group do not
+	// expire. To set a retention policy so that events expire and are deleted after a
+	// specified time, use [PutRetentionPolicy].
+	//
+	// If you associate an KMS key with the log group, ingested data is encrypted
+	// using the KMS key. This association is stored as long as the data encrypted with
+	// the KMS key is still within CloudWatch Logs. This enables CloudWatch Logs to
+	// decrypt this data whenever it is requested.
+	//
+	// [PutRetentionPolicy]: https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html
+	CreateLogGroup(
+		ctx context.Context,
+		params *cloudwatchlogs.CreateLogGroupInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.CreateLogGroupOutput, error)
+	// Deletes the specified log group and permanently deletes all the archived log
+	// events associated with the log group.
+	DeleteLogGroup(
+		ctx context.Context,
+		params *cloudwatchlogs.DeleteLogGroupInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DeleteLogGroupOutput, error)
+	// Returns information about log groups. You can return all your log groups or
+	// filter the results by prefix. The results are ASCII-sorted by log group name.
+	DescribeLogGroups(
+		ctx context.Context,
+		params *cloudwatchlogs.DescribeLogGroupsInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	// Sets the retention of the specified log group. With a retention policy, you can
+	// configure the number of days for which to retain log events in the specified log
+	// group.
+	//
+	// CloudWatch Logs doesn't immediately delete log events when they reach their
+	// retention setting. It typically takes up to 72 hours after that before log
+	// events are deleted, but in rare situations might take longer.
+	PutRetentionPolicy(
+		ctx context.Context,
+		params *cloudwatchlogs.PutRetentionPolicyInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.PutRetentionPolicyOutput, error)
+	// Deletes the specified retention policy.
+	//
+	// Log events do not expire if they belong to log groups without a retention
+	// policy.
+	DeleteRetentionPolicy(
+		ctx context.Context,
+		params *cloudwatchlogs.DeleteRetentionPolicyInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error)
+	// Associates the specified KMS key with either one log group in the account, or
+	// with all stored CloudWatch Logs query insights results in the account.
+	//
+	// Associating a KMS key with a log group overrides any existing associations
+	// between the log group and a KMS key. After a KMS key is associated with a log
+	// group, all newly ingested data for the log group is encrypted using the KMS key.
+	AssociateKmsKey(
+		ctx context.Context,
+		params *cloudwatchlogs.AssociateKmsKeyInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.AssociateKmsKeyOutput, error)
+	// Disassociates the specified KMS key from the specified log group or from all
+	// CloudWatch Logs Insights query results in the account.
+	//
+	// The log events that were ingested while the key was associated with the log group
+	// are still encrypted with that key. It can take up to 5 minutes for this operation
+	// to take effect.
+	DisassociateKmsKey(
+		ctx context.Context,
+		params *cloudwatchlogs.DisassociateKmsKeyInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DisassociateKmsKeyOutput, error)
+	// Assigns one or more tags (key-value pairs) to the specified CloudWatch Logs
+	// resource. Currently, the only CloudWatch Logs resources that can be tagged are
+	// log groups and destinations.
+	//
+	// You can associate as many as 50 tags with a CloudWatch Logs resource.
+	TagResource(
+		ctx context.Context,
+		params *cloudwatchlogs.TagResourceInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.TagResourceOutput, error)
+	// Removes one or more tags from the specified resource.
+	UntagResource(
+		ctx context.Context,
+		params *cloudwatchlogs.UntagResourceInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.UntagResourceOutput, error)
+	// Displays the tags associated with a CloudWatch Logs resource. Currently, log
+	// groups and destinations support tagging.
+	ListTagsForResource(
+		ctx context.Context,
+		params *cloudwatchlogs.ListTagsForResourceInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.ListTagsForResourceOutput, error)
+	// Creates or updates a metric filter and associates it with the specified log
+	// group. With metric filters, you can configure rules to extract metric data from
+	// log events ingested through [PutLogEvents].
+	//
+	// The maximum number of metric filters that can be associated with a log group is
+	// 100.
+	//
+	// [PutLogEvents]: https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutLogEvents.html
+	PutMetricFilter(
+		ctx context.Context,
+		params *cloudwatchlogs.PutMetricFilterInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.PutMetricFilterOutput, error)
+	// Deletes the specified metric filter.
+	DeleteMetricFilter(
+		ctx context.Context,
+		params *cloudwatchlogs.DeleteMetricFilterInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DeleteMetricFilterOutput, error)
+	// Lists the specified metric filters. You can list all of the metric filters or
+	// filter the results by log name, prefix, metric name, or metric namespace. The
+	// results are ASCII-sorted by filter name.
+	DescribeMetricFilters(
+		ctx context.Context,
+		params *cloudwatchlogs.DescribeMetricFiltersInput,
+		optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DescribeMetricFiltersOutput, error)
+}
+
+// NewService creates a new instance of the Amazon CloudWatch Logs service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return cloudwatchlogs.NewFromConfig(
+		*awsConfig,
+		cloudwatchlogs.WithEndpointResolverV2(
+			&logsEndpointResolverV2{
+				providerContext,
+			},
+		),
+	)
+}
+
+type logsEndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (l *logsEndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params cloudwatchlogs.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	logsAliases := utils.Services["logs"]
+	logsEndpoint, hasLogsEndpoint := utils.GetEndpointFromProviderConfig(
+		l.providerContext,
+		"logs",
+		logsAliases,
+	)
+	if hasLogsEndpoint && !core.IsScalarNil(logsEndpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(logsEndpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return cloudwatchlogs.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
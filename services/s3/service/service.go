@@ -0,0 +1,77 @@
+package s3service
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the Amazon S3 service
+// used by resource implementations that need to stage artifacts in S3,
+// such as Lambda deployment packages that are too large to upload directly.
+type Service interface {
+	// Adds an object to a bucket.
+	//
+	// Amazon S3 never adds partial objects; if you receive a success response,
+	// Amazon S3 added the entire object to the bucket. You cannot use PutObject to
+	// only update a single piece of metadata for an existing object.
+	PutObject(
+		ctx context.Context,
+		params *s3.PutObjectInput,
+		optFns ...func(*s3.Options),
+	) (*s3.PutObjectOutput, error)
+}
+
+// NewService creates a new instance of the Amazon S3 service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return s3.NewFromConfig(
+		*awsConfig,
+		s3.WithEndpointResolverV2(
+			&s3EndpointResolverV2{
+				providerContext,
+			},
+		),
+		func(options *s3.Options) {
+			usePathStyle, hasUsePathStyle := providerContext.ProviderConfigVariable(
+				"s3UsePathStyle",
+			)
+			if hasUsePathStyle && !core.IsScalarNil(usePathStyle) {
+				options.UsePathStyle = core.BoolValueFromScalar(usePathStyle)
+			}
+		},
+	)
+}
+
+type s3EndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (s *s3EndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params s3.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	s3Aliases := utils.Services["s3"]
+	s3Endpoint, hasS3Endpoint := utils.GetEndpointFromProviderConfig(
+		s.providerContext,
+		"s3",
+		s3Aliases,
+	)
+	if hasS3Endpoint && !core.IsScalarNil(s3Endpoint) {
+		// The endpoint parameters are passed through to the default resolver
+		// so that bucket addressing is handled in the same way as it would be
+		// for the default S3 endpoints.
+		params.Endpoint = aws.String(core.StringValueFromScalar(s3Endpoint))
+		if _, err := url.Parse(*params.Endpoint); err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+	}
+
+	return s3.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
@@ -0,0 +1,6 @@
+package signer
+
+import "embed"
+
+//go:embed examples/*
+var examples embed.FS
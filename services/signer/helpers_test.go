@@ -0,0 +1,72 @@
+package signer
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+const (
+	testSigningProfileARN        = "arn:aws:signer:us-west-2:123456789012:/signing-profiles/TestProfile"
+	testSigningProfileVersionARN = "arn:aws:signer:us-west-2:123456789012:/signing-profiles/TestProfile/abcdef1234"
+)
+
+// newTestSigningJobResource creates a signing job resource for tests
+// with a short poll interval so tests that wait for jobs to complete run quickly.
+func newTestSigningJobResource(
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newSigningJobResource(
+		signerServiceFactory,
+		awsConfigStore,
+		&signingJobWaiter{
+			pollInterval: 1 * time.Millisecond,
+			maxAttempts:  3,
+		},
+	)
+}
+
+func createTestDeployInput(
+	resourceType string,
+	resourceName string,
+	specData *core.MappingNode,
+	currentStateSpecData *core.MappingNode,
+	providerCtx provider.Context,
+) *provider.ResourceDeployInput {
+	input := &provider.ResourceDeployInput{
+		InstanceID: "test-instance-id",
+		ResourceID: "test-resource-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-resource-id",
+				ResourceName: resourceName,
+				InstanceID:   "test-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: resourceType,
+					},
+					Spec: specData,
+				},
+			},
+		},
+		ProviderContext: providerCtx,
+	}
+
+	if currentStateSpecData != nil {
+		input.Changes.AppliedResourceInfo.CurrentResourceState = &state.ResourceState{
+			ResourceID: "test-resource-id",
+			Name:       resourceName,
+			InstanceID: "test-instance-id",
+			SpecData:   currentStateSpecData,
+		}
+	}
+
+	return input
+}
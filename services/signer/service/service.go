@@ -0,0 +1,127 @@
+package signerservice
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// Service is an interface that represents the functionality of the AWS Signer service
+// used by resource implementations that manage signing profiles and sign code artifacts,
+// such as Lambda deployment packages that are validated by a code signing configuration.
+type Service interface {
+	// Creates a signing profile. A signing profile is a code-signing template that
+	// can be used to carry out a pre-defined signing job.
+	PutSigningProfile(
+		ctx context.Context,
+		params *signer.PutSigningProfileInput,
+		optFns ...func(*signer.Options),
+	) (*signer.PutSigningProfileOutput, error)
+	// Returns information on a specific signing profile.
+	GetSigningProfile(
+		ctx context.Context,
+		params *signer.GetSigningProfileInput,
+		optFns ...func(*signer.Options),
+	) (*signer.GetSigningProfileOutput, error)
+	// Changes the state of an ACTIVE signing profile to CANCELED . A canceled profile
+	// is still viewable with the ListSigningProfiles operation, but it cannot perform
+	// new signing jobs. See [Data Retention]for more information on scheduled deletion of a canceled
+	// signing profile.
+	//
+	// [Data Retention]: https://docs.aws.amazon.com/signer/latest/developerguide/retention.html
+	CancelSigningProfile(
+		ctx context.Context,
+		params *signer.CancelSigningProfileInput,
+		optFns ...func(*signer.Options),
+	) (*signer.CancelSigningProfileOutput, error)
+	// Adds one or more tags to a signing profile. Tags are labels that you can use to
+	// identify and organize your AWS resources. Each tag consists of a key and an
+	// optional value. To specify the signing profile, use its Amazon Resource Name
+	// (ARN). To specify the tag, use a key-value pair.
+	TagResource(
+		ctx context.Context,
+		params *signer.TagResourceInput,
+		optFns ...func(*signer.Options),
+	) (*signer.TagResourceOutput, error)
+	// Removes one or more tags from a signing profile. To remove the tags, specify a
+	// list of tag keys.
+	UntagResource(
+		ctx context.Context,
+		params *signer.UntagResourceInput,
+		optFns ...func(*signer.Options),
+	) (*signer.UntagResourceOutput, error)
+	// Initiates a signing job to be performed on the code provided. Signing jobs are
+	// viewable by the ListSigningJobs operation for two years after they are
+	// performed. Note the following requirements:
+	//
+	//   - You must create an Amazon S3 source bucket. For more information, see [Creating a Bucket]in
+	//     the Amazon S3 Getting Started Guide.
+	//
+	//   - Your S3 source bucket must be version enabled.
+	//
+	//   - You must create an S3 destination bucket. AWS Signer uses your S3 destination
+	//     bucket to write your signed code.
+	//
+	//   - You specify the name of the source and destination buckets when calling the
+	//     StartSigningJob operation.
+	//
+	// [Creating a Bucket]: http://docs.aws.amazon.com/AmazonS3/latest/gsg/CreatingABucket.html
+	StartSigningJob(
+		ctx context.Context,
+		params *signer.StartSigningJobInput,
+		optFns ...func(*signer.Options),
+	) (*signer.StartSigningJobOutput, error)
+	// Returns information about a specific code signing job. You specify the job by
+	// using the jobId value that is returned by the StartSigningJoboperation.
+	DescribeSigningJob(
+		ctx context.Context,
+		params *signer.DescribeSigningJobInput,
+		optFns ...func(*signer.Options),
+	) (*signer.DescribeSigningJobOutput, error)
+}
+
+// NewService creates a new instance of the AWS Signer service
+// based on the provided AWS configuration.
+func NewService(awsConfig *aws.Config, providerContext provider.Context) Service {
+	return signer.NewFromConfig(
+		*awsConfig,
+		signer.WithEndpointResolverV2(
+			&signerEndpointResolverV2{
+				providerContext,
+			},
+		),
+	)
+}
+
+type signerEndpointResolverV2 struct {
+	providerContext provider.Context
+}
+
+func (s *signerEndpointResolverV2) ResolveEndpoint(
+	ctx context.Context,
+	params signer.EndpointParameters,
+) (smithyendpoints.Endpoint, error) {
+	signerAliases := utils.Services["signer"]
+	signerEndpoint, hasSignerEndpoint := utils.GetEndpointFromProviderConfig(
+		s.providerContext,
+		"signer",
+		signerAliases,
+	)
+	if hasSignerEndpoint && !core.IsScalarNil(signerEndpoint) {
+		u, err := url.Parse(core.StringValueFromScalar(signerEndpoint))
+		if err != nil {
+			return smithyendpoints.Endpoint{}, err
+		}
+		return smithyendpoints.Endpoint{
+			URI: *u,
+		}, nil
+	}
+
+	return signer.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
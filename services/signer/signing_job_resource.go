@@ -0,0 +1,89 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// SigningJobResource returns a resource implementation for an AWS Signer Signing Job.
+func SigningJobResource(
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	return newSigningJobResource(
+		signerServiceFactory,
+		awsConfigStore,
+		newSigningJobWaiter(),
+	)
+}
+
+func newSigningJobResource(
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+	waiter *signingJobWaiter,
+) provider.Resource {
+	basicExample, _ := examples.ReadFile("examples/resources/signer_signing_job_basic.md")
+	lambdaExample, _ := examples.ReadFile("examples/resources/signer_signing_job_lambda_code_signing.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/signer_signing_job_jsonc.md")
+
+	signingJobActions := &signerSigningJobResourceActions{
+		signerServiceFactory: signerServiceFactory,
+		awsConfigStore:       awsConfigStore,
+		waiter:               waiter,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/signer/signingJob",
+		Label:            "AWS Signer Signing Job",
+		PlainTextSummary: "A resource for signing a code artifact stored in S3 with an AWS Signer signing profile.",
+		FormattedDescription: "The resource type used to run an [AWS Signer signing job](https://docs.aws.amazon.com/signer/latest/developerguide/signing-jobs.html) " +
+			"that signs an unsigned artifact in a versioned S3 bucket and writes the signed artifact to a destination bucket. " +
+			"The location of the signed object can be used as the `code.s3Bucket` and `code.s3Key` of a Lambda function " +
+			"that enforces a code signing configuration.\n\n" +
+			"Signing jobs can not be modified or deleted, changes to any field will start a new signing job " +
+			"and destroying the resource will leave the signed object in the destination bucket.",
+		Schema:  signerSigningJobResourceSchema(),
+		IDField: "jobId",
+		// A signing job is not a terminal resource as the signed object
+		// is used by other resources such as Lambda functions.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(basicExample),
+			string(lambdaExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: signingJobActions.GetExternalState,
+		CreateFunc:           signingJobActions.Create,
+		UpdateFunc:           signingJobActions.Update,
+		DestroyFunc:          signingJobActions.Destroy,
+		StabilisedFunc:       signingJobActions.Stabilised,
+	}
+}
+
+type signerSigningJobResourceActions struct {
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	waiter               *signingJobWaiter
+}
+
+func (s *signerSigningJobResourceActions) getSignerService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (signerservice.Service, error) {
+	awsConfig, err := s.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signerServiceFactory(awsConfig, providerContext), nil
+}
@@ -0,0 +1,153 @@
+package signer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningJobResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	specData := pluginutils.GetResolvedResourceSpecData(input.Changes)
+	startSigningJobInput := specToStartSigningJobInput(specData)
+	startSigningJobInput.ClientRequestToken = aws.String(
+		signingJobClientRequestToken(input, startSigningJobInput),
+	)
+
+	startSigningJobOutput, err := signerService.StartSigningJob(ctx, startSigningJobInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start signing job: %w", err)
+	}
+
+	jobID := aws.ToString(startSigningJobOutput.JobId)
+	describeSigningJobOutput, err := s.waiter.waitForCompletion(ctx, signerService, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	computedFields := map[string]*core.MappingNode{
+		"spec.jobId":    core.MappingNodeFromString(jobID),
+		"spec.jobOwner": core.MappingNodeFromString(aws.ToString(startSigningJobOutput.JobOwner)),
+	}
+	addSignedObjectComputedFields(describeSigningJobOutput, computedFields)
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: computedFields,
+	}, nil
+}
+
+func specToStartSigningJobInput(specData *core.MappingNode) *signer.StartSigningJobInput {
+	input := &signer.StartSigningJobInput{
+		Source: &types.Source{
+			S3: &types.S3Source{},
+		},
+		Destination: &types.Destination{
+			S3: &types.S3Destination{},
+		},
+	}
+
+	valueSetters := []*pluginutils.ValueSetter[*signer.StartSigningJobInput]{
+		pluginutils.NewValueSetter(
+			"$.profileName",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.ProfileName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.profileOwner",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.ProfileOwner = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.source.s3.bucketName",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.Source.S3.BucketName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.source.s3.key",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.Source.S3.Key = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.source.s3.version",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.Source.S3.Version = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.destination.s3.bucketName",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.Destination.S3.BucketName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.destination.s3.prefix",
+			func(value *core.MappingNode, input *signer.StartSigningJobInput) {
+				input.Destination.S3.Prefix = aws.String(core.StringValue(value))
+			},
+		),
+	}
+
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+	}
+
+	return input
+}
+
+// signingJobClientRequestToken derives an idempotency token for a signing job
+// from the resource and the object being signed. Retrying the same deployment returns
+// the existing signing job while replacing the resource to sign a new version
+// of the source object starts a new signing job.
+func signingJobClientRequestToken(
+	deployInput *provider.ResourceDeployInput,
+	startSigningJobInput *signer.StartSigningJobInput,
+) string {
+	hash := sha256.Sum256([]byte(strings.Join(
+		[]string{
+			deployInput.InstanceID,
+			deployInput.ResourceID,
+			aws.ToString(startSigningJobInput.ProfileName),
+			aws.ToString(startSigningJobInput.Source.S3.BucketName),
+			aws.ToString(startSigningJobInput.Source.S3.Key),
+			aws.ToString(startSigningJobInput.Source.S3.Version),
+		},
+		"\n",
+	)))
+	return hex.EncodeToString(hash[:])
+}
+
+func addSignedObjectComputedFields(
+	output *signer.DescribeSigningJobOutput,
+	computedFields map[string]*core.MappingNode,
+) {
+	if output.SignedObject == nil || output.SignedObject.S3 == nil {
+		return
+	}
+
+	computedFields["spec.signedObjectBucketName"] = core.MappingNodeFromString(
+		aws.ToString(output.SignedObject.S3.BucketName),
+	)
+	computedFields["spec.signedObjectKey"] = core.MappingNodeFromString(
+		aws.ToString(output.SignedObject.S3.Key),
+	)
+}
@@ -0,0 +1,310 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningJobResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningJobResourceCreateSuite) Test_create_signing_job() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		createSigningJobTestCase(providerCtx, loader),
+		createSigningJobFailedJobTestCase(providerCtx, loader),
+		createSigningJobTimeoutTestCase(providerCtx, loader),
+		createSigningJobStartFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		newTestSigningJobResource,
+		&s.Suite,
+	)
+}
+
+func (s *SignerSigningJobResourceCreateSuite) Test_client_request_token_changes_with_source_version() {
+	input := createTestDeployInput(
+		"aws/signer/signingJob",
+		"TestSigningJob",
+		createTestSigningJobSpecData(),
+		nil,
+		nil,
+	)
+
+	startSigningJobInput := specToStartSigningJobInput(createTestSigningJobSpecData())
+	token := signingJobClientRequestToken(input, startSigningJobInput)
+	s.Equal(token, signingJobClientRequestToken(input, startSigningJobInput))
+
+	startSigningJobInput.Source.S3.Version = aws.String("version-2")
+	s.NotEqual(token, signingJobClientRequestToken(input, startSigningJobInput))
+}
+
+func TestSignerSigningJobResourceCreateSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningJobResourceCreateSuite))
+}
+
+func createSigningJobTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithStartSigningJobOutput(&signer.StartSigningJobOutput{
+			JobId:    aws.String("test-job-id"),
+			JobOwner: aws.String("123456789012"),
+		}),
+		signermock.WithDescribeSigningJobOutputSequence(
+			&signer.DescribeSigningJobOutput{
+				JobId:  aws.String("test-job-id"),
+				Status: types.SigningStatusInProgress,
+			},
+			createTestSucceededSigningJobOutput(),
+		),
+	)
+
+	specData := createTestSigningJobSpecData()
+	input := createTestDeployInput(
+		"aws/signer/signingJob",
+		"TestSigningJob",
+		specData,
+		nil,
+		providerCtx,
+	)
+	startSigningJobInput := specToStartSigningJobInput(specData)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "starts signing job and waits for it to complete",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: input,
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.jobId":                  core.MappingNodeFromString("test-job-id"),
+				"spec.jobOwner":               core.MappingNodeFromString("123456789012"),
+				"spec.signedObjectBucketName": core.MappingNodeFromString("signed-code-bucket"),
+				"spec.signedObjectKey":        core.MappingNodeFromString("signed/test-job-id.zip"),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"StartSigningJob": &signer.StartSigningJobInput{
+				ProfileName: aws.String("TestProfile"),
+				Source: &types.Source{
+					S3: &types.S3Source{
+						BucketName: aws.String("unsigned-code-bucket"),
+						Key:        aws.String("function.zip"),
+						Version:    aws.String("version-1"),
+					},
+				},
+				Destination: &types.Destination{
+					S3: &types.S3Destination{
+						BucketName: aws.String("signed-code-bucket"),
+						Prefix:     aws.String("signed/"),
+					},
+				},
+				ClientRequestToken: aws.String(
+					signingJobClientRequestToken(input, startSigningJobInput),
+				),
+			},
+			"DescribeSigningJob": []any{
+				&signer.DescribeSigningJobInput{
+					JobId: aws.String("test-job-id"),
+				},
+				&signer.DescribeSigningJobInput{
+					JobId: aws.String("test-job-id"),
+				},
+			},
+		},
+	}
+}
+
+func createSigningJobFailedJobTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithStartSigningJobOutput(&signer.StartSigningJobOutput{
+			JobId:    aws.String("test-job-id"),
+			JobOwner: aws.String("123456789012"),
+		}),
+		signermock.WithDescribeSigningJobOutput(&signer.DescribeSigningJobOutput{
+			JobId:        aws.String("test-job-id"),
+			Status:       types.SigningStatusFailed,
+			StatusReason: aws.String("Source object not found"),
+		}),
+	)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "fails when signing job fails",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingJob",
+			"TestSigningJob",
+			createTestSigningJobSpecData(),
+			nil,
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
+
+func createSigningJobTimeoutTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithStartSigningJobOutput(&signer.StartSigningJobOutput{
+			JobId:    aws.String("test-job-id"),
+			JobOwner: aws.String("123456789012"),
+		}),
+		signermock.WithDescribeSigningJobOutput(&signer.DescribeSigningJobOutput{
+			JobId:  aws.String("test-job-id"),
+			Status: types.SigningStatusInProgress,
+		}),
+	)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "fails when signing job does not complete",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingJob",
+			"TestSigningJob",
+			createTestSigningJobSpecData(),
+			nil,
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
+
+func createSigningJobStartFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithStartSigningJobError(errors.New("access denied")),
+	)
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "fails when signing job can not be started",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingJob",
+			"TestSigningJob",
+			createTestSigningJobSpecData(),
+			nil,
+			providerCtx,
+		),
+		SaveActionsNotCalled: []string{"DescribeSigningJob"},
+		ExpectError:          true,
+	}
+}
+
+func createTestSigningJobSpecData() *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"profileName": core.MappingNodeFromString("TestProfile"),
+			"source": {
+				Fields: map[string]*core.MappingNode{
+					"s3": {
+						Fields: map[string]*core.MappingNode{
+							"bucketName": core.MappingNodeFromString("unsigned-code-bucket"),
+							"key":        core.MappingNodeFromString("function.zip"),
+							"version":    core.MappingNodeFromString("version-1"),
+						},
+					},
+				},
+			},
+			"destination": {
+				Fields: map[string]*core.MappingNode{
+					"s3": {
+						Fields: map[string]*core.MappingNode{
+							"bucketName": core.MappingNodeFromString("signed-code-bucket"),
+							"prefix":     core.MappingNodeFromString("signed/"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestSucceededSigningJobOutput() *signer.DescribeSigningJobOutput {
+	return &signer.DescribeSigningJobOutput{
+		JobId:       aws.String("test-job-id"),
+		JobOwner:    aws.String("123456789012"),
+		ProfileName: aws.String("TestProfile"),
+		Status:      types.SigningStatusSucceeded,
+		Source: &types.Source{
+			S3: &types.S3Source{
+				BucketName: aws.String("unsigned-code-bucket"),
+				Key:        aws.String("function.zip"),
+				Version:    aws.String("version-1"),
+			},
+		},
+		SignedObject: &types.SignedObject{
+			S3: &types.S3SignedObject{
+				BucketName: aws.String("signed-code-bucket"),
+				Key:        aws.String("signed/test-job-id.zip"),
+			},
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (s *signerSigningJobResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	// Signing jobs can not be deleted and are retained by AWS Signer for two years,
+	// the signed object is left in the destination bucket as it may still be
+	// in use by deployed resources such as Lambda function versions.
+	return nil
+}
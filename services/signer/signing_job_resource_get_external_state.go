@@ -0,0 +1,75 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (s *signerSigningJobResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Signer service: %w", err)
+	}
+
+	jobID := core.StringValue(input.CurrentResourceSpec.Fields["jobId"])
+	output, err := signerService.DescribeSigningJob(
+		ctx,
+		&signer.DescribeSigningJobInput{
+			JobId: aws.String(jobID),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe signing job: %w", err)
+	}
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"jobId":       core.MappingNodeFromString(aws.ToString(output.JobId)),
+			"jobOwner":    core.MappingNodeFromString(aws.ToString(output.JobOwner)),
+			"profileName": core.MappingNodeFromString(aws.ToString(output.ProfileName)),
+		},
+	}
+
+	if output.Source != nil && output.Source.S3 != nil {
+		resourceSpecState.Fields["source"] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"s3": {
+					Fields: map[string]*core.MappingNode{
+						"bucketName": core.MappingNodeFromString(aws.ToString(output.Source.S3.BucketName)),
+						"key":        core.MappingNodeFromString(aws.ToString(output.Source.S3.Key)),
+						"version":    core.MappingNodeFromString(aws.ToString(output.Source.S3.Version)),
+					},
+				},
+			},
+		}
+	}
+
+	if output.SignedObject != nil && output.SignedObject.S3 != nil {
+		resourceSpecState.Fields["signedObjectBucketName"] = core.MappingNodeFromString(
+			aws.ToString(output.SignedObject.S3.BucketName),
+		)
+		resourceSpecState.Fields["signedObjectKey"] = core.MappingNodeFromString(
+			aws.ToString(output.SignedObject.S3.Key),
+		)
+	}
+
+	// The destination and profile owner are not returned when describing
+	// a signing job, so they are carried over from the current spec.
+	for _, field := range []string{"destination", "profileOwner"} {
+		if value, hasValue := input.CurrentResourceSpec.Fields[field]; hasValue {
+			resourceSpecState.Fields[field] = value
+		}
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
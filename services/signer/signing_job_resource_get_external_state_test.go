@@ -0,0 +1,113 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningJobResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningJobResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		getSigningJobExternalStateTestCase(providerCtx, loader),
+		getSigningJobExternalStateErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		SigningJobResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningJobResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningJobResourceGetExternalStateSuite))
+}
+
+func getSigningJobExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service] {
+	currentSpec := createTestSigningJobSpecData()
+	currentSpec.Fields["jobId"] = core.MappingNodeFromString("test-job-id")
+
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		Name: "successfully gets signing job state",
+		ServiceFactory: signermock.CreateSignerServiceMockFactory(
+			signermock.WithDescribeSigningJobOutput(createTestSucceededSigningJobOutput()),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext:     providerCtx,
+			CurrentResourceSpec: currentSpec,
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"jobId":                  core.MappingNodeFromString("test-job-id"),
+					"jobOwner":               core.MappingNodeFromString("123456789012"),
+					"profileName":            core.MappingNodeFromString("TestProfile"),
+					"source":                 currentSpec.Fields["source"],
+					"destination":            currentSpec.Fields["destination"],
+					"signedObjectBucketName": core.MappingNodeFromString("signed-code-bucket"),
+					"signedObjectKey":        core.MappingNodeFromString("signed/test-job-id.zip"),
+				},
+			},
+		},
+	}
+}
+
+func getSigningJobExternalStateErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		Name: "handles describe signing job error",
+		ServiceFactory: signermock.CreateSignerServiceMockFactory(
+			signermock.WithDescribeSigningJobError(errors.New("signing job not found")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"jobId": core.MappingNodeFromString("test-job-id"),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
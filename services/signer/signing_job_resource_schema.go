@@ -0,0 +1,114 @@
+package signer
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func signerSigningJobResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "SignerSigningJobDefinition",
+		Description: "The definition of an AWS Signer signing job.",
+		Required:    []string{"profileName", "source", "destination"},
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"profileName": {
+				Type:         provider.ResourceDefinitionsSchemaTypeString,
+				Description:  "The name of the signing profile used to sign the source object.",
+				Pattern:      "^[a-zA-Z0-9_]{2,64}$",
+				MustRecreate: true,
+			},
+			"profileOwner": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The AWS account ID of the signing profile owner, " +
+					"this is only required when the signing profile is owned by another account.",
+				Pattern:      "^[0-9]{12}$",
+				MustRecreate: true,
+			},
+			"source": {
+				Type:         provider.ResourceDefinitionsSchemaTypeObject,
+				Label:        "SigningJobSource",
+				Description:  "The location of the unsigned object to sign.",
+				Required:     []string{"s3"},
+				MustRecreate: true,
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"s3": {
+						Type:         provider.ResourceDefinitionsSchemaTypeObject,
+						Label:        "S3SigningJobSource",
+						Description:  "The S3 location of the unsigned object, the source bucket must have versioning enabled.",
+						Required:     []string{"bucketName", "key", "version"},
+						MustRecreate: true,
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"bucketName": {
+								Type:         provider.ResourceDefinitionsSchemaTypeString,
+								Description:  "The name of the S3 bucket that contains the object to sign.",
+								MustRecreate: true,
+							},
+							"key": {
+								Type:         provider.ResourceDefinitionsSchemaTypeString,
+								Description:  "The key of the object to sign, for example, a Lambda deployment package .zip file.",
+								MustRecreate: true,
+							},
+							"version": {
+								Type:         provider.ResourceDefinitionsSchemaTypeString,
+								Description:  "The version ID of the object to sign.",
+								MustRecreate: true,
+							},
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:         provider.ResourceDefinitionsSchemaTypeObject,
+				Label:        "SigningJobDestination",
+				Description:  "The location to save the signed object to.",
+				Required:     []string{"s3"},
+				MustRecreate: true,
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"s3": {
+						Type:         provider.ResourceDefinitionsSchemaTypeObject,
+						Label:        "S3SigningJobDestination",
+						Description:  "The S3 location to save the signed object to.",
+						Required:     []string{"bucketName"},
+						MustRecreate: true,
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"bucketName": {
+								Type:         provider.ResourceDefinitionsSchemaTypeString,
+								Description:  "The name of the S3 bucket to save the signed object to.",
+								MustRecreate: true,
+							},
+							"prefix": {
+								Type:         provider.ResourceDefinitionsSchemaTypeString,
+								Description:  "An optional prefix for the key of the signed object.",
+								MustRecreate: true,
+							},
+						},
+					},
+				},
+			},
+
+			// Computed fields returned by AWS
+			"jobId": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The ID of the signing job.",
+				Computed:    true,
+			},
+			"jobOwner": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The AWS account ID of the signing job owner.",
+				Computed:    true,
+			},
+			"signedObjectBucketName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name of the S3 bucket that contains the signed object, " +
+					"this can be used as the code.s3Bucket of a Lambda function.",
+				Computed: true,
+			},
+			"signedObjectKey": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The key of the signed object, " +
+					"this can be used as the code.s3Key of a Lambda function.",
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningJobResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Signer service: %w", err)
+	}
+
+	jobID, hasJobID := pluginutils.GetValueByPath("$.jobId", input.ResourceSpec)
+	if !hasJobID {
+		return nil, fmt.Errorf("jobId must be defined in the resource spec")
+	}
+
+	output, err := signerService.DescribeSigningJob(
+		ctx,
+		&signer.DescribeSigningJobInput{
+			JobId: aws.String(core.StringValue(jobID)),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe signing job: %w", err)
+	}
+
+	if output.Status == types.SigningStatusFailed {
+		return nil, signingJobFailedError(core.StringValue(jobID), output)
+	}
+
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: output.Status == types.SigningStatusSucceeded,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningJobResourceStabilisedSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningJobResourceStabilisedSuite) Test_stabilised_signing_job() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, signerservice.Service]{
+		stabilisedSigningJobTestCase(
+			"signing job is stabilised when it has succeeded",
+			types.SigningStatusSucceeded,
+			&provider.ResourceHasStabilisedOutput{Stabilised: true},
+			false,
+			providerCtx,
+			loader,
+		),
+		stabilisedSigningJobTestCase(
+			"signing job is not stabilised while in progress",
+			types.SigningStatusInProgress,
+			&provider.ResourceHasStabilisedOutput{Stabilised: false},
+			false,
+			providerCtx,
+			loader,
+		),
+		stabilisedSigningJobTestCase(
+			"fails when signing job has failed",
+			types.SigningStatusFailed,
+			nil,
+			true,
+			providerCtx,
+			loader,
+		),
+	}
+
+	plugintestutils.RunResourceHasStabilisedTestCases(
+		testCases,
+		SigningJobResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningJobResourceStabilisedSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningJobResourceStabilisedSuite))
+}
+
+func stabilisedSigningJobTestCase(
+	name string,
+	status types.SigningStatus,
+	expectedOutput *provider.ResourceHasStabilisedOutput,
+	expectError bool,
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithDescribeSigningJobOutput(&signer.DescribeSigningJobOutput{
+			JobId:        aws.String("test-job-id"),
+			Status:       status,
+			StatusReason: aws.String("Source object not found"),
+		}),
+	)
+
+	return plugintestutils.ResourceHasStabilisedTestCase[*aws.Config, signerservice.Service]{
+		Name: name,
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceHasStabilisedInput{
+			ProviderContext: providerCtx,
+			ResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"jobId": core.MappingNodeFromString("test-job-id"),
+				},
+			},
+		},
+		ExpectedOutput: expectedOutput,
+		ExpectError:    expectError,
+	}
+}
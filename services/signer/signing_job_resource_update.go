@@ -0,0 +1,35 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningJobResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	// Signing jobs can not be modified, all fields of the resource require
+	// a new signing job to be started so the computed fields of the existing
+	// signing job are returned as they are.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+
+	computedFields := map[string]*core.MappingNode{}
+	for _, field := range []string{
+		"jobId",
+		"jobOwner",
+		"signedObjectBucketName",
+		"signedObjectKey",
+	} {
+		if value, hasValue := pluginutils.GetValueByPath("$."+field, currentStateSpecData); hasValue {
+			computedFields["spec."+field] = value
+		}
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: computedFields,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+)
+
+// signingJobWaiter polls a signing job until it has completed so the location
+// of the signed object can be returned as a computed field when the resource is created.
+type signingJobWaiter struct {
+	pollInterval time.Duration
+	maxAttempts  int
+}
+
+func newSigningJobWaiter() *signingJobWaiter {
+	return &signingJobWaiter{
+		pollInterval: 2 * time.Second,
+		maxAttempts:  150,
+	}
+}
+
+func (w *signingJobWaiter) waitForCompletion(
+	ctx context.Context,
+	signerService signerservice.Service,
+	jobID string,
+) (*signer.DescribeSigningJobOutput, error) {
+	for attempt := 0; attempt < w.maxAttempts; attempt += 1 {
+		output, err := signerService.DescribeSigningJob(
+			ctx,
+			&signer.DescribeSigningJobInput{
+				JobId: aws.String(jobID),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe signing job %s: %w", jobID, err)
+		}
+
+		switch output.Status {
+		case types.SigningStatusSucceeded:
+			return output, nil
+		case types.SigningStatusFailed:
+			return nil, signingJobFailedError(jobID, output)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(w.pollInterval):
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"signing job %s did not complete after %d attempts",
+		jobID,
+		w.maxAttempts,
+	)
+}
+
+func signingJobFailedError(jobID string, output *signer.DescribeSigningJobOutput) error {
+	return fmt.Errorf(
+		"signing job %s failed: %s",
+		jobID,
+		aws.ToString(output.StatusReason),
+	)
+}
@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+)
+
+// SigningProfileResource returns a resource implementation for an AWS Signer Signing Profile.
+func SigningProfileResource(
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service],
+	awsConfigStore pluginutils.ServiceConfigStore[*aws.Config],
+) provider.Resource {
+	basicExample, _ := examples.ReadFile("examples/resources/signer_signing_profile_basic.md")
+	completeExample, _ := examples.ReadFile("examples/resources/signer_signing_profile_complete.md")
+	jsoncExample, _ := examples.ReadFile("examples/resources/signer_signing_profile_jsonc.md")
+
+	signingProfileActions := &signerSigningProfileResourceActions{
+		signerServiceFactory: signerServiceFactory,
+		awsConfigStore:       awsConfigStore,
+		uniqueNameGenerator:  utils.SignerSigningProfileNameGenerator,
+	}
+	return &providerv1.ResourceDefinition{
+		Type:             "aws/signer/signingProfile",
+		Label:            "AWS Signer Signing Profile",
+		PlainTextSummary: "A resource for managing an AWS Signer signing profile.",
+		FormattedDescription: "The resource type used to define an [AWS Signer signing profile](https://docs.aws.amazon.com/signer/latest/developerguide/gs-profile.html) " +
+			"that is deployed to AWS. The profile version ARN of a signing profile can be used as an allowed publisher " +
+			"in a Lambda code signing configuration.",
+		Schema:  signerSigningProfileResourceSchema(),
+		IDField: "arn",
+		// A signing profile is not a terminal resource as it is used by signing jobs
+		// and code signing configurations.
+		CommonTerminal: false,
+		FormattedExamples: []string{
+			string(basicExample),
+			string(completeExample),
+			string(jsoncExample),
+		},
+		ResourceCanLinkTo:    []string{},
+		GetExternalStateFunc: signingProfileActions.GetExternalState,
+		CreateFunc:           signingProfileActions.Create,
+		UpdateFunc:           signingProfileActions.Update,
+		DestroyFunc:          signingProfileActions.Destroy,
+		StabilisedFunc:       signingProfileActions.Stabilised,
+	}
+}
+
+type signerSigningProfileResourceActions struct {
+	signerServiceFactory pluginutils.ServiceFactory[*aws.Config, signerservice.Service]
+	awsConfigStore       pluginutils.ServiceConfigStore[*aws.Config]
+	uniqueNameGenerator  utils.UniqueNameGenerator
+}
+
+func (s *signerSigningProfileResourceActions) getSignerService(
+	ctx context.Context,
+	providerContext provider.Context,
+) (signerservice.Service, error) {
+	awsConfig, err := s.awsConfigStore.FromProviderContext(
+		ctx,
+		providerContext,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signerServiceFactory(awsConfig, providerContext), nil
+}
+
+// extractProfileNameFromARN extracts the profile name from a signing profile ARN
+// or signing profile version ARN.
+// ARN format: arn:aws:signer:us-east-1:123456789012:/signing-profiles/ProfileName[/version].
+func extractProfileNameFromARN(arn string) (string, error) {
+	_, afterPrefix, found := strings.Cut(arn, ":/signing-profiles/")
+	if !found {
+		return "", fmt.Errorf("invalid signing profile ARN format: %s", arn)
+	}
+
+	profileName, _, _ := strings.Cut(afterPrefix, "/")
+	if profileName == "" {
+		return "", fmt.Errorf("profile name cannot be empty in ARN: %s", arn)
+	}
+
+	return profileName, nil
+}
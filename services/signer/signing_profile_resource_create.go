@@ -0,0 +1,65 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningProfileResourceActions) Create(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	createOperations := []pluginutils.SaveOperation[signerservice.Service]{
+		newSigningProfileCreate(s.uniqueNameGenerator),
+	}
+
+	hasSavedValues, saveOpCtx, err := pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			Data: map[string]any{
+				"ResourceDeployInput": input,
+			},
+		},
+		createOperations,
+		input,
+		signerService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSavedValues {
+		return nil, fmt.Errorf("no values were saved during signing profile creation")
+	}
+
+	putSigningProfileOutput, ok := saveOpCtx.Data["putSigningProfileOutput"].(*signer.PutSigningProfileOutput)
+	if !ok {
+		return nil, fmt.Errorf("putSigningProfileOutput not found in save operation context")
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(
+				aws.ToString(putSigningProfileOutput.Arn),
+			),
+			"spec.profileVersion": core.MappingNodeFromString(
+				aws.ToString(putSigningProfileOutput.ProfileVersion),
+			),
+			"spec.profileVersionArn": core.MappingNodeFromString(
+				aws.ToString(putSigningProfileOutput.ProfileVersionArn),
+			),
+		},
+	}, nil
+}
@@ -0,0 +1,125 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+type signingProfileCreate struct {
+	input                      *signer.PutSigningProfileInput
+	uniqueProfileNameGenerator utils.UniqueNameGenerator
+}
+
+func newSigningProfileCreate(generator utils.UniqueNameGenerator) *signingProfileCreate {
+	return &signingProfileCreate{
+		uniqueProfileNameGenerator: generator,
+	}
+}
+
+func (u *signingProfileCreate) Name() string {
+	return "create signing profile"
+}
+
+func (u *signingProfileCreate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	input, hasValues := changesToPutSigningProfileInput(specData)
+
+	if aws.ToString(input.ProfileName) == "" {
+		generator := u.uniqueProfileNameGenerator
+		if generator == nil {
+			generator = utils.SignerSigningProfileNameGenerator
+		}
+
+		inputData, ok := saveOpCtx.Data["ResourceDeployInput"].(*provider.ResourceDeployInput)
+		if !ok || inputData == nil {
+			return false, saveOpCtx, fmt.Errorf("ResourceDeployInput not found in SaveOperationContext.Data")
+		}
+
+		uniqueProfileName, err := generator(inputData)
+		if err != nil {
+			return false, saveOpCtx, err
+		}
+
+		input.ProfileName = aws.String(uniqueProfileName)
+		hasValues = true
+	}
+
+	u.input = input
+	return hasValues, saveOpCtx, nil
+}
+
+func (u *signingProfileCreate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	signerService signerservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	newSaveOpCtx := pluginutils.SaveOperationContext{
+		Data: saveOpCtx.Data,
+	}
+
+	putSigningProfileOutput, err := signerService.PutSigningProfile(ctx, u.input)
+	if err != nil {
+		return saveOpCtx, fmt.Errorf("failed to create signing profile: %w", err)
+	}
+
+	newSaveOpCtx.ProviderUpstreamID = aws.ToString(putSigningProfileOutput.Arn)
+	newSaveOpCtx.Data["putSigningProfileOutput"] = putSigningProfileOutput
+
+	return newSaveOpCtx, nil
+}
+
+func changesToPutSigningProfileInput(
+	specData *core.MappingNode,
+) (*signer.PutSigningProfileInput, bool) {
+	input := &signer.PutSigningProfileInput{}
+
+	valueSetters := []*pluginutils.ValueSetter[*signer.PutSigningProfileInput]{
+		pluginutils.NewValueSetter(
+			"$.profileName",
+			func(value *core.MappingNode, input *signer.PutSigningProfileInput) {
+				input.ProfileName = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.platformId",
+			func(value *core.MappingNode, input *signer.PutSigningProfileInput) {
+				input.PlatformId = aws.String(core.StringValue(value))
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.signatureValidityPeriod",
+			func(value *core.MappingNode, input *signer.PutSigningProfileInput) {
+				input.SignatureValidityPeriod = &types.SignatureValidityPeriod{
+					Type:  types.ValidityType(core.StringValue(value.Fields["type"])),
+					Value: int32(core.IntValue(value.Fields["value"])),
+				}
+			},
+		),
+		pluginutils.NewValueSetter(
+			"$.tags",
+			func(value *core.MappingNode, input *signer.PutSigningProfileInput) {
+				input.Tags = signerTagsFromValue(value)
+			},
+		),
+	}
+
+	hasValuesToSave := false
+	for _, valueSetter := range valueSetters {
+		valueSetter.Set(specData, input)
+		hasValuesToSave = hasValuesToSave || valueSetter.DidSet()
+	}
+
+	return input, hasValuesToSave
+}
@@ -0,0 +1,216 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningProfileResourceCreateSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningProfileResourceCreateSuite) Test_create_signing_profile() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		createSigningProfileTestCase(providerCtx, loader),
+		createSigningProfileWithGeneratedNameTestCase(providerCtx, loader),
+		createSigningProfileFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		SigningProfileResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningProfileResourceCreateSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningProfileResourceCreateSuite))
+}
+
+func createSigningProfileTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithPutSigningProfileOutput(&signer.PutSigningProfileOutput{
+			Arn:               aws.String(testSigningProfileARN),
+			ProfileVersion:    aws.String("abcdef1234"),
+			ProfileVersionArn: aws.String(testSigningProfileVersionARN),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"profileName": core.MappingNodeFromString("TestProfile"),
+			"platformId":  core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+			"signatureValidityPeriod": {
+				Fields: map[string]*core.MappingNode{
+					"type":  core.MappingNodeFromString("MONTHS"),
+					"value": core.MappingNodeFromInt(12),
+				},
+			},
+			"tags": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"key":   core.MappingNodeFromString("Environment"),
+							"value": core.MappingNodeFromString("Production"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "create signing profile",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingProfile",
+			"TestProfile",
+			specData,
+			nil,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":               core.MappingNodeFromString(testSigningProfileARN),
+				"spec.profileVersion":    core.MappingNodeFromString("abcdef1234"),
+				"spec.profileVersionArn": core.MappingNodeFromString(testSigningProfileVersionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"PutSigningProfile": &signer.PutSigningProfileInput{
+				ProfileName: aws.String("TestProfile"),
+				PlatformId:  aws.String("AWSLambda-SHA384-ECDSA"),
+				SignatureValidityPeriod: &types.SignatureValidityPeriod{
+					Type:  types.ValidityTypeMonths,
+					Value: 12,
+				},
+				Tags: map[string]string{
+					"Environment": "Production",
+				},
+			},
+		},
+	}
+}
+
+func createSigningProfileWithGeneratedNameTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	generatedARN := "arn:aws:signer:us-west-2:123456789012:/signing-profiles/LambdaSigning_abc123"
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithPutSigningProfileOutput(&signer.PutSigningProfileOutput{
+			Arn:               aws.String(generatedARN),
+			ProfileVersion:    aws.String("abcdef1234"),
+			ProfileVersionArn: aws.String(generatedARN + "/abcdef1234"),
+		}),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"platformId": core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "create signing profile with generated name",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingProfile",
+			"LambdaSigning",
+			specData,
+			nil,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":               core.MappingNodeFromString(generatedARN),
+				"spec.profileVersion":    core.MappingNodeFromString("abcdef1234"),
+				"spec.profileVersionArn": core.MappingNodeFromString(generatedARN + "/abcdef1234"),
+			},
+		},
+		// The generated name includes a random ID so the exact input
+		// can not be asserted.
+	}
+}
+
+func createSigningProfileFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithPutSigningProfileError(errors.New("failed to create signing profile")),
+	)
+
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"profileName": core.MappingNodeFromString("TestProfile"),
+			"platformId":  core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+		},
+	}
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "handles signing profile creation failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingProfile",
+			"TestProfile",
+			specData,
+			nil,
+			providerCtx,
+		),
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,42 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (s *signerSigningProfileResourceActions) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return fmt.Errorf("failed to get Signer service: %w", err)
+	}
+
+	arn := core.StringValue(input.ResourceState.SpecData.Fields["arn"])
+	profileName, err := extractProfileNameFromARN(arn)
+	if err != nil {
+		return err
+	}
+
+	// Signing profiles can not be deleted, cancelling a profile prevents it
+	// from being used for new signing jobs and AWS deletes it after a retention period.
+	// Signatures that were generated with the profile remain valid.
+	_, err = signerService.CancelSigningProfile(
+		ctx,
+		&signer.CancelSigningProfileInput{
+			ProfileName: aws.String(profileName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to cancel signing profile: %w", err)
+	}
+
+	return nil
+}
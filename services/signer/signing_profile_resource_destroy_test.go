@@ -0,0 +1,116 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningProfileResourceDestroySuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningProfileResourceDestroySuite) Test_destroy_signing_profile() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDestroyTestCase[*aws.Config, signerservice.Service]{
+		destroySigningProfileTestCase(providerCtx, loader),
+		destroySigningProfileFailureTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDestroyTestCases(
+		testCases,
+		SigningProfileResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningProfileResourceDestroySuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningProfileResourceDestroySuite))
+}
+
+func destroySigningProfileTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock()
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, signerservice.Service]{
+		Name: "cancels signing profile",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-resource-id",
+			ResourceState: &state.ResourceState{
+				SpecData: createTestSigningProfileSpecData(map[string]string{}),
+			},
+			ProviderContext: providerCtx,
+		},
+		DestroyActionsCalled: map[string]any{
+			"CancelSigningProfile": &signer.CancelSigningProfileInput{
+				ProfileName: aws.String("TestProfile"),
+			},
+		},
+	}
+}
+
+func destroySigningProfileFailureTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDestroyTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock(
+		signermock.WithCancelSigningProfileError(errors.New("failed to cancel signing profile")),
+	)
+
+	return plugintestutils.ResourceDestroyTestCase[*aws.Config, signerservice.Service]{
+		Name: "handles signing profile cancellation failure",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceDestroyInput{
+			InstanceID: "test-instance-id",
+			ResourceID: "test-resource-id",
+			ResourceState: &state.ResourceState{
+				SpecData: createTestSigningProfileSpecData(map[string]string{}),
+			},
+			ProviderContext: providerCtx,
+		},
+		ExpectError: true,
+	}
+}
@@ -0,0 +1,98 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningProfileResourceActions) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Signer service: %w", err)
+	}
+
+	arn := core.StringValue(input.CurrentResourceSpec.Fields["arn"])
+	profileName, err := extractProfileNameFromARN(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := signerService.GetSigningProfile(
+		ctx,
+		&signer.GetSigningProfileInput{
+			ProfileName: aws.String(profileName),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing profile: %w", err)
+	}
+
+	resourceSpecState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":               core.MappingNodeFromString(aws.ToString(output.Arn)),
+			"profileName":       core.MappingNodeFromString(aws.ToString(output.ProfileName)),
+			"platformId":        core.MappingNodeFromString(aws.ToString(output.PlatformId)),
+			"profileVersion":    core.MappingNodeFromString(aws.ToString(output.ProfileVersion)),
+			"profileVersionArn": core.MappingNodeFromString(aws.ToString(output.ProfileVersionArn)),
+		},
+	}
+
+	err = s.addOptionalConfigurationsToSpec(output, resourceSpecState.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: resourceSpecState,
+	}, nil
+}
+
+func (s *signerSigningProfileResourceActions) addOptionalConfigurationsToSpec(
+	output *signer.GetSigningProfileOutput,
+	specFields map[string]*core.MappingNode,
+) error {
+	extractors := []pluginutils.OptionalValueExtractor[*signer.GetSigningProfileOutput]{
+		{
+			Name: "signatureValidityPeriod",
+			Condition: func(output *signer.GetSigningProfileOutput) bool {
+				return output.SignatureValidityPeriod != nil
+			},
+			Fields: []string{"signatureValidityPeriod"},
+			Values: func(output *signer.GetSigningProfileOutput) ([]*core.MappingNode, error) {
+				return []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"type": core.MappingNodeFromString(
+								string(output.SignatureValidityPeriod.Type),
+							),
+							"value": core.MappingNodeFromInt(
+								int(output.SignatureValidityPeriod.Value),
+							),
+						},
+					},
+				}, nil
+			},
+		},
+		{
+			Name: "tags",
+			Condition: func(output *signer.GetSigningProfileOutput) bool {
+				return len(output.Tags) > 0
+			},
+			Fields: []string{"tags"},
+			Values: func(output *signer.GetSigningProfileOutput) ([]*core.MappingNode, error) {
+				return []*core.MappingNode{extractSignerTags(output.Tags)}, nil
+			},
+		},
+	}
+
+	return pluginutils.RunOptionalValueExtractors(output, specFields, extractors)
+}
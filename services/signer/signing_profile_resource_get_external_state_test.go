@@ -0,0 +1,151 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningProfileResourceGetExternalStateSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningProfileResourceGetExternalStateSuite) Test_get_external_state() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		getSigningProfileExternalStateTestCase(providerCtx, loader),
+		getSigningProfileExternalStateErrorTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceGetExternalStateTestCases(
+		testCases,
+		SigningProfileResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningProfileResourceGetExternalStateSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningProfileResourceGetExternalStateSuite))
+}
+
+func getSigningProfileExternalStateTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		Name: "successfully gets signing profile state",
+		ServiceFactory: signermock.CreateSignerServiceMockFactory(
+			signermock.WithGetSigningProfileOutput(&signer.GetSigningProfileOutput{
+				Arn:               aws.String(testSigningProfileARN),
+				ProfileName:       aws.String("TestProfile"),
+				PlatformId:        aws.String("AWSLambda-SHA384-ECDSA"),
+				ProfileVersion:    aws.String("abcdef1234"),
+				ProfileVersionArn: aws.String(testSigningProfileVersionARN),
+				SignatureValidityPeriod: &types.SignatureValidityPeriod{
+					Type:  types.ValidityTypeYears,
+					Value: 2,
+				},
+				Status: types.SigningProfileStatusActive,
+				Tags: map[string]string{
+					"Team":        "Platform",
+					"Environment": "Production",
+				},
+			}),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString(testSigningProfileARN),
+				},
+			},
+		},
+		ExpectedOutput: &provider.ResourceGetExternalStateOutput{
+			ResourceSpecState: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn":               core.MappingNodeFromString(testSigningProfileARN),
+					"profileName":       core.MappingNodeFromString("TestProfile"),
+					"platformId":        core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+					"profileVersion":    core.MappingNodeFromString("abcdef1234"),
+					"profileVersionArn": core.MappingNodeFromString(testSigningProfileVersionARN),
+					"signatureValidityPeriod": {
+						Fields: map[string]*core.MappingNode{
+							"type":  core.MappingNodeFromString("YEARS"),
+							"value": core.MappingNodeFromInt(2),
+						},
+					},
+					"tags": {
+						Items: []*core.MappingNode{
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("Environment"),
+									"value": core.MappingNodeFromString("Production"),
+								},
+							},
+							{
+								Fields: map[string]*core.MappingNode{
+									"key":   core.MappingNodeFromString("Team"),
+									"value": core.MappingNodeFromString("Platform"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getSigningProfileExternalStateErrorTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service] {
+	return plugintestutils.ResourceGetExternalStateTestCase[*aws.Config, signerservice.Service]{
+		Name: "handles get signing profile error",
+		ServiceFactory: signermock.CreateSignerServiceMockFactory(
+			signermock.WithGetSigningProfileError(errors.New("signing profile not found")),
+		),
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: &provider.ResourceGetExternalStateInput{
+			ProviderContext: providerCtx,
+			CurrentResourceSpec: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"arn": core.MappingNodeFromString(testSigningProfileARN),
+				},
+			},
+		},
+		ExpectError: true,
+	}
+}
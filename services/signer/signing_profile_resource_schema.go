@@ -0,0 +1,77 @@
+package signer
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func signerSigningProfileResourceSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeObject,
+		Label:       "SignerSigningProfileDefinition",
+		Description: "The definition of an AWS Signer signing profile.",
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"profileName": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name of the signing profile. If a name is not provided, " +
+					"a unique name will be generated based on the blueprint instance and resource name.",
+				Pattern:      "^[a-zA-Z0-9_]{2,64}$",
+				MustRecreate: true,
+			},
+			"platformId": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The ID of the signing platform used by the signing profile. " +
+					"Use AWSLambda-SHA384-ECDSA for profiles that sign Lambda deployment packages.",
+				FormattedDescription: "The ID of the [signing platform](https://docs.aws.amazon.com/signer/latest/developerguide/gs-platform.html) " +
+					"used by the signing profile. Use `AWSLambda-SHA384-ECDSA` for profiles that sign Lambda deployment packages.",
+				Default:      core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+				MustRecreate: true,
+			},
+			"signatureValidityPeriod": {
+				Type:  provider.ResourceDefinitionsSchemaTypeObject,
+				Label: "SignatureValidityPeriod",
+				Description: "The validity period for signatures generated using the signing profile. " +
+					"If not set, signatures are valid for 135 months.",
+				Required:     []string{"type", "value"},
+				MustRecreate: true,
+				Attributes: map[string]*provider.ResourceDefinitionsSchema{
+					"type": {
+						Type:        provider.ResourceDefinitionsSchemaTypeString,
+						Description: "The time unit for the signature validity period.",
+						AllowedValues: []*core.MappingNode{
+							core.MappingNodeFromString("DAYS"),
+							core.MappingNodeFromString("MONTHS"),
+							core.MappingNodeFromString("YEARS"),
+						},
+						MustRecreate: true,
+					},
+					"value": {
+						Type:         provider.ResourceDefinitionsSchemaTypeInteger,
+						Description:  "The numerical value of the time unit for the signature validity period.",
+						Minimum:      core.ScalarFromInt(1),
+						MustRecreate: true,
+					},
+				},
+			},
+			"tags": signerSchemaTags("signing profile"),
+
+			// Computed fields returned by AWS
+			"arn": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The Amazon Resource Name (ARN) of the signing profile.",
+				Computed:    true,
+			},
+			"profileVersion": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The version of the signing profile.",
+				Computed:    true,
+			},
+			"profileVersionArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The signing profile ARN including the profile version, " +
+					"this can be used as an allowed publisher in a Lambda code signing configuration.",
+				Computed: true,
+			},
+		},
+	}
+}
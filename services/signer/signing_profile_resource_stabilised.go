@@ -0,0 +1,17 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+func (s *signerSigningProfileResourceActions) Stabilised(
+	ctx context.Context,
+	input *provider.ResourceHasStabilisedInput,
+) (*provider.ResourceHasStabilisedOutput, error) {
+	// Signing profiles are active as soon as they have been created.
+	return &provider.ResourceHasStabilisedOutput{
+		Stabilised: true,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"context"
+
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func (s *signerSigningProfileResourceActions) Update(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	signerService, err := s.getSignerService(ctx, input.ProviderContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tags are the only part of a signing profile that can be updated,
+	// changes to any other field will cause the signing profile to be replaced.
+	currentStateSpecData := pluginutils.GetCurrentResourceStateSpecData(input.Changes)
+	arn, _ := pluginutils.GetValueByPath("$.arn", currentStateSpecData)
+	profileVersion, _ := pluginutils.GetValueByPath("$.profileVersion", currentStateSpecData)
+	profileVersionArn, _ := pluginutils.GetValueByPath("$.profileVersionArn", currentStateSpecData)
+
+	updateOperations := []pluginutils.SaveOperation[signerservice.Service]{
+		&tagsUpdate{},
+	}
+
+	_, _, err = pluginutils.RunSaveOperations(
+		ctx,
+		pluginutils.SaveOperationContext{
+			ProviderUpstreamID: core.StringValue(arn),
+			Data:               map[string]any{},
+		},
+		updateOperations,
+		input,
+		signerService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: map[string]*core.MappingNode{
+			"spec.arn":               arn,
+			"spec.profileVersion":    profileVersion,
+			"spec.profileVersionArn": profileVersionArn,
+		},
+	}, nil
+}
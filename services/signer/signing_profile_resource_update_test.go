@@ -0,0 +1,160 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/newstack-cloud/bluelink-provider-aws/internal/testutils"
+	signermock "github.com/newstack-cloud/bluelink-provider-aws/internal/testutils/signer_mock"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/plugintestutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignerSigningProfileResourceUpdateSuite struct {
+	suite.Suite
+}
+
+func (s *SignerSigningProfileResourceUpdateSuite) Test_update_signing_profile() {
+	loader := &testutils.MockAWSConfigLoader{}
+	providerCtx := plugintestutils.NewTestProviderContext(
+		"aws",
+		map[string]*core.ScalarValue{
+			"region": core.ScalarFromString("us-west-2"),
+		},
+		map[string]*core.ScalarValue{
+			"session_id": core.ScalarFromString("test-session-id"),
+		},
+	)
+
+	testCases := []plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		updateSigningProfileTagsTestCase(providerCtx, loader),
+		updateSigningProfileNoChangesTestCase(providerCtx, loader),
+	}
+
+	plugintestutils.RunResourceDeployTestCases(
+		testCases,
+		SigningProfileResource,
+		&s.Suite,
+	)
+}
+
+func TestSignerSigningProfileResourceUpdateSuite(t *testing.T) {
+	suite.Run(t, new(SignerSigningProfileResourceUpdateSuite))
+}
+
+func updateSigningProfileTagsTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock()
+
+	currentStateSpecData := createTestSigningProfileSpecData(map[string]string{
+		"Environment": "Staging",
+		"Team":        "Platform",
+	})
+	specData := createTestSigningProfileSpecData(map[string]string{
+		"Environment": "Production",
+	})
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "update signing profile tags",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingProfile",
+			"TestProfile",
+			specData,
+			currentStateSpecData,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":               core.MappingNodeFromString(testSigningProfileARN),
+				"spec.profileVersion":    core.MappingNodeFromString("abcdef1234"),
+				"spec.profileVersionArn": core.MappingNodeFromString(testSigningProfileVersionARN),
+			},
+		},
+		SaveActionsCalled: map[string]any{
+			"TagResource": &signer.TagResourceInput{
+				ResourceArn: aws.String(testSigningProfileARN),
+				Tags: map[string]string{
+					"Environment": "Production",
+				},
+			},
+			"UntagResource": &signer.UntagResourceInput{
+				ResourceArn: aws.String(testSigningProfileARN),
+				TagKeys:     []string{"Team"},
+			},
+		},
+		SaveActionsNotCalled: []string{"PutSigningProfile"},
+	}
+}
+
+func updateSigningProfileNoChangesTestCase(
+	providerCtx provider.Context,
+	loader *testutils.MockAWSConfigLoader,
+) plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service] {
+	service := signermock.CreateSignerServiceMock()
+
+	specData := createTestSigningProfileSpecData(map[string]string{})
+
+	return plugintestutils.ResourceDeployTestCase[*aws.Config, signerservice.Service]{
+		Name: "update signing profile without tag changes",
+		ServiceFactory: func(awsConfig *aws.Config, providerContext provider.Context) signerservice.Service {
+			return service
+		},
+		ServiceMockCalls: &service.MockCalls,
+		ConfigStore: utils.NewAWSConfigStore(
+			[]string{},
+			utils.AWSConfigFromProviderContext,
+			loader,
+			utils.AWSConfigCacheKey,
+		),
+		Input: createTestDeployInput(
+			"aws/signer/signingProfile",
+			"TestProfile",
+			specData,
+			specData,
+			providerCtx,
+		),
+		ExpectedOutput: &provider.ResourceDeployOutput{
+			ComputedFieldValues: map[string]*core.MappingNode{
+				"spec.arn":               core.MappingNodeFromString(testSigningProfileARN),
+				"spec.profileVersion":    core.MappingNodeFromString("abcdef1234"),
+				"spec.profileVersionArn": core.MappingNodeFromString(testSigningProfileVersionARN),
+			},
+		},
+		SaveActionsNotCalled: []string{"PutSigningProfile", "TagResource", "UntagResource"},
+	}
+}
+
+func createTestSigningProfileSpecData(tags map[string]string) *core.MappingNode {
+	specData := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"profileName":       core.MappingNodeFromString("TestProfile"),
+			"platformId":        core.MappingNodeFromString("AWSLambda-SHA384-ECDSA"),
+			"arn":               core.MappingNodeFromString(testSigningProfileARN),
+			"profileVersion":    core.MappingNodeFromString("abcdef1234"),
+			"profileVersionArn": core.MappingNodeFromString(testSigningProfileVersionARN),
+		},
+	}
+
+	if len(tags) > 0 {
+		specData.Fields["tags"] = extractSignerTags(tags)
+	}
+
+	return specData
+}
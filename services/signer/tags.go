@@ -0,0 +1,139 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	signerservice "github.com/newstack-cloud/bluelink-provider-aws/services/signer/service"
+	"github.com/newstack-cloud/bluelink-provider-aws/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
+)
+
+func signerSchemaTags(resourceType string) *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type:        provider.ResourceDefinitionsSchemaTypeArray,
+		Description: fmt.Sprintf("A list of tags to apply to the %s.", resourceType),
+		FormattedDescription: fmt.Sprintf(
+			"A list of [tags](https://docs.aws.amazon.com/signer/latest/developerguide/tagging-signing-profiles.html) "+
+				"to apply to the %s.",
+			resourceType,
+		),
+		Items: &provider.ResourceDefinitionsSchema{
+			Type:        provider.ResourceDefinitionsSchemaTypeObject,
+			Label:       "Tag",
+			Description: fmt.Sprintf("A tag to apply to the %s.", resourceType),
+			Required:    []string{"key", "value"},
+			Attributes: map[string]*provider.ResourceDefinitionsSchema{
+				"key": {
+					Type:        provider.ResourceDefinitionsSchemaTypeString,
+					Description: "The key of the tag.",
+					MinLength:   1,
+					MaxLength:   128,
+				},
+				"value": {
+					Type:        provider.ResourceDefinitionsSchemaTypeString,
+					Description: "The value of the tag.",
+					MinLength:   0,
+					MaxLength:   256,
+				},
+			},
+		},
+	}
+}
+
+func signerTagsFromValue(value *core.MappingNode) map[string]string {
+	tags := map[string]string{}
+	for _, item := range value.Items {
+		key := core.StringValue(item.Fields["key"])
+		tags[key] = core.StringValue(item.Fields["value"])
+	}
+	return tags
+}
+
+func extractSignerTags(tags map[string]string) *core.MappingNode {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	// Sort the tags by key so the external state is deterministic.
+	sort.Strings(keys)
+
+	tagItems := make([]*core.MappingNode, len(keys))
+	for i, key := range keys {
+		tagItems[i] = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"key":   core.MappingNodeFromString(key),
+				"value": core.MappingNodeFromString(tags[key]),
+			},
+		}
+	}
+	return &core.MappingNode{
+		Items: tagItems,
+	}
+}
+
+type tagsUpdate struct {
+	toSet    map[string]string
+	toRemove []string
+}
+
+func (u *tagsUpdate) Name() string {
+	return "update tags"
+}
+
+func (u *tagsUpdate) Prepare(
+	saveOpCtx pluginutils.SaveOperationContext,
+	specData *core.MappingNode,
+	changes *provider.Changes,
+) (bool, pluginutils.SaveOperationContext, error) {
+	diffResult := utils.DiffTags(
+		changes,
+		"$.tags",
+		func(tag *utils.Tag) *utils.Tag {
+			return tag
+		},
+	)
+
+	u.toSet = map[string]string{}
+	for _, tag := range diffResult.ToSet {
+		u.toSet[tag.Key] = tag.Value
+	}
+	u.toRemove = diffResult.ToRemove
+
+	return len(u.toSet) > 0 || len(u.toRemove) > 0, saveOpCtx, nil
+}
+
+func (u *tagsUpdate) Execute(
+	ctx context.Context,
+	saveOpCtx pluginutils.SaveOperationContext,
+	signerService signerservice.Service,
+) (pluginutils.SaveOperationContext, error) {
+	arn := saveOpCtx.ProviderUpstreamID
+
+	if len(u.toRemove) > 0 {
+		_, err := signerService.UntagResource(ctx, &signer.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     u.toRemove,
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to remove tags: %w", err)
+		}
+	}
+
+	if len(u.toSet) > 0 {
+		_, err := signerService.TagResource(ctx, &signer.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        u.toSet,
+		})
+		if err != nil {
+			return saveOpCtx, fmt.Errorf("failed to add tags: %w", err)
+		}
+	}
+
+	return saveOpCtx, nil
+}
@@ -3,9 +3,17 @@ package utils
 import (
 	"archive/zip"
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 )
 
 const (
@@ -50,3 +58,253 @@ func ZipInMemory(
 	}
 	return base64.StdEncoding.EncodeToString(zipBytes), nil
 }
+
+const (
+	// MaxDirectUploadZipFileSize is the maximum size of a zip archive in bytes
+	// that can be uploaded directly to AWS Lambda as a part of an API request.
+	// Archives larger than this must be uploaded to Amazon S3 first.
+	MaxDirectUploadZipFileSize = 50 * 1024 * 1024 // 50MB
+)
+
+// zipEpoch is the fixed modification time used for every entry in
+// archives created from a local source, the earliest date that can be
+// represented in the MS-DOS date format used by zip archives.
+var zipEpoch = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ZipSource describes a set of local files that should be packaged
+// into a zip archive.
+type ZipSource struct {
+	// Path is either the path to a directory or a glob pattern
+	// such as "dist/**/*.js".
+	// When a glob pattern is provided, the directory portion of the path
+	// before the first glob segment is used as the root of the archive.
+	Path string
+	// Include is an optional list of glob patterns that files must match,
+	// relative to the root of the archive, in order to be included.
+	// When empty, all files under the root are included.
+	Include []string
+	// Exclude is an optional list of glob patterns relative to the root
+	// of the archive for files that should be left out of the archive.
+	// Exclude patterns take precedence over include patterns.
+	Exclude []string
+}
+
+// ZipArchive holds the contents of a zip archive created from a local source
+// along with its SHA-256 hash.
+type ZipArchive struct {
+	// Content holds the raw bytes of the zip archive.
+	Content []byte
+	// SHA256 is the base64-encoded SHA-256 hash of the archive,
+	// this is the same format that AWS Lambda uses to report
+	// the hash of a deployment package.
+	SHA256 string
+}
+
+// ZipFromSource creates a deterministic zip archive in memory from the files
+// described by the provided source.
+// Entries are sorted by path and are written with a fixed modification time
+// so that the same set of files will always produce the same archive and hash.
+func ZipFromSource(source *ZipSource) (*ZipArchive, error) {
+	rootDir, pathPattern := splitGlobPath(source.Path)
+	rootInfo, err := os.Stat(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source path %q: %w", source.Path, err)
+	}
+
+	if !rootInfo.IsDir() {
+		// A path to a single file is packaged as an archive
+		// containing just that file.
+		rootDir = filepath.Dir(rootDir)
+		pathPattern = filepath.ToSlash(filepath.Base(source.Path))
+	}
+
+	files, err := collectSourceFiles(rootDir, pathPattern, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files matched the source path %q", source.Path)
+	}
+
+	zipBuffer := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(zipBuffer)
+	for _, relPath := range files {
+		err = writeSourceFileToZip(zipWriter, rootDir, relPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	zipBytes := zipBuffer.Bytes()
+	hash := sha256.Sum256(zipBytes)
+	return &ZipArchive{
+		Content: zipBytes,
+		SHA256:  base64.StdEncoding.EncodeToString(hash[:]),
+	}, nil
+}
+
+func collectSourceFiles(
+	rootDir string,
+	pathPattern string,
+	source *ZipSource,
+) ([]string, error) {
+	files := []string{}
+	err := filepath.WalkDir(rootDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(rootDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if pathPattern != "" && !MatchGlob(pathPattern, relPath) {
+			return nil
+		}
+
+		if shouldIncludeSourceFile(relPath, source) {
+			files = append(files, relPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect files from source path %q: %w", source.Path, err)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func shouldIncludeSourceFile(relPath string, source *ZipSource) bool {
+	for _, pattern := range source.Exclude {
+		if MatchGlob(pattern, relPath) {
+			return false
+		}
+	}
+
+	if len(source.Include) == 0 {
+		return true
+	}
+
+	for _, pattern := range source.Include {
+		if MatchGlob(pattern, relPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeSourceFileToZip(
+	zipWriter *zip.Writer,
+	rootDir string,
+	relPath string,
+) error {
+	fullPath := filepath.Join(rootDir, filepath.FromSlash(relPath))
+	// os.Stat is used over the walked directory entry so that symlinks
+	// are resolved to the file they point to.
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:   relPath,
+		Method: zip.Deflate,
+	}
+	header.Modified = zipEpoch
+	// Only the executable bit is carried over from the source file
+	// to avoid differences in archives produced from the same files
+	// on machines with different umask settings.
+	mode := fs.FileMode(0644)
+	if info.Mode()&0111 != 0 {
+		mode = 0755
+	}
+	header.SetMode(mode)
+
+	fileWriter, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(fileWriter, file)
+	return err
+}
+
+// splitGlobPath splits a path into the directory that precedes
+// the first segment containing glob characters and the remaining
+// pattern in slash-separated form.
+// For paths without glob characters, the path is returned
+// as is with an empty pattern.
+func splitGlobPath(sourcePath string) (string, string) {
+	segments := strings.Split(filepath.ToSlash(sourcePath), "/")
+	for i, segment := range segments {
+		if strings.ContainsAny(segment, "*?[") {
+			rootDir := strings.Join(segments[:i], "/")
+			if rootDir == "" {
+				rootDir = "."
+				if strings.HasPrefix(sourcePath, "/") {
+					rootDir = "/"
+				}
+			}
+			return filepath.FromSlash(rootDir), strings.Join(segments[i:], "/")
+		}
+	}
+
+	return sourcePath, ""
+}
+
+// MatchGlob reports whether a slash-separated path matches the provided
+// glob pattern.
+// In addition to the syntax supported by path.Match, a "**" segment
+// matches zero or more directories.
+func MatchGlob(pattern string, filePath string) bool {
+	return matchGlobSegments(
+		strings.Split(strings.TrimPrefix(pattern, "./"), "/"),
+		strings.Split(filePath, "/"),
+	)
+}
+
+func matchGlobSegments(patternSegments []string, pathSegments []string) bool {
+	if len(patternSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+
+	if patternSegments[0] == "**" {
+		for i := 0; i <= len(pathSegments); i += 1 {
+			if matchGlobSegments(patternSegments[1:], pathSegments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(pathSegments) == 0 {
+		return false
+	}
+
+	matched, err := path.Match(patternSegments[0], pathSegments[0])
+	if err != nil || !matched {
+		return false
+	}
+
+	return matchGlobSegments(patternSegments[1:], pathSegments[1:])
+}
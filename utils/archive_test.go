@@ -3,11 +3,13 @@ package utils
 import (
 	"archive/zip"
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -70,6 +72,166 @@ func (s *ArchiveSuite) TestZipInMemory() {
 	}
 }
 
+type zipFromSourceTestCase struct {
+	name          string
+	source        func(rootDir string) *ZipSource
+	expectedFiles []string
+	expectedError bool
+}
+
+func (s *ArchiveSuite) TestZipFromSource() {
+	rootDir := s.T().TempDir()
+	s.writeSourceFile(rootDir, "index.js", "exports.handler = async () => {};", 0644)
+	s.writeSourceFile(rootDir, "lib/util.js", "module.exports = {};", 0644)
+	s.writeSourceFile(rootDir, "lib/util.test.js", "test('util', () => {});", 0644)
+	s.writeSourceFile(rootDir, "lib/nested/data.json", "{}", 0644)
+	s.writeSourceFile(rootDir, "bootstrap", "#!/bin/sh", 0755)
+	s.writeSourceFile(rootDir, "README.md", "# Function", 0644)
+
+	cases := []zipFromSourceTestCase{
+		{
+			name: "zips all files in a directory",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{Path: rootDir}
+			},
+			expectedFiles: []string{
+				"README.md",
+				"bootstrap",
+				"index.js",
+				"lib/nested/data.json",
+				"lib/util.js",
+				"lib/util.test.js",
+			},
+		},
+		{
+			name: "zips files in a directory with include and exclude patterns",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{
+					Path:    rootDir,
+					Include: []string{"**/*.js", "**/*.json"},
+					Exclude: []string{"**/*.test.js"},
+				}
+			},
+			expectedFiles: []string{
+				"index.js",
+				"lib/nested/data.json",
+				"lib/util.js",
+			},
+		},
+		{
+			name: "zips files matching a glob path",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{
+					Path: filepath.Join(rootDir, "lib", "**", "*.js*"),
+				}
+			},
+			expectedFiles: []string{
+				"nested/data.json",
+				"util.js",
+				"util.test.js",
+			},
+		},
+		{
+			name: "zips a single file",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{Path: filepath.Join(rootDir, "bootstrap")}
+			},
+			expectedFiles: []string{"bootstrap"},
+		},
+		{
+			name: "fails when no files match the source",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{
+					Path:    rootDir,
+					Include: []string{"**/*.py"},
+				}
+			},
+			expectedError: true,
+		},
+		{
+			name: "fails when the source path does not exist",
+			source: func(rootDir string) *ZipSource {
+				return &ZipSource{Path: filepath.Join(rootDir, "missing")}
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			archive, err := ZipFromSource(tc.source(rootDir))
+			if tc.expectedError {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			zipReader, err := zip.NewReader(
+				bytes.NewReader(archive.Content),
+				int64(len(archive.Content)),
+			)
+			s.NoError(err)
+
+			fileNames := []string{}
+			for _, file := range zipReader.File {
+				fileNames = append(fileNames, file.Name)
+				s.True(file.Modified.Equal(zipEpoch))
+			}
+			s.Equal(tc.expectedFiles, fileNames)
+
+			hash := sha256.Sum256(archive.Content)
+			s.Equal(base64.StdEncoding.EncodeToString(hash[:]), archive.SHA256)
+		})
+	}
+}
+
+func (s *ArchiveSuite) TestZipFromSourceIsDeterministic() {
+	rootDir := s.T().TempDir()
+	s.writeSourceFile(rootDir, "index.py", "def handler(event, context): pass", 0644)
+	s.writeSourceFile(rootDir, "pkg/module.py", "VALUE = 1", 0644)
+
+	first, err := ZipFromSource(&ZipSource{Path: rootDir})
+	s.NoError(err)
+
+	// Touch the files to make sure modification times do not
+	// affect the contents of the archive.
+	later := time.Now().Add(time.Hour)
+	s.NoError(os.Chtimes(filepath.Join(rootDir, "index.py"), later, later))
+	s.NoError(os.Chtimes(filepath.Join(rootDir, "pkg", "module.py"), later, later))
+
+	second, err := ZipFromSource(&ZipSource{Path: rootDir})
+	s.NoError(err)
+	s.Equal(first.SHA256, second.SHA256)
+	s.Equal(first.Content, second.Content)
+
+	s.writeSourceFile(rootDir, "pkg/module.py", "VALUE = 2", 0644)
+	third, err := ZipFromSource(&ZipSource{Path: rootDir})
+	s.NoError(err)
+	s.NotEqual(first.SHA256, third.SHA256)
+}
+
+func (s *ArchiveSuite) TestMatchGlob() {
+	s.True(MatchGlob("*.js", "index.js"))
+	s.False(MatchGlob("*.js", "lib/index.js"))
+	s.True(MatchGlob("**/*.js", "index.js"))
+	s.True(MatchGlob("**/*.js", "lib/nested/index.js"))
+	s.True(MatchGlob("lib/**", "lib/nested/index.js"))
+	s.True(MatchGlob("./lib/*.js", "lib/index.js"))
+	s.False(MatchGlob("lib/**/*.py", "lib/nested/index.js"))
+	s.True(MatchGlob("node_modules/**", "node_modules/pkg/index.js"))
+}
+
+func (s *ArchiveSuite) writeSourceFile(
+	rootDir string,
+	relPath string,
+	content string,
+	mode os.FileMode,
+) {
+	fullPath := filepath.Join(rootDir, filepath.FromSlash(relPath))
+	s.Require().NoError(os.MkdirAll(filepath.Dir(fullPath), 0755))
+	s.Require().NoError(os.WriteFile(fullPath, []byte(content), mode))
+}
+
 func TestArchiveSuite(t *testing.T) {
 	suite.Run(t, new(ArchiveSuite))
 }
@@ -72,7 +72,7 @@ func (s *AWSConfigStore) FromProviderContext(
 	// The session ID is augmented with metadata specific to the current request
 	// to ensure that different configurations can be used in the same session
 	// when a specific request provides different metadata (e.g. a different region).
-	sessionID, hasSessionID := getSessionID(ctx, providerContext)
+	sessionID, hasSessionID := SessionID(ctx, providerContext)
 	var cacheKey string
 	if hasSessionID {
 		cacheKey = s.configStoreCacheKey(sessionID, meta)
@@ -101,7 +101,10 @@ func (s *AWSConfigStore) setInCache(sessionID string, awsConfig *aws.Config) {
 	s.mu.Unlock()
 }
 
-func getSessionID(
+// SessionID retrieves the ID of the current session from the provider context
+// or the go context, this is used to scope cached data to a single command
+// run by a client such as the Bluelink CLI.
+func SessionID(
 	ctx context.Context,
 	providerContext provider.Context,
 ) (string, bool) {
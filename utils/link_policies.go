@@ -0,0 +1,16 @@
+package utils
+
+import "strings"
+
+// LinkInlinePolicyNamePrefix is the prefix for the names of inline policies
+// that links add to IAM roles, such as the policies that allow a lambda function
+// to invoke the resources that it is linked to.
+// Inline policies with this prefix are managed by links, so IAM resources
+// with exclusive policy management enabled leave them in place.
+const LinkInlinePolicyNamePrefix = "bluelink-link-"
+
+// IsLinkInlinePolicy determines whether an inline policy was added
+// to an IAM role by a link.
+func IsLinkInlinePolicy(policyName string) bool {
+	return strings.HasPrefix(policyName, LinkInlinePolicyNamePrefix)
+}
@@ -26,11 +26,17 @@ func AWSServiceList() string {
 
 // Services is a map of AWS services and their aliases.
 var Services = map[string][]string{
-	"account":  {},
-	"lambda":   {},
-	"iam":      {},
-	"dynamodb": {},
-	"sqs":      {},
+	"account":                {},
+	"lambda":                 {},
+	"iam":                    {},
+	"dynamodb":               {},
+	"sqs":                    {},
+	"s3":                     {"s3api"},
+	"cloudwatch":             {"monitoring"},
+	"applicationautoscaling": {"appautoscaling"},
+	"signer":                 {},
+	"ecr":                    {},
+	"logs":                   {"cloudwatchlogs"},
 }
 
 // GetEndpointFromProviderConfig returns the endpoint for a given service or one of its aliases.
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 
 	gonanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -130,4 +131,27 @@ var (
 
 	// IAMServerCertificateNameGenerator generates names for IAM server certificates (128 char limit).
 	IAMServerCertificateNameGenerator = DefaultUniqueNameGenerator(128)
+
+	// SignerSigningProfileNameGenerator generates names for AWS Signer signing profiles (64 char limit).
+	SignerSigningProfileNameGenerator = AlphanumericUniqueNameGenerator(64)
 )
+
+// AlphanumericUniqueNameGenerator creates a unique name in the same way as DefaultUniqueNameGenerator
+// for services that only allow alphanumeric characters and underscores in names,
+// any other characters are replaced with underscores.
+func AlphanumericUniqueNameGenerator(maxLength int) UniqueNameGenerator {
+	generator := DefaultUniqueNameGenerator(maxLength)
+	return func(input *provider.ResourceDeployInput) (string, error) {
+		name, err := generator(input)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Map(func(r rune) rune {
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+				return r
+			}
+			return '_'
+		}, name), nil
+	}
+}
@@ -223,6 +223,34 @@ func TestPredefinedGenerators(t *testing.T) {
 	}
 }
 
+func TestAlphanumericUniqueNameGenerator(t *testing.T) {
+	input := &provider.ResourceDeployInput{
+		InstanceID:   "uuid-instance-id",
+		InstanceName: "production-env",
+		ResourceID:   "test-resource-id",
+		Changes: &provider.Changes{
+			AppliedResourceInfo: provider.ResourceInfo{
+				ResourceID:   "test-resource-id",
+				ResourceName: "TestProfile",
+				InstanceID:   "uuid-instance-id",
+				ResourceWithResolvedSubs: &provider.ResolvedResource{
+					Type: &schema.ResourceTypeWrapper{
+						Value: "aws/signer/signingProfile",
+					},
+					Spec: &core.MappingNode{},
+				},
+			},
+		},
+	}
+
+	result, err := SignerSigningProfileNameGenerator(input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 64)
+	assert.Contains(t, result, "production_env_TestProfile_")
+	assert.Regexp(t, "^[a-zA-Z0-9_]+$", result)
+}
+
 func TestGeneratorWithEmptyInputs(t *testing.T) {
 	input := &provider.ResourceDeployInput{
 		InstanceID:   "uuid-instance-id",